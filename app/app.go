@@ -40,6 +40,7 @@ import (
 	"github.com/kava-labs/kava/x/incentive"
 	"github.com/kava-labs/kava/x/kavadist"
 	"github.com/kava-labs/kava/x/pricefeed"
+	pricefeedclient "github.com/kava-labs/kava/x/pricefeed/client"
 	validatorvesting "github.com/kava-labs/kava/x/validator-vesting"
 )
 
@@ -65,7 +66,7 @@ var (
 		distr.AppModuleBasic{},
 		gov.NewAppModuleBasic(
 			paramsclient.ProposalHandler, distr.ProposalHandler, committee.ProposalHandler,
-			upgradeclient.ProposalHandler,
+			upgradeclient.ProposalHandler, pricefeedclient.ProposalHandler, pricefeedclient.ReactivateOracleProposalHandler,
 		),
 		params.AppModuleBasic{},
 		crisis.AppModuleBasic{},
@@ -97,6 +98,7 @@ var (
 		cdp.SavingsRateMacc:         {supply.Minter},
 		bep3.ModuleName:             nil,
 		kavadist.ModuleName:         {supply.Minter},
+		committee.ModuleName:        nil,
 	}
 
 	// module accounts that are allowed to receive tokens
@@ -266,20 +268,34 @@ func NewApp(logger log.Logger, db dbm.DB, traceStore io.Writer, loadLatest bool,
 	evidenceKeeper.SetRouter(evidenceRouter)
 	app.evidenceKeeper = *evidenceKeeper
 
+	app.pricefeedKeeper = pricefeed.NewKeeper(
+		app.cdc,
+		keys[pricefeed.StoreKey],
+		pricefeedSubspace,
+		&stakingKeeper,
+	)
+
 	// create committee keeper with router
 	committeeGovRouter := gov.NewRouter()
 	committeeGovRouter.
 		AddRoute(gov.RouterKey, gov.ProposalHandler).
 		AddRoute(params.RouterKey, params.NewParamChangeProposalHandler(app.paramsKeeper)).
 		AddRoute(distr.RouterKey, distr.NewCommunityPoolSpendProposalHandler(app.distrKeeper)).
-		AddRoute(upgrade.RouterKey, upgrade.NewSoftwareUpgradeProposalHandler(app.upgradeKeeper))
-	// Note: the committee proposal handler is not registered on the committee router. This means committees cannot create or update other committees.
-	// Adding the committee proposal handler to the router is possible but awkward as the handler depends on the keeper which depends on the handler.
+		AddRoute(upgrade.RouterKey, upgrade.NewSoftwareUpgradeProposalHandler(app.upgradeKeeper)).
+		AddRoute(pricefeed.RouterKey, pricefeed.NewProposalHandler(app.pricefeedKeeper)).
+		// The handler depends on the keeper which depends on the router, so it's wrapped to use the keeper once it has been created.
+		AddRoute(committee.RouterKey, func(ctx sdk.Context, content gov.Content) error {
+			return committee.NewCancelProposalHandler(app.committeeKeeper)(ctx, content)
+		})
+	// Note: only the cancel proposal handler is registered on the committee router. This means committees can cancel queued proposals, but cannot create or update other committees.
 	app.committeeKeeper = committee.NewKeeper(
 		app.cdc,
 		keys[committee.StoreKey],
 		committeeGovRouter,
 		app.paramsKeeper,
+		app.accountKeeper,
+		&stakingKeeper,
+		app.supplyKeeper,
 	)
 
 	// create gov keeper with router
@@ -289,6 +305,7 @@ func NewApp(logger log.Logger, db dbm.DB, traceStore io.Writer, loadLatest bool,
 		AddRoute(params.RouterKey, params.NewParamChangeProposalHandler(app.paramsKeeper)).
 		AddRoute(distr.RouterKey, distr.NewCommunityPoolSpendProposalHandler(app.distrKeeper)).
 		AddRoute(upgrade.RouterKey, upgrade.NewSoftwareUpgradeProposalHandler(app.upgradeKeeper)).
+		AddRoute(pricefeed.RouterKey, pricefeed.NewProposalHandler(app.pricefeedKeeper)).
 		AddRoute(committee.RouterKey, committee.NewProposalHandler(app.committeeKeeper))
 	app.govKeeper = gov.NewKeeper(
 		app.cdc,
@@ -307,15 +324,11 @@ func NewApp(logger log.Logger, db dbm.DB, traceStore io.Writer, loadLatest bool,
 		app.supplyKeeper,
 		&stakingKeeper,
 	)
-	app.pricefeedKeeper = pricefeed.NewKeeper(
-		app.cdc,
-		keys[pricefeed.StoreKey],
-		pricefeedSubspace,
-	)
 	app.auctionKeeper = auction.NewKeeper(
 		app.cdc,
 		keys[auction.StoreKey],
 		app.supplyKeeper,
+		app.distrKeeper,
 		auctionSubspace,
 	)
 	app.cdpKeeper = cdp.NewKeeper(
@@ -10,8 +10,13 @@ import (
 	db "github.com/tendermint/tm-db"
 
 	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/gov"
 
 	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/kava-labs/kava/x/committee"
+	"github.com/kava-labs/kava/x/pricefeed"
 )
 
 func TestExport(t *testing.T) {
@@ -35,6 +40,37 @@ func TestBlackListedAddrs(t *testing.T) {
 	}
 }
 
+// ensure kava proposals can be carried by gov and committee msgs encoded with the app codec
+func TestProposalCodec(t *testing.T) {
+	cdc := MakeCodec()
+	_, addrs := GeneratePrivKeyAddressPairs(1)
+	proposals := []gov.Content{
+		pricefeed.NewConfirmPriceProposal("A Title", "A description of this proposal.", "bnb:usd"),
+		pricefeed.NewReactivateOracleProposal("A Title", "A description of this proposal.", "bnb:usd", addrs[0]),
+	}
+
+	var msgs []sdk.Msg
+	for _, proposal := range proposals {
+		msgs = append(msgs,
+			gov.NewMsgSubmitProposal(proposal, sdk.NewCoins(sdk.NewInt64Coin("ukava", 1000)), addrs[0]),
+			committee.NewMsgSubmitProposal(proposal, addrs[0], 1),
+		)
+	}
+	for _, msg := range msgs {
+		bz, err := cdc.MarshalBinaryBare(msg)
+		require.NoError(t, err)
+		var decoded sdk.Msg
+		require.NoError(t, cdc.UnmarshalBinaryBare(bz, &decoded))
+		require.Equal(t, msg, decoded)
+
+		bz, err = cdc.MarshalJSON(msg)
+		require.NoError(t, err)
+		decoded = nil
+		require.NoError(t, cdc.UnmarshalJSON(bz, &decoded))
+		require.Equal(t, msg, decoded)
+	}
+}
+
 func setGenesis(app *App) error {
 	genesisState := NewDefaultGenesisState()
 
@@ -0,0 +1,58 @@
+package main
+
+import (
+	"fmt"
+
+	tmbytes "github.com/tendermint/tendermint/libs/bytes"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// CounterpartySwapStatus is the status of a swap on the counterparty chain
+type CounterpartySwapStatus byte
+
+// Statuses of a swap on the counterparty chain
+const (
+	CounterpartyOpen     CounterpartySwapStatus = 0x01
+	CounterpartyClaimed  CounterpartySwapStatus = 0x02
+	CounterpartyRefunded CounterpartySwapStatus = 0x03
+)
+
+// String returns the string representation of the status
+func (status CounterpartySwapStatus) String() string {
+	switch status {
+	case CounterpartyOpen:
+		return "open"
+	case CounterpartyClaimed:
+		return "claimed"
+	case CounterpartyRefunded:
+		return "refunded"
+	default:
+		return fmt.Sprintf("unknown(%d)", status)
+	}
+}
+
+// CounterpartySwap is a hashed timelock swap on the counterparty chain, identified by its random number hash
+type CounterpartySwap struct {
+	RandomNumberHash tmbytes.HexBytes
+	Timestamp        int64
+	Sender           string // deputy's address on the counterparty chain
+	Recipient        string
+	Amount           sdk.Coins
+	ExpireHeight     int64
+	Status           CounterpartySwapStatus
+	RandomNumber     tmbytes.HexBytes // revealed when the swap is claimed
+}
+
+// Counterparty is the chain on the other side of the bep3 bridge, as seen by the deputy.
+// Swaps are created and refunded from the deputy's account on the counterparty chain.
+type Counterparty interface {
+	// Height returns the latest block height of the counterparty chain
+	Height() (int64, error)
+	// CreateSwap locks amount from the deputy's account in a swap to recipient that can be refunded after heightSpan blocks
+	CreateSwap(randomNumberHash tmbytes.HexBytes, timestamp int64, recipient string, amount sdk.Coins, heightSpan int64) error
+	// GetSwap returns the swap with the given random number hash, if it exists
+	GetSwap(randomNumberHash tmbytes.HexBytes) (CounterpartySwap, bool, error)
+	// RefundSwap returns the coins of an expired swap to the deputy
+	RefundSwap(randomNumberHash tmbytes.HexBytes) error
+}
@@ -0,0 +1,202 @@
+package main
+
+import (
+	"encoding/hex"
+	"fmt"
+	"time"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmbytes "github.com/tendermint/tendermint/libs/bytes"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/client/keys"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/auth/client/utils"
+
+	"github.com/kava-labs/kava/x/bep3"
+)
+
+// KavaChain is the kava side of the bep3 bridge, as seen by the deputy.
+// Claims and refunds are sent from the deputy's address.
+type KavaChain interface {
+	// LatestHeight returns the height of the latest committed block
+	LatestHeight() (int64, error)
+	// CreatedSwapIDs returns the ids of the swaps created by txs in the block at height
+	CreatedSwapIDs(height int64) ([]tmbytes.HexBytes, error)
+	// GetSwap returns the swap with the given id
+	GetSwap(swapID tmbytes.HexBytes) (bep3.AtomicSwap, error)
+	// GetParams returns the bep3 params
+	GetParams() (bep3.Params, error)
+	// ClaimSwap claims a swap with the random number revealed on the counterparty chain
+	ClaimSwap(swapID, randomNumber tmbytes.HexBytes) error
+	// RefundSwap refunds an expired swap to its sender
+	RefundSwap(swapID tmbytes.HexBytes) error
+}
+
+// RPCKavaChain talks to a kava node over tendermint rpc
+type RPCKavaChain struct {
+	cliCtx     context.CLIContext
+	txBldr     auth.TxBuilder
+	maxRetries int
+	retryDelay time.Duration
+	logger     log.Logger
+}
+
+var _ KavaChain = RPCKavaChain{}
+
+// NewRPCKavaChain returns a new RPCKavaChain that signs txs with the cliCtx's from key
+func NewRPCKavaChain(cliCtx context.CLIContext, txBldr auth.TxBuilder, maxRetries int, retryDelay time.Duration, logger log.Logger) RPCKavaChain {
+	return RPCKavaChain{
+		cliCtx:     cliCtx,
+		txBldr:     txBldr,
+		maxRetries: maxRetries,
+		retryDelay: retryDelay,
+		logger:     logger,
+	}
+}
+
+// LatestHeight returns the height of the latest block committed by the node
+func (k RPCKavaChain) LatestHeight() (int64, error) {
+	node, err := k.cliCtx.GetNode()
+	if err != nil {
+		return 0, err
+	}
+	status, err := node.Status()
+	if err != nil {
+		return 0, err
+	}
+	return status.SyncInfo.LatestBlockHeight, nil
+}
+
+// CreatedSwapIDs returns the ids in the create_atomic_swap events of the successful txs at height
+func (k RPCKavaChain) CreatedSwapIDs(height int64) ([]tmbytes.HexBytes, error) {
+	node, err := k.cliCtx.GetNode()
+	if err != nil {
+		return nil, err
+	}
+	res, err := node.BlockResults(&height)
+	if err != nil {
+		return nil, err
+	}
+
+	var ids []tmbytes.HexBytes
+	for _, txRes := range res.TxsResults {
+		if txRes.Code != 0 {
+			continue
+		}
+		txIDs, err := createdSwapIDs(txRes.Events)
+		if err != nil {
+			return nil, fmt.Errorf("invalid events in block %d: %w", height, err)
+		}
+		ids = append(ids, txIDs...)
+	}
+	return ids, nil
+}
+
+// createdSwapIDs returns the swap ids in the create_atomic_swap events
+func createdSwapIDs(events []abci.Event) ([]tmbytes.HexBytes, error) {
+	var ids []tmbytes.HexBytes
+	for _, event := range events {
+		if event.Type != bep3.EventTypeCreateAtomicSwap {
+			continue
+		}
+		for _, attr := range event.Attributes {
+			if string(attr.Key) != bep3.AttributeKeyAtomicSwapID {
+				continue
+			}
+			id, err := hex.DecodeString(string(attr.Value))
+			if err != nil {
+				return nil, fmt.Errorf("invalid swap id %s: %w", attr.Value, err)
+			}
+			ids = append(ids, id)
+		}
+	}
+	return ids, nil
+}
+
+// GetSwap queries the swap with the given id
+func (k RPCKavaChain) GetSwap(swapID tmbytes.HexBytes) (bep3.AtomicSwap, error) {
+	bz, err := k.cliCtx.Codec.MarshalJSON(bep3.NewQueryAtomicSwapByID(swapID))
+	if err != nil {
+		return bep3.AtomicSwap{}, err
+	}
+	res, _, err := k.cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", bep3.QuerierRoute, bep3.QueryGetAtomicSwap), bz)
+	if err != nil {
+		return bep3.AtomicSwap{}, err
+	}
+	var swap bep3.AtomicSwap
+	if err := k.cliCtx.Codec.UnmarshalJSON(res, &swap); err != nil {
+		return bep3.AtomicSwap{}, err
+	}
+	return swap, nil
+}
+
+// GetParams queries the bep3 params
+func (k RPCKavaChain) GetParams() (bep3.Params, error) {
+	res, _, err := k.cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", bep3.QuerierRoute, bep3.QueryGetParams), nil)
+	if err != nil {
+		return bep3.Params{}, err
+	}
+	var params bep3.Params
+	if err := k.cliCtx.Codec.UnmarshalJSON(res, &params); err != nil {
+		return bep3.Params{}, err
+	}
+	return params, nil
+}
+
+// ClaimSwap broadcasts a MsgClaimAtomicSwap from the deputy
+func (k RPCKavaChain) ClaimSwap(swapID, randomNumber tmbytes.HexBytes) error {
+	return k.broadcastWithRetry(bep3.NewMsgClaimAtomicSwap(k.cliCtx.GetFromAddress(), swapID, randomNumber))
+}
+
+// RefundSwap broadcasts a MsgRefundAtomicSwap from the deputy
+func (k RPCKavaChain) RefundSwap(swapID tmbytes.HexBytes) error {
+	return k.broadcastWithRetry(bep3.NewMsgRefundAtomicSwap(k.cliCtx.GetFromAddress(), swapID))
+}
+
+// broadcastWithRetry signs and broadcasts msg, retrying up to the configured number of attempts.
+// The account number and sequence are fetched before every attempt so a failed or
+// concurrently sent tx does not leave the deputy stuck on a stale sequence.
+func (k RPCKavaChain) broadcastWithRetry(msg sdk.Msg) error {
+	attempts := k.maxRetries + 1
+	var err error
+	for i := 0; i < attempts; i++ {
+		if i > 0 {
+			k.logger.Info("retrying broadcast", "attempt", i+1, "err", err)
+			time.Sleep(k.retryDelay)
+		}
+		if err = k.broadcast([]sdk.Msg{msg}); err == nil {
+			return nil
+		}
+	}
+	return fmt.Errorf("failed to broadcast after %d attempts: %w", attempts, err)
+}
+
+func (k RPCKavaChain) broadcast(msgs []sdk.Msg) error {
+	num, seq, err := auth.NewAccountRetriever(k.cliCtx).GetAccountNumberSequence(k.cliCtx.GetFromAddress())
+	if err != nil {
+		return err
+	}
+	txBldr := k.txBldr.WithAccountNumber(num).WithSequence(seq)
+	if txBldr.SimulateAndExecute() {
+		txBldr, err = utils.EnrichWithGas(txBldr, k.cliCtx, msgs)
+		if err != nil {
+			return err
+		}
+	}
+
+	txBytes, err := txBldr.BuildAndSign(k.cliCtx.GetFromName(), keys.DefaultKeyPass, msgs)
+	if err != nil {
+		return err
+	}
+	res, err := k.cliCtx.BroadcastTx(txBytes)
+	if err != nil {
+		return err
+	}
+	if res.Code != 0 {
+		return fmt.Errorf("tx %s failed with code %d: %s", res.TxHash, res.Code, res.RawLog)
+	}
+	return nil
+}
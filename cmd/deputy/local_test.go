@@ -0,0 +1,115 @@
+package main
+
+import (
+	"flag"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/crypto/keys"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+
+	"github.com/kava-labs/kava/app"
+	"github.com/kava-labs/kava/x/bep3"
+)
+
+// Flags for running the relayer against a local kvd, see local_test.sh
+var (
+	flagKvdNode      = flag.String("KvdNode", "", "rpc address of a local kvd, the end to end test is skipped if empty")
+	flagKvdChainID   = flag.String("KvdChainID", "testing", "chain id of the local kvd")
+	flagKvcliHome    = flag.String("KvcliHome", app.DefaultCLIHome, "kvcli home holding the deputy and user keys in the test keyring")
+	flagDeputyKey    = flag.String("DeputyKey", "deputy", "name of the deputy's key")
+	flagUserKey      = flag.String("UserKey", "user", "name of the key that sends the swap to the deputy")
+	flagSwapCoin     = flag.String("SwapCoin", "100000bnb", "coin sent to the deputy")
+	flagSwapBlocks   = flag.Uint64("SwapBlocks", 250, "height span of the kava swap")
+	flagPollAttempts = flag.Int("PollAttempts", 30, "polls to wait for each step, one per second")
+)
+
+func TestRelayer_LocalKvd(t *testing.T) {
+	if *flagKvdNode == "" {
+		t.Skip("skipping end to end test, set -KvdNode to run against a local kvd")
+	}
+	app.SetBech32AddressPrefixes(sdk.GetConfig())
+	viper.Set(flags.FlagNode, *flagKvdNode)
+	viper.Set(flags.FlagChainID, *flagKvdChainID)
+	viper.Set(flags.FlagHome, *flagKvcliHome)
+	viper.Set(flags.FlagKeyringBackend, keys.BackendTest)
+	viper.Set(flags.FlagTrustNode, true)
+	viper.Set(flags.FlagBroadcastMode, flags.BroadcastBlock)
+
+	cdc := app.MakeCodec()
+	txBldr := auth.NewTxBuilderFromCLI(nil).WithTxEncoder(auth.DefaultTxEncoder(cdc))
+	logger := log.NewTMLogger(log.NewSyncWriter(os.Stdout)).With("module", "deputy")
+
+	deputyCtx := context.NewCLIContextWithFrom(*flagDeputyKey).WithCodec(cdc)
+	userCtx := context.NewCLIContextWithFrom(*flagUserKey).WithCodec(cdc)
+	kava := NewRPCKavaChain(deputyCtx, txBldr, 3, time.Second, logger)
+	user := NewRPCKavaChain(userCtx, txBldr, 3, time.Second, logger)
+
+	coin, err := sdk.ParseCoin(*flagSwapCoin)
+	require.NoError(t, err)
+	params, err := kava.GetParams()
+	require.NoError(t, err)
+	_, found := params.Deputies.Get(deputyCtx.GetFromAddress())
+	require.True(t, found, "%s is not a deputy", deputyCtx.GetFromAddress())
+	asset, found := params.SupportedAssets.Get(coin.Denom)
+	require.True(t, found, "%s is not a supported asset", coin.Denom)
+
+	counterparty := NewMockCounterparty(testDeputyOtherChain, sdk.NewCoins(coin))
+	startHeight, err := kava.LatestHeight()
+	require.NoError(t, err)
+	relayer := NewRelayer(kava, counterparty, deputyCtx.GetFromAddress(), Config{
+		Interval:               time.Second,
+		StartHeight:            startHeight,
+		CounterpartyHeightSpan: 10,
+		MinRemainingHeight:     *flagSwapBlocks / 2,
+	}, logger)
+
+	// The user sends a swap to the deputy on kava
+	timestamp := time.Now().Unix()
+	randomNumber, err := bep3.GenerateSecureRandomNumber()
+	require.NoError(t, err)
+	randomNumberHash := bep3.CalculateRandomHash(randomNumber, timestamp)
+	require.NoError(t, user.broadcastWithRetry(bep3.NewMsgCreateAtomicSwap(userCtx.GetFromAddress(), deputyCtx.GetFromAddress(),
+		testRecipientOtherChain, testSenderOtherChain, randomNumberHash, timestamp, sdk.NewCoins(coin), *flagSwapBlocks)))
+	swapID := bep3.CalculateSwapID(randomNumberHash, userCtx.GetFromAddress(), testSenderOtherChain, asset.ChainID)
+
+	// The deputy mirrors it on the counterparty, less its fee
+	pollUntil(t, relayer, func() bool {
+		_, found, _ := counterparty.GetSwap(randomNumberHash)
+		return found
+	})
+	cpSwap, _, err := counterparty.GetSwap(randomNumberHash)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(coin.Sub(sdk.NewCoin(coin.Denom, asset.Fee.Calculate(coin.Amount)))), cpSwap.Amount)
+
+	// The user claims on the counterparty and the deputy claims on kava with the revealed random number
+	require.NoError(t, counterparty.ClaimSwap(randomNumberHash, randomNumber))
+	pollUntil(t, relayer, func() bool {
+		swap, err := kava.GetSwap(swapID)
+		return err == nil && swap.Status == bep3.Completed
+	})
+	require.Equal(t, cpSwap.Amount, counterparty.Balance(testRecipientOtherChain))
+}
+
+// pollUntil polls the relayer once a second until done returns true
+func pollUntil(t *testing.T, relayer *Relayer, done func() bool) {
+	for i := 0; i < *flagPollAttempts; i++ {
+		if err := relayer.Poll(); err != nil {
+			t.Logf("poll failed: %s", err)
+		}
+		if done() {
+			return
+		}
+		time.Sleep(time.Second)
+	}
+	t.Fatal("timed out waiting for the relayer")
+}
@@ -0,0 +1,44 @@
+#! /bin/bash
+# Runs the deputy end to end test against a local single validator kvd with a bep3 deputy in genesis.
+# Usage: ./cmd/deputy/local_test.sh, from the repository root with kvd and kvcli installed.
+set -e
+
+kvdHome=/tmp/deputyKvdHome
+kvcliHome=/tmp/deputyKvcliHome
+genesis=$kvdHome/config/genesis.json
+chainID=testing
+kvdPidFile=/tmp/deputyKvd.pid
+
+# stop a kvd left running by a previous run of this script, without touching any other kvd
+if [ -f $kvdPidFile ]; then
+	kill $(cat $kvdPidFile) 2> /dev/null || true
+	rm -f $kvdPidFile
+fi
+rm -rf $kvdHome $kvcliHome
+
+kvcli keys add validator --home $kvcliHome --keyring-backend test > /dev/null 2>&1
+kvcli keys add deputy --home $kvcliHome --keyring-backend test > /dev/null 2>&1
+kvcli keys add user --home $kvcliHome --keyring-backend test > /dev/null 2>&1
+deputy=$(kvcli keys show deputy -a --home $kvcliHome --keyring-backend test)
+user=$(kvcli keys show user -a --home $kvcliHome --keyring-backend test)
+
+kvd init --home $kvdHome --chain-id $chainID validator > /dev/null 2>&1
+kvd add-genesis-account --home $kvdHome $(kvcli keys show validator -a --home $kvcliHome --keyring-backend test) 10000000000000stake
+kvd add-genesis-account --home $kvdHome $deputy 1000000000ukava
+kvd add-genesis-account --home $kvdHome $user 1000000000ukava,1000000000bnb
+kvd gentx --home $kvdHome --name validator --home-client $kvcliHome --keyring-backend test > /dev/null 2>&1
+kvd collect-gentxs --home $kvdHome > /dev/null 2>&1
+
+# bnb with the user's balance as the current supply, relayed by the deputy for a fee of 1000 plus 10 basis points
+jq --arg deputy $deputy '.app_state.bep3.params.deputies = [{"address": $deputy, "supported_assets": ["bnb"], "min_swap_amount": "1", "max_swap_amount": "1000000000"}]' $genesis > $genesis.tmp && mv $genesis.tmp $genesis
+jq '.app_state.bep3.params.supported_assets = [{"denom": "bnb", "coin_id": "714", "limit": "10000000000", "active": true, "chain_id": "Binance-Chain-Tigris", "address_format": "bech32", "address_prefix": "bnb", "time_window": "0", "time_window_limit": "0", "fee": {"fixed_fee": "1000", "fee_rate": "10", "min_fee": "0"}}]' $genesis > $genesis.tmp && mv $genesis.tmp $genesis
+jq '.app_state.bep3.assets_supplies = [{"denom": "bnb", "incoming_supply": {"denom": "bnb", "amount": "0"}, "outgoing_supply": {"denom": "bnb", "amount": "0"}, "current_supply": {"denom": "bnb", "amount": "1000000000"}, "supply_limit": {"denom": "bnb", "amount": "10000000000"}}]' $genesis > $genesis.tmp && mv $genesis.tmp $genesis
+sed -i 's/timeout_commit = "5s"/timeout_commit = "1s"/' $kvdHome/config/config.toml
+
+kvd start --home $kvdHome > $kvdHome/kvd.log 2>&1 &
+kvdPid=$!
+echo $kvdPid > $kvdPidFile
+trap "kill $kvdPid; rm -f $kvdPidFile" EXIT
+sleep 10
+
+go test ./cmd/deputy -run TestRelayer_LocalKvd -count 1 -v -KvdNode tcp://localhost:26657 -KvdChainID $chainID -KvcliHome $kvcliHome
@@ -0,0 +1,202 @@
+// Command deputy is a reference bep3 deputy.
+//
+// It only relays swaps from kava to a counterparty chain: swaps sent to the deputy on kava are mirrored on the
+// counterparty, and claims and refunds are relayed back. Swaps from the counterparty to kava are not relayed.
+package main
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"os"
+	"os/signal"
+	"path"
+	"syscall"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/tendermint/tendermint/libs/cli"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+
+	"github.com/kava-labs/kava/app"
+)
+
+// Counterparty chain types selectable from the command line
+const (
+	CounterpartyMock = "mock"
+)
+
+// Flags for the deputy daemon
+const (
+	flagCounterparty           = "counterparty"
+	flagInterval               = "interval"
+	flagStartHeight            = "start-height"
+	flagCounterpartyHeightSpan = "counterparty-height-span"
+	flagMinRemainingHeight     = "min-remaining-height"
+	flagMaxRetries             = "max-retries"
+	flagRetryDelay             = "retry-delay"
+	flagMockDeputy             = "mock-deputy"
+	flagMockCoins              = "mock-coins"
+	flagMockBlockTime          = "mock-block-time"
+)
+
+func main() {
+	cdc := app.MakeCodec()
+
+	config := sdk.GetConfig()
+	app.SetBech32AddressPrefixes(config)
+	app.SetBip44CoinType(config)
+	config.Seal()
+
+	rootCmd := &cobra.Command{
+		Use:   "deputy",
+		Short: "Reference bep3 deputy that relays swaps from kava to a counterparty chain",
+	}
+	rootCmd.PersistentPreRunE = func(_ *cobra.Command, _ []string) error {
+		return initConfig(rootCmd)
+	}
+
+	rootCmd.AddCommand(
+		runCmd(cdc),
+		flags.LineBreak,
+		version.Cmd,
+	)
+
+	executor := cli.PrepareMainCmd(rootCmd, "DP", app.DefaultCLIHome)
+	if err := executor.Execute(); err != nil {
+		fmt.Printf("Failed executing deputy: %s, exiting...\n", err)
+		os.Exit(1)
+	}
+}
+
+func runCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "run",
+		Short: "mirror kava swaps sent to the deputy on the counterparty chain and relay claims and refunds back",
+		Example: `deputy run --from deputy --chain-id kava-3 --broadcast-mode block --counterparty mock --mock-coins 1000000000bnb
+
+The --from key must be a deputy in the bep3 params. Keys are loaded from the kvcli keyring, see --home and --keyring-backend.
+The mock counterparty keeps its state in memory and produces a block every --mock-block-time.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := viper.BindPFlags(cmd.Flags()); err != nil {
+				return err
+			}
+			if viper.GetString(flags.FlagFrom) == "" {
+				return errors.New("a key must be provided with --from")
+			}
+			interval := viper.GetDuration(flagInterval)
+			if interval <= 0 {
+				return fmt.Errorf("interval must be positive: %s", interval)
+			}
+			heightSpan := viper.GetInt64(flagCounterpartyHeightSpan)
+			if heightSpan <= 0 {
+				return fmt.Errorf("counterparty height span must be positive: %d", heightSpan)
+			}
+			maxRetries := viper.GetInt(flagMaxRetries)
+			if maxRetries < 0 {
+				return fmt.Errorf("max retries must be non-negative: %d", maxRetries)
+			}
+
+			stop := make(chan struct{})
+			counterparty, err := newCounterparty(viper.GetString(flagCounterparty), stop)
+			if err != nil {
+				return err
+			}
+
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			cliCtx := context.NewCLIContextWithInput(inBuf).WithCodec(cdc)
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(auth.DefaultTxEncoder(cdc))
+
+			logger := log.NewTMLogger(log.NewSyncWriter(os.Stdout)).With("module", "deputy")
+			kava := NewRPCKavaChain(cliCtx, txBldr, maxRetries, viper.GetDuration(flagRetryDelay), logger)
+			relayer := NewRelayer(kava, counterparty, cliCtx.GetFromAddress(), Config{
+				Interval:               interval,
+				StartHeight:            viper.GetInt64(flagStartHeight),
+				CounterpartyHeightSpan: heightSpan,
+				MinRemainingHeight:     viper.GetUint64(flagMinRemainingHeight),
+			}, logger)
+
+			sigs := make(chan os.Signal, 1)
+			signal.Notify(sigs, syscall.SIGINT, syscall.SIGTERM)
+			go func() {
+				<-sigs
+				close(stop)
+			}()
+
+			logger.Info("starting deputy", "deputy", cliCtx.GetFromAddress(), "counterparty", viper.GetString(flagCounterparty))
+			relayer.Run(stop)
+			return nil
+		},
+	}
+
+	cmd.Flags().String(flagCounterparty, CounterpartyMock, fmt.Sprintf("counterparty chain type (%s)", CounterpartyMock))
+	cmd.Flags().Duration(flagInterval, 5*time.Second, "time between polls of both chains")
+	cmd.Flags().Int64(flagStartHeight, 0, "first kava block to scan for swaps, 0 starts from the latest block")
+	cmd.Flags().Int64(flagCounterpartyHeightSpan, 100, "counterparty blocks before a mirrored swap can be refunded")
+	cmd.Flags().Uint64(flagMinRemainingHeight, 120, "kava blocks a swap must have left before expiry to be mirrored")
+	cmd.Flags().Int(flagMaxRetries, 3, "number of times to retry a failed broadcast")
+	cmd.Flags().Duration(flagRetryDelay, 5*time.Second, "time to wait between broadcast retries")
+	cmd.Flags().String(flagMockDeputy, "deputy", "deputy's address on the mock counterparty")
+	cmd.Flags().String(flagMockCoins, "", "deputy's starting balance on the mock counterparty")
+	cmd.Flags().Duration(flagMockBlockTime, 5*time.Second, "time between blocks on the mock counterparty")
+
+	return flags.PostCommands(cmd)[0]
+}
+
+// newCounterparty returns the counterparty chain of the given type, which runs until stop is closed
+func newCounterparty(counterpartyType string, stop <-chan struct{}) (Counterparty, error) {
+	switch counterpartyType {
+	case CounterpartyMock:
+		coins, err := sdk.ParseCoins(viper.GetString(flagMockCoins))
+		if err != nil {
+			return nil, fmt.Errorf("invalid mock coins: %w", err)
+		}
+		blockTime := viper.GetDuration(flagMockBlockTime)
+		if blockTime <= 0 {
+			return nil, fmt.Errorf("mock block time must be positive: %s", blockTime)
+		}
+		mock := NewMockCounterparty(viper.GetString(flagMockDeputy), coins)
+		go func() {
+			ticker := time.NewTicker(blockTime)
+			defer ticker.Stop()
+			for {
+				select {
+				case <-stop:
+					return
+				case <-ticker.C:
+					mock.AdvanceHeight(1)
+				}
+			}
+		}()
+		return mock, nil
+	default:
+		return nil, fmt.Errorf("invalid counterparty %s, must be one of: %s", counterpartyType, CounterpartyMock)
+	}
+}
+
+func initConfig(cmd *cobra.Command) error {
+	home, err := cmd.PersistentFlags().GetString(cli.HomeFlag)
+	if err != nil {
+		return err
+	}
+	cfgFile := path.Join(home, "config", "config.toml")
+
+	if _, err := os.Stat(cfgFile); err == nil {
+		viper.SetConfigFile(cfgFile)
+
+		if err := viper.ReadInConfig(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
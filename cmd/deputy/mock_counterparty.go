@@ -0,0 +1,150 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"sync"
+
+	tmbytes "github.com/tendermint/tendermint/libs/bytes"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kava-labs/kava/x/bep3"
+)
+
+// MockCounterparty is an in-memory counterparty chain for running the deputy against a local kvd.
+// Blocks are produced by calling AdvanceHeight, and swaps are claimed by calling ClaimSwap,
+// standing in for the users of the counterparty chain.
+type MockCounterparty struct {
+	mtx sync.Mutex
+
+	deputy   string
+	height   int64
+	balances map[string]sdk.Coins
+	swaps    map[string]CounterpartySwap
+}
+
+var _ Counterparty = (*MockCounterparty)(nil)
+
+// NewMockCounterparty returns a new MockCounterparty at height 1 where the deputy holds deputyCoins
+func NewMockCounterparty(deputy string, deputyCoins sdk.Coins) *MockCounterparty {
+	return &MockCounterparty{
+		deputy:   deputy,
+		height:   1,
+		balances: map[string]sdk.Coins{deputy: deputyCoins},
+		swaps:    make(map[string]CounterpartySwap),
+	}
+}
+
+// Height returns the current height of the mock chain
+func (m *MockCounterparty) Height() (int64, error) {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	return m.height, nil
+}
+
+// CreateSwap locks amount from the deputy's balance in a new swap
+func (m *MockCounterparty) CreateSwap(randomNumberHash tmbytes.HexBytes, timestamp int64, recipient string, amount sdk.Coins, heightSpan int64) error {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	if _, found := m.swaps[randomNumberHash.String()]; found {
+		return fmt.Errorf("swap %s already exists", randomNumberHash)
+	}
+	if !amount.IsValid() || amount.Empty() {
+		return fmt.Errorf("invalid swap amount: %s", amount)
+	}
+	if heightSpan <= 0 {
+		return fmt.Errorf("height span must be positive: %d", heightSpan)
+	}
+	balance, hasNeg := m.balances[m.deputy].SafeSub(amount)
+	if hasNeg {
+		return fmt.Errorf("insufficient deputy balance %s for swap of %s", m.balances[m.deputy], amount)
+	}
+	m.balances[m.deputy] = balance
+
+	m.swaps[randomNumberHash.String()] = CounterpartySwap{
+		RandomNumberHash: randomNumberHash,
+		Timestamp:        timestamp,
+		Sender:           m.deputy,
+		Recipient:        recipient,
+		Amount:           amount,
+		ExpireHeight:     m.height + heightSpan,
+		Status:           CounterpartyOpen,
+	}
+	return nil
+}
+
+// GetSwap returns the swap with the given random number hash
+func (m *MockCounterparty) GetSwap(randomNumberHash tmbytes.HexBytes) (CounterpartySwap, bool, error) {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	swap, found := m.swaps[randomNumberHash.String()]
+	return swap, found, nil
+}
+
+// RefundSwap returns the coins of an expired swap to the deputy
+func (m *MockCounterparty) RefundSwap(randomNumberHash tmbytes.HexBytes) error {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	swap, found := m.swaps[randomNumberHash.String()]
+	if !found {
+		return fmt.Errorf("swap %s not found", randomNumberHash)
+	}
+	if swap.Status != CounterpartyOpen {
+		return fmt.Errorf("swap %s is %s", randomNumberHash, swap.Status)
+	}
+	if m.height < swap.ExpireHeight {
+		return fmt.Errorf("swap %s cannot be refunded until height %d", randomNumberHash, swap.ExpireHeight)
+	}
+
+	swap.Status = CounterpartyRefunded
+	m.swaps[randomNumberHash.String()] = swap
+	m.balances[swap.Sender] = m.balances[swap.Sender].Add(swap.Amount...)
+	return nil
+}
+
+// ClaimSwap claims an open swap for its recipient, revealing the random number
+func (m *MockCounterparty) ClaimSwap(randomNumberHash, randomNumber tmbytes.HexBytes) error {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	swap, found := m.swaps[randomNumberHash.String()]
+	if !found {
+		return fmt.Errorf("swap %s not found", randomNumberHash)
+	}
+	if swap.Status != CounterpartyOpen {
+		return fmt.Errorf("swap %s is %s", randomNumberHash, swap.Status)
+	}
+	if m.height >= swap.ExpireHeight {
+		return fmt.Errorf("swap %s expired at height %d", randomNumberHash, swap.ExpireHeight)
+	}
+	if !bytes.Equal(bep3.CalculateRandomHash(randomNumber, swap.Timestamp), swap.RandomNumberHash) {
+		return fmt.Errorf("random number does not match hash %s", randomNumberHash)
+	}
+
+	swap.Status = CounterpartyClaimed
+	swap.RandomNumber = randomNumber
+	m.swaps[randomNumberHash.String()] = swap
+	m.balances[swap.Recipient] = m.balances[swap.Recipient].Add(swap.Amount...)
+	return nil
+}
+
+// AdvanceHeight produces the given number of blocks
+func (m *MockCounterparty) AdvanceHeight(blocks int64) {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	m.height += blocks
+}
+
+// Balance returns the coins held by an address on the mock chain
+func (m *MockCounterparty) Balance(address string) sdk.Coins {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	return m.balances[address]
+}
@@ -0,0 +1,61 @@
+package main
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kava-labs/kava/x/bep3"
+)
+
+func TestMockCounterparty(t *testing.T) {
+	deputyCoins := sdk.NewCoins(sdk.NewInt64Coin("bnb", 1000))
+	amount := sdk.NewCoins(sdk.NewInt64Coin("bnb", 400))
+	timestamp := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC).Unix()
+	randomNumber, err := bep3.GenerateSecureRandomNumber()
+	require.NoError(t, err)
+	randomNumberHash := bep3.CalculateRandomHash(randomNumber, timestamp)
+	otherRandomNumber, err := bep3.GenerateSecureRandomNumber()
+	require.NoError(t, err)
+	otherRandomNumberHash := bep3.CalculateRandomHash(otherRandomNumber, timestamp)
+
+	t.Run("claim", func(t *testing.T) {
+		mock := NewMockCounterparty(testDeputyOtherChain, deputyCoins)
+		require.NoError(t, mock.CreateSwap(randomNumberHash, timestamp, testRecipientOtherChain, amount, 10))
+		require.Error(t, mock.CreateSwap(randomNumberHash, timestamp, testRecipientOtherChain, amount, 10), "duplicate swap")
+		require.Error(t, mock.CreateSwap(otherRandomNumberHash, timestamp, testRecipientOtherChain, deputyCoins, 10), "insufficient balance")
+		require.Equal(t, deputyCoins.Sub(amount), mock.Balance(testDeputyOtherChain))
+
+		require.Error(t, mock.ClaimSwap(randomNumberHash, otherRandomNumber), "wrong random number")
+		require.Error(t, mock.RefundSwap(randomNumberHash), "not expired")
+		require.NoError(t, mock.ClaimSwap(randomNumberHash, randomNumber))
+		require.Error(t, mock.ClaimSwap(randomNumberHash, randomNumber), "already claimed")
+
+		swap, found, err := mock.GetSwap(randomNumberHash)
+		require.NoError(t, err)
+		require.True(t, found)
+		require.Equal(t, CounterpartyClaimed, swap.Status)
+		require.Equal(t, randomNumber, []byte(swap.RandomNumber))
+		require.Equal(t, amount, mock.Balance(testRecipientOtherChain))
+	})
+
+	t.Run("refund", func(t *testing.T) {
+		mock := NewMockCounterparty(testDeputyOtherChain, deputyCoins)
+		require.NoError(t, mock.CreateSwap(randomNumberHash, timestamp, testRecipientOtherChain, amount, 10))
+
+		mock.AdvanceHeight(9)
+		require.Error(t, mock.RefundSwap(randomNumberHash), "not expired")
+		mock.AdvanceHeight(1)
+		require.Error(t, mock.ClaimSwap(randomNumberHash, randomNumber), "expired")
+		require.NoError(t, mock.RefundSwap(randomNumberHash))
+		require.Error(t, mock.RefundSwap(randomNumberHash), "already refunded")
+
+		swap, _, _ := mock.GetSwap(randomNumberHash)
+		require.Equal(t, CounterpartyRefunded, swap.Status)
+		require.Equal(t, deputyCoins, mock.Balance(testDeputyOtherChain))
+		require.True(t, mock.Balance(testRecipientOtherChain).IsZero())
+	})
+}
@@ -0,0 +1,216 @@
+package main
+
+import (
+	"fmt"
+	"time"
+
+	tmbytes "github.com/tendermint/tendermint/libs/bytes"
+	"github.com/tendermint/tendermint/libs/log"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kava-labs/kava/x/bep3"
+)
+
+// Config holds the settings for a Relayer
+type Config struct {
+	Interval               time.Duration // time between polls of both chains
+	StartHeight            int64         // first kava block to scan for swaps, 0 starts from the latest block
+	CounterpartyHeightSpan int64         // counterparty blocks before a mirrored swap can be refunded
+	MinRemainingHeight     uint64        // kava blocks a swap must have left before expiry for it to be mirrored
+}
+
+// Relayer is a bep3 deputy for swaps from kava to a counterparty chain. Swaps from the counterparty to kava are not relayed.
+// It watches kava for swaps sent to the deputy and mirrors them on the counterparty chain, less the
+// deputy's fee on each asset. When a mirrored swap is claimed the revealed random number is used to claim
+// the kava swap, and when it expires it is refunded and the kava swap is refunded once it expires too.
+type Relayer struct {
+	kava         KavaChain
+	counterparty Counterparty
+	deputy       sdk.AccAddress
+	config       Config
+	logger       log.Logger
+
+	nextHeight int64
+	mirrored   map[string]bep3.AtomicSwap // open kava swaps mirrored on the counterparty, keyed by swap id
+}
+
+// NewRelayer returns a new Relayer for the given deputy address
+func NewRelayer(kava KavaChain, counterparty Counterparty, deputy sdk.AccAddress, config Config, logger log.Logger) *Relayer {
+	return &Relayer{
+		kava:         kava,
+		counterparty: counterparty,
+		deputy:       deputy,
+		config:       config,
+		logger:       logger,
+		nextHeight:   config.StartHeight,
+		mirrored:     make(map[string]bep3.AtomicSwap),
+	}
+}
+
+// Run polls both chains every interval until stop is closed
+func (r *Relayer) Run(stop <-chan struct{}) {
+	ticker := time.NewTicker(r.config.Interval)
+	defer ticker.Stop()
+
+	for {
+		if err := r.Poll(); err != nil {
+			r.logger.Error("poll failed", "err", err)
+		}
+		select {
+		case <-stop:
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Poll mirrors the swaps created on kava since the last poll, then relays the outcome of every mirrored swap
+func (r *Relayer) Poll() error {
+	latest, err := r.kava.LatestHeight()
+	if err != nil {
+		return fmt.Errorf("could not query kava height: %w", err)
+	}
+	if err := r.scanBlocks(latest); err != nil {
+		return err
+	}
+	for id, swap := range r.mirrored {
+		if err := r.relay(swap); err != nil {
+			r.logger.Error("could not relay swap", "swap_id", id, "err", err)
+		}
+	}
+	return nil
+}
+
+// scanBlocks mirrors the swaps created in each block up to latest.
+// Scanning stops at the first block that fails so it is retried on the next poll.
+func (r *Relayer) scanBlocks(latest int64) error {
+	if r.nextHeight <= 0 {
+		r.nextHeight = latest
+	}
+	params, err := r.kava.GetParams()
+	if err != nil {
+		return fmt.Errorf("could not query bep3 params: %w", err)
+	}
+	deputy, found := params.Deputies.Get(r.deputy)
+	if !found {
+		return fmt.Errorf("%s is not a bep3 deputy", r.deputy)
+	}
+
+	for ; r.nextHeight <= latest; r.nextHeight++ {
+		ids, err := r.kava.CreatedSwapIDs(r.nextHeight)
+		if err != nil {
+			return fmt.Errorf("could not query swaps created at height %d: %w", r.nextHeight, err)
+		}
+		for _, id := range ids {
+			swap, err := r.kava.GetSwap(id)
+			if err != nil {
+				return fmt.Errorf("could not query swap %s: %w", id, err)
+			}
+			if err := r.mirror(swap, deputy, params.SupportedAssets, latest); err != nil {
+				return fmt.Errorf("could not mirror swap %s: %w", id, err)
+			}
+		}
+	}
+	return nil
+}
+
+// mirror creates a counterparty swap for an open kava swap sent to the deputy.
+// Swaps that were already mirrored, for example before a restart, are tracked without being created again.
+func (r *Relayer) mirror(swap bep3.AtomicSwap, deputy bep3.DeputyParam, assets bep3.AssetParams, height int64) error {
+	if !swap.Recipient.Equals(r.deputy) || swap.Direction != bep3.Outgoing || !swap.CrossChain || swap.Status != bep3.Open {
+		return nil
+	}
+	id := tmbytes.HexBytes(swap.GetSwapID())
+
+	_, found, err := r.counterparty.GetSwap(swap.RandomNumberHash)
+	if err != nil {
+		return err
+	}
+	if found {
+		r.mirrored[id.String()] = swap
+		return nil
+	}
+
+	// The deputy must be able to claim on kava after the counterparty swap is claimed
+	if swap.ExpireHeight < uint64(height)+r.config.MinRemainingHeight {
+		r.logger.Info("not mirroring swap close to expiry", "swap_id", id, "expire_height", swap.ExpireHeight)
+		return nil
+	}
+
+	// The deputy keeps its fee on each asset
+	fee := sdk.NewCoins()
+	for _, coin := range swap.Amount {
+		asset, found := assets.Get(coin.Denom)
+		if !found {
+			return fmt.Errorf("%s is not a supported asset", coin.Denom)
+		}
+		fee = fee.Add(sdk.NewCoin(coin.Denom, deputy.GetAssetFee(asset).Calculate(coin.Amount)))
+	}
+	amount := swap.Amount.Sub(fee)
+	if err := r.counterparty.CreateSwap(swap.RandomNumberHash, swap.Timestamp, swap.RecipientOtherChain, amount, r.config.CounterpartyHeightSpan); err != nil {
+		return err
+	}
+	r.mirrored[id.String()] = swap
+	r.logger.Info("mirrored swap", "swap_id", id, "random_number_hash", swap.RandomNumberHash, "amount", amount)
+	return nil
+}
+
+// relay claims or refunds a mirrored kava swap according to the counterparty swap,
+// and stops tracking it once the kava swap is closed
+func (r *Relayer) relay(swap bep3.AtomicSwap) error {
+	id := tmbytes.HexBytes(swap.GetSwapID())
+	kavaSwap, err := r.kava.GetSwap(id)
+	if err != nil {
+		return err
+	}
+	if kavaSwap.Status == bep3.Completed {
+		delete(r.mirrored, id.String())
+		return nil
+	}
+
+	cpSwap, found, err := r.counterparty.GetSwap(swap.RandomNumberHash)
+	if err != nil {
+		return err
+	}
+	if !found {
+		return fmt.Errorf("counterparty swap %s not found", swap.RandomNumberHash)
+	}
+
+	switch cpSwap.Status {
+	case CounterpartyClaimed:
+		if kavaSwap.Status != bep3.Open {
+			// The kava swap can no longer be claimed, so stop tracking it instead of failing on every poll
+			r.logger.Error("counterparty swap was claimed but kava swap can no longer be claimed",
+				"swap_id", id, "status", kavaSwap.Status, "random_number_hash", swap.RandomNumberHash,
+				"amount", kavaSwap.Amount, "expire_height", kavaSwap.ExpireHeight)
+			delete(r.mirrored, id.String())
+			return nil
+		}
+		if err := r.kava.ClaimSwap(id, cpSwap.RandomNumber); err != nil {
+			return err
+		}
+		r.logger.Info("claimed swap", "swap_id", id)
+	case CounterpartyOpen:
+		height, err := r.counterparty.Height()
+		if err != nil {
+			return err
+		}
+		if height < cpSwap.ExpireHeight {
+			return nil
+		}
+		if err := r.counterparty.RefundSwap(swap.RandomNumberHash); err != nil {
+			return err
+		}
+		r.logger.Info("refunded counterparty swap", "random_number_hash", swap.RandomNumberHash)
+	case CounterpartyRefunded:
+		if kavaSwap.Status != bep3.Expired {
+			return nil
+		}
+		if err := r.kava.RefundSwap(id); err != nil {
+			return err
+		}
+		r.logger.Info("refunded swap", "swap_id", id)
+	}
+	return nil
+}
@@ -0,0 +1,288 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmbytes "github.com/tendermint/tendermint/libs/bytes"
+	"github.com/tendermint/tendermint/libs/log"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kava-labs/kava/app"
+	"github.com/kava-labs/kava/x/bep3"
+)
+
+const (
+	testSenderOtherChain    = "bnb1uky3me9ggqypmrsvxk7ur6hqkzq7zmv4ed4ng7"
+	testRecipientOtherChain = "bnb1urfermcg92dwq36572cx4xg84wpk3lfpksr5g7"
+	testDeputyOtherChain    = "bnb1xz3xqf4p2ygrw9lhp5g5df4ep4nd20vsywnmpr"
+	testFixedFee            = 10
+	testFeeRate             = 100 // basis points
+	testSwapFee             = 20  // fee on swaps of 1000
+)
+
+// testKava is a KavaChain backed by an in-process app, with a block produced on demand
+type testKava struct {
+	app     app.TestApp
+	ctx     sdk.Context
+	handler sdk.Handler
+	deputy  sdk.AccAddress
+	events  map[int64][]abci.Event
+}
+
+var _ KavaChain = (*testKava)(nil)
+
+func (k *testKava) keeper() bep3.Keeper { return k.app.GetBep3Keeper() }
+
+// nextBlock runs the bep3 begin blocker at the given number of blocks after the current height
+func (k *testKava) nextBlock(blocks int64) {
+	k.ctx = k.ctx.WithBlockHeight(k.ctx.BlockHeight() + blocks).WithBlockTime(k.ctx.BlockTime().Add(time.Duration(blocks) * 6 * time.Second))
+	bep3.BeginBlocker(k.ctx, k.keeper())
+}
+
+// deliver handles msg in the current block, recording its events
+func (k *testKava) deliver(msg sdk.Msg) error {
+	if err := msg.ValidateBasic(); err != nil {
+		return err
+	}
+	res, err := k.handler(k.ctx.WithEventManager(sdk.NewEventManager()), msg)
+	if err != nil {
+		return err
+	}
+	k.events[k.ctx.BlockHeight()] = append(k.events[k.ctx.BlockHeight()], res.Events.ToABCIEvents()...)
+	return nil
+}
+
+func (k *testKava) LatestHeight() (int64, error) { return k.ctx.BlockHeight(), nil }
+
+func (k *testKava) CreatedSwapIDs(height int64) ([]tmbytes.HexBytes, error) {
+	return createdSwapIDs(k.events[height])
+}
+
+func (k *testKava) GetSwap(swapID tmbytes.HexBytes) (bep3.AtomicSwap, error) {
+	swap, found := k.keeper().GetAtomicSwap(k.ctx, swapID)
+	if !found {
+		return bep3.AtomicSwap{}, fmt.Errorf("swap %s not found", swapID)
+	}
+	return swap, nil
+}
+
+func (k *testKava) GetParams() (bep3.Params, error) { return k.keeper().GetParams(k.ctx), nil }
+
+func (k *testKava) ClaimSwap(swapID, randomNumber tmbytes.HexBytes) error {
+	return k.deliver(bep3.NewMsgClaimAtomicSwap(k.deputy, swapID, randomNumber))
+}
+
+func (k *testKava) RefundSwap(swapID tmbytes.HexBytes) error {
+	return k.deliver(bep3.NewMsgRefundAtomicSwap(k.deputy, swapID))
+}
+
+type RelayerTestSuite struct {
+	suite.Suite
+
+	kava         *testKava
+	counterparty *MockCounterparty
+	deputy       sdk.AccAddress
+	otherDeputy  sdk.AccAddress
+	user         sdk.AccAddress
+	config       Config
+}
+
+func (suite *RelayerTestSuite) SetupTest() {
+	config := sdk.GetConfig()
+	app.SetBech32AddressPrefixes(config)
+
+	tApp := app.NewTestApp()
+	_, addrs := app.GeneratePrivKeyAddressPairs(3)
+	suite.deputy, suite.otherDeputy, suite.user = addrs[0], addrs[1], addrs[2]
+
+	coins := sdk.NewCoins(sdk.NewInt64Coin("bnb", 1000000))
+	authGS := app.NewAuthGenState(addrs, []sdk.Coins{coins, coins, coins})
+
+	limit := sdk.NewInt(10000000)
+	bep3GS := bep3.GenesisState{
+		Params: bep3.NewParams(
+			bep3.DeputyParams{
+				bep3.NewDeputyParam(suite.deputy, nil, []string{"bnb"}, sdk.NewCoins(sdk.NewInt64Coin("bnb", 100)), sdk.NewCoins(sdk.NewInt64Coin("bnb", 100000))),
+				bep3.NewDeputyParam(suite.otherDeputy, nil, []string{"bnb"}, sdk.NewCoins(sdk.NewInt64Coin("bnb", 100)), sdk.NewCoins(sdk.NewInt64Coin("bnb", 100000))),
+			},
+			bep3.DefaultMinBlockLock, bep3.DefaultMaxBlockLock,
+			bep3.AssetParams{
+				bep3.NewAssetParam("bnb", 714, limit, true, bep3.DefaultChainID, bep3.AddressFormatBech32, "bnb", 0, sdk.ZeroInt(),
+					bep3.NewAssetFee(sdk.NewInt(testFixedFee), testFeeRate, sdk.ZeroInt())),
+			},
+			false, bep3.DefaultMaxAutoRefunds,
+		),
+		AssetSupplies: bep3.AssetSupplies{
+			bep3.NewAssetSupply("bnb", sdk.NewInt64Coin("bnb", 0), sdk.NewInt64Coin("bnb", 0), sdk.NewInt64Coin("bnb", 3000000), sdk.NewCoin("bnb", limit)),
+		},
+	}
+	tApp.InitializeFromGenesisStates(authGS, app.GenesisState{bep3.ModuleName: bep3.ModuleCdc.MustMarshalJSON(bep3GS)})
+
+	suite.kava = &testKava{
+		app:     tApp,
+		ctx:     tApp.NewContext(true, abci.Header{Height: 1, Time: time.Now().UTC()}),
+		handler: bep3.NewHandler(tApp.GetBep3Keeper()),
+		deputy:  suite.deputy,
+		events:  make(map[int64][]abci.Event),
+	}
+	suite.counterparty = NewMockCounterparty(testDeputyOtherChain, sdk.NewCoins(sdk.NewInt64Coin("bnb", 1000000)))
+	suite.config = Config{
+		Interval:               time.Second,
+		StartHeight:            1,
+		CounterpartyHeightSpan: 50,
+		MinRemainingHeight:     120,
+	}
+}
+
+func (suite *RelayerTestSuite) newRelayer() *Relayer {
+	return NewRelayer(suite.kava, suite.counterparty, suite.deputy, suite.config, log.NewNopLogger())
+}
+
+// createSwap sends a swap from the user to a deputy on kava and returns its id and random number
+func (suite *RelayerTestSuite) createSwap(deputy sdk.AccAddress, amount int64, heightSpan uint64) (tmbytes.HexBytes, tmbytes.HexBytes, tmbytes.HexBytes) {
+	timestamp := suite.kava.ctx.BlockTime().Unix()
+	randomNumber, err := bep3.GenerateSecureRandomNumber()
+	suite.Require().NoError(err)
+	randomNumberHash := bep3.CalculateRandomHash(randomNumber, timestamp)
+
+	msg := bep3.NewMsgCreateAtomicSwap(suite.user, deputy, testRecipientOtherChain, testSenderOtherChain,
+		randomNumberHash, timestamp, sdk.NewCoins(sdk.NewInt64Coin("bnb", amount)), heightSpan)
+	suite.Require().NoError(suite.kava.deliver(msg))
+
+	swapID := bep3.CalculateSwapID(randomNumberHash, suite.user, testSenderOtherChain, bep3.DefaultChainID)
+	return swapID, randomNumberHash, randomNumber
+}
+
+func (suite *RelayerTestSuite) swapStatus(swapID tmbytes.HexBytes) bep3.SwapStatus {
+	swap, err := suite.kava.GetSwap(swapID)
+	suite.Require().NoError(err)
+	return swap.Status
+}
+
+func (suite *RelayerTestSuite) TestPoll_Claim() {
+	swapID, randomNumberHash, randomNumber := suite.createSwap(suite.deputy, 1000, 250)
+	suite.kava.nextBlock(1)
+
+	relayer := suite.newRelayer()
+	suite.Require().NoError(relayer.Poll())
+
+	// The swap is mirrored to the user's counterparty address less the deputy's fee
+	cpSwap, found, err := suite.counterparty.GetSwap(randomNumberHash)
+	suite.Require().NoError(err)
+	suite.Require().True(found)
+	suite.Equal(testRecipientOtherChain, cpSwap.Recipient)
+	suite.Equal(sdk.NewCoins(sdk.NewInt64Coin("bnb", 1000-testSwapFee)), cpSwap.Amount)
+	suite.Equal(int64(1+suite.config.CounterpartyHeightSpan), cpSwap.ExpireHeight)
+	suite.Equal(CounterpartyOpen, cpSwap.Status)
+
+	// Polling again does not mirror the swap twice
+	suite.Require().NoError(relayer.Poll())
+	suite.Equal(sdk.NewCoins(sdk.NewInt64Coin("bnb", 1000000-1000+testSwapFee)), suite.counterparty.Balance(testDeputyOtherChain))
+
+	// A restarted relayer picks up the mirrored swap and relays the claim
+	suite.Require().NoError(suite.counterparty.ClaimSwap(randomNumberHash, randomNumber))
+	relayer = suite.newRelayer()
+	suite.Require().NoError(relayer.Poll())
+	suite.Equal(bep3.Completed, suite.swapStatus(swapID))
+	suite.Equal(sdk.NewCoins(sdk.NewInt64Coin("bnb", 1000000+1000)), suite.kava.app.GetAccountKeeper().GetAccount(suite.kava.ctx, suite.deputy).GetCoins())
+
+	suite.kava.nextBlock(1)
+	suite.Require().NoError(relayer.Poll())
+	suite.Empty(relayer.mirrored)
+}
+
+func (suite *RelayerTestSuite) TestPoll_Refund() {
+	swapID, randomNumberHash, _ := suite.createSwap(suite.deputy, 1000, 250)
+	suite.kava.nextBlock(1)
+
+	relayer := suite.newRelayer()
+	suite.Require().NoError(relayer.Poll())
+
+	// The counterparty swap is refunded once it expires
+	suite.counterparty.AdvanceHeight(suite.config.CounterpartyHeightSpan - 1)
+	suite.Require().NoError(relayer.Poll())
+	cpSwap, _, _ := suite.counterparty.GetSwap(randomNumberHash)
+	suite.Equal(CounterpartyOpen, cpSwap.Status)
+
+	suite.counterparty.AdvanceHeight(1)
+	suite.Require().NoError(relayer.Poll())
+	cpSwap, _, _ = suite.counterparty.GetSwap(randomNumberHash)
+	suite.Equal(CounterpartyRefunded, cpSwap.Status)
+	suite.Equal(sdk.NewCoins(sdk.NewInt64Coin("bnb", 1000000)), suite.counterparty.Balance(testDeputyOtherChain))
+
+	// The kava swap is refunded to the user once it expires
+	suite.Require().NoError(relayer.Poll())
+	suite.Equal(bep3.Open, suite.swapStatus(swapID))
+
+	suite.kava.nextBlock(250)
+	suite.Require().NoError(relayer.Poll())
+	suite.Equal(bep3.Completed, suite.swapStatus(swapID))
+	suite.Equal(sdk.NewCoins(sdk.NewInt64Coin("bnb", 1000000)), suite.kava.app.GetAccountKeeper().GetAccount(suite.kava.ctx, suite.user).GetCoins())
+
+	suite.Require().NoError(relayer.Poll())
+	suite.Empty(relayer.mirrored)
+}
+
+func (suite *RelayerTestSuite) TestPoll_ClaimedAfterExpiry() {
+	swapID, randomNumberHash, randomNumber := suite.createSwap(suite.deputy, 1000, 250)
+	suite.kava.nextBlock(1)
+
+	var logs bytes.Buffer
+	relayer := NewRelayer(suite.kava, suite.counterparty, suite.deputy, suite.config, log.NewTMLogger(log.NewSyncWriter(&logs)))
+	suite.Require().NoError(relayer.Poll())
+	suite.Len(relayer.mirrored, 1)
+
+	// The counterparty swap is claimed after the kava swap has expired
+	suite.kava.nextBlock(250)
+	suite.Equal(bep3.Expired, suite.swapStatus(swapID))
+	suite.Require().NoError(suite.counterparty.ClaimSwap(randomNumberHash, randomNumber))
+
+	// The swap is reported once and no longer tracked
+	suite.Require().NoError(relayer.Poll())
+	suite.Empty(relayer.mirrored)
+	suite.Require().NoError(relayer.Poll())
+	suite.Equal(1, strings.Count(logs.String(), "counterparty swap was claimed but kava swap can no longer be claimed"))
+	suite.Equal(bep3.Expired, suite.swapStatus(swapID))
+}
+
+func (suite *RelayerTestSuite) TestPoll_Ignored() {
+	// Swap to another deputy
+	suite.createSwap(suite.otherDeputy, 1000, 250)
+	// Swap too close to expiry for the deputy to claim after the counterparty swap is claimed
+	suite.kava.nextBlock(1)
+	suite.createSwap(suite.deputy, 1000, 100)
+	// Swap from the deputy to the user
+	suite.kava.nextBlock(1)
+	timestamp := suite.kava.ctx.BlockTime().Unix()
+	randomNumber, err := bep3.GenerateSecureRandomNumber()
+	suite.Require().NoError(err)
+	suite.Require().NoError(suite.kava.deliver(bep3.NewMsgCreateAtomicSwap(suite.deputy, suite.user, testRecipientOtherChain, testSenderOtherChain,
+		bep3.CalculateRandomHash(randomNumber, timestamp), timestamp, sdk.NewCoins(sdk.NewInt64Coin("bnb", 1000)), 250)))
+	suite.kava.nextBlock(1)
+
+	relayer := suite.newRelayer()
+	suite.Require().NoError(relayer.Poll())
+	suite.Empty(relayer.mirrored)
+	suite.Empty(suite.counterparty.swaps)
+	suite.Equal(suite.kava.ctx.BlockHeight()+1, relayer.nextHeight)
+}
+
+func (suite *RelayerTestSuite) TestPoll_NotDeputy() {
+	suite.createSwap(suite.deputy, 1000, 250)
+
+	relayer := NewRelayer(suite.kava, suite.counterparty, suite.user, suite.config, log.NewNopLogger())
+	suite.Require().Error(relayer.Poll())
+	suite.Equal(suite.config.StartHeight, relayer.nextHeight)
+}
+
+func TestRelayerTestSuite(t *testing.T) {
+	suite.Run(t, new(RelayerTestSuite))
+}
@@ -0,0 +1,182 @@
+package main
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"os"
+	"os/signal"
+	"path"
+	"syscall"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/tendermint/tendermint/libs/cli"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+
+	"github.com/kava-labs/kava/app"
+)
+
+// Flags for the oracle daemon
+const (
+	flagSource     = "source"
+	flagSourceFile = "source-file"
+	flagSourceURL  = "source-url"
+	flagTimeout    = "source-timeout"
+	flagInterval   = "interval"
+	flagExpiry     = "expiry"
+	flagDeviation  = "deviation"
+	flagHeartbeat  = "heartbeat"
+	flagMaxRetries = "max-retries"
+	flagRetryDelay = "retry-delay"
+)
+
+func main() {
+	cdc := app.MakeCodec()
+
+	config := sdk.GetConfig()
+	app.SetBech32AddressPrefixes(config)
+	app.SetBip44CoinType(config)
+	config.Seal()
+
+	rootCmd := &cobra.Command{
+		Use:   "kvoracle",
+		Short: "Price oracle daemon that posts prices to the kava pricefeed",
+	}
+	rootCmd.PersistentPreRunE = func(_ *cobra.Command, _ []string) error {
+		return initConfig(rootCmd)
+	}
+
+	rootCmd.AddCommand(
+		runCmd(cdc),
+		flags.LineBreak,
+		version.Cmd,
+	)
+
+	executor := cli.PrepareMainCmd(rootCmd, "KO", app.DefaultCLIHome)
+	if err := executor.Execute(); err != nil {
+		fmt.Printf("Failed executing oracle: %s, exiting...\n", err)
+		os.Exit(1)
+	}
+}
+
+func runCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "run",
+		Short: "poll a price source and post prices for every market the key is an oracle for",
+		Example: `kvoracle run --from oracle --chain-id kava-3 --source http --source-url http://localhost:8080/prices
+kvoracle run --from oracle --chain-id kava-3 --source static --source-file prices.json --deviation 0.005 --heartbeat 10m
+
+Prices are read as a JSON object of market ids to decimal prices, eg {"bnb:usd": "17.25"}.
+Keys are loaded from the kvcli keyring, see --home and --keyring-backend.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := viper.BindPFlags(cmd.Flags()); err != nil {
+				return err
+			}
+			if viper.GetString(flags.FlagFrom) == "" {
+				return errors.New("a key must be provided with --from")
+			}
+			source, err := NewPriceSource(
+				viper.GetString(flagSource),
+				viper.GetString(flagSourceFile),
+				viper.GetString(flagSourceURL),
+				viper.GetDuration(flagTimeout),
+			)
+			if err != nil {
+				return err
+			}
+			deviation, err := sdk.NewDecFromStr(viper.GetString(flagDeviation))
+			if err != nil {
+				return fmt.Errorf("invalid deviation: %w", err)
+			}
+			if deviation.IsNegative() {
+				return fmt.Errorf("deviation must be non-negative: %s", deviation)
+			}
+			interval := viper.GetDuration(flagInterval)
+			if interval <= 0 {
+				return fmt.Errorf("interval must be positive: %s", interval)
+			}
+			expiry := viper.GetDuration(flagExpiry)
+			if expiry <= 0 {
+				return fmt.Errorf("expiry must be positive: %s", expiry)
+			}
+			// prices must be reposted before the last posted price expires
+			heartbeat := viper.GetDuration(flagHeartbeat)
+			if heartbeat <= 0 {
+				return fmt.Errorf("heartbeat must be positive: %s", heartbeat)
+			}
+			if heartbeat >= expiry {
+				return fmt.Errorf("heartbeat must be less than expiry: %s >= %s", heartbeat, expiry)
+			}
+			maxRetries := viper.GetInt(flagMaxRetries)
+			if maxRetries < 0 {
+				return fmt.Errorf("max retries must be non-negative: %d", maxRetries)
+			}
+
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			cliCtx := context.NewCLIContextWithInput(inBuf).WithCodec(cdc)
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(auth.DefaultTxEncoder(cdc))
+
+			logger := log.NewTMLogger(log.NewSyncWriter(os.Stdout)).With("module", "kvoracle")
+			oracle := NewOracle(cliCtx, txBldr, source, Config{
+				Interval:   interval,
+				Expiry:     expiry,
+				Rules:      NewPostingRules(deviation, heartbeat),
+				MaxRetries: maxRetries,
+				RetryDelay: viper.GetDuration(flagRetryDelay),
+			}, logger)
+
+			stop := make(chan struct{})
+			sigs := make(chan os.Signal, 1)
+			signal.Notify(sigs, syscall.SIGINT, syscall.SIGTERM)
+			go func() {
+				<-sigs
+				close(stop)
+			}()
+
+			logger.Info("starting oracle", "oracle", cliCtx.GetFromAddress(), "source", viper.GetString(flagSource))
+			oracle.Run(stop)
+			return nil
+		},
+	}
+
+	cmd.Flags().String(flagSource, SourceHTTP, fmt.Sprintf("price source type (%s|%s)", SourceStatic, SourceHTTP))
+	cmd.Flags().String(flagSourceFile, "", "path to a JSON price file, used by the static source")
+	cmd.Flags().String(flagSourceURL, "", "url of a JSON price endpoint, used by the http source")
+	cmd.Flags().Duration(flagTimeout, 10*time.Second, "timeout for requests to the price source")
+	cmd.Flags().Duration(flagInterval, 30*time.Second, "time between polls of the price source")
+	cmd.Flags().Duration(flagExpiry, time.Hour, "time after which posted prices expire")
+	cmd.Flags().String(flagDeviation, "0.01", "fractional price change from the last posted price that triggers a new post")
+	cmd.Flags().Duration(flagHeartbeat, 15*time.Minute, "maximum time between posts for a market, must be less than expiry")
+	cmd.Flags().Int(flagMaxRetries, 3, "number of times to retry a failed broadcast")
+	cmd.Flags().Duration(flagRetryDelay, 5*time.Second, "time to wait between broadcast retries")
+
+	return flags.PostCommands(cmd)[0]
+}
+
+func initConfig(cmd *cobra.Command) error {
+	home, err := cmd.PersistentFlags().GetString(cli.HomeFlag)
+	if err != nil {
+		return err
+	}
+	cfgFile := path.Join(home, "config", "config.toml")
+
+	if _, err := os.Stat(cfgFile); err == nil {
+		viper.SetConfigFile(cfgFile)
+
+		if err := viper.ReadInConfig(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
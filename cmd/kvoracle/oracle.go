@@ -0,0 +1,188 @@
+package main
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/client/keys"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/auth/client/utils"
+
+	"github.com/kava-labs/kava/x/pricefeed"
+)
+
+// Config holds the settings for an Oracle
+type Config struct {
+	Interval   time.Duration // time between polls of the price source
+	Expiry     time.Duration // validity period of posted prices
+	Rules      PostingRules
+	MaxRetries int           // attempts to broadcast a tx before giving up until the next poll
+	RetryDelay time.Duration // time between broadcast attempts
+}
+
+// Oracle polls a price source and posts prices for every market the signing key is an oracle for
+type Oracle struct {
+	cliCtx context.CLIContext
+	txBldr auth.TxBuilder
+	source PriceSource
+	config Config
+	logger log.Logger
+
+	posted map[string]PostedPrice
+}
+
+// NewOracle returns a new Oracle
+func NewOracle(cliCtx context.CLIContext, txBldr auth.TxBuilder, source PriceSource, config Config, logger log.Logger) *Oracle {
+	return &Oracle{
+		cliCtx: cliCtx,
+		txBldr: txBldr,
+		source: source,
+		config: config,
+		logger: logger,
+		posted: make(map[string]PostedPrice),
+	}
+}
+
+// Run polls the price source every interval until stop is closed
+func (o *Oracle) Run(stop <-chan struct{}) {
+	ticker := time.NewTicker(o.config.Interval)
+	defer ticker.Stop()
+
+	for {
+		if err := o.Poll(time.Now().UTC()); err != nil {
+			o.logger.Error("poll failed", "err", err)
+		}
+		select {
+		case <-stop:
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Poll fetches the latest prices and posts those that satisfy the posting rules in a single MsgPostPrices
+func (o *Oracle) Poll(now time.Time) error {
+	markets, err := o.oracleMarkets()
+	if err != nil {
+		return fmt.Errorf("could not query markets: %w", err)
+	}
+	if len(markets) == 0 {
+		o.logger.Info("key is not an oracle for any active market", "oracle", o.cliCtx.GetFromAddress())
+		return nil
+	}
+	prices, err := o.source.Prices()
+	if err != nil {
+		return fmt.Errorf("could not fetch prices: %w", err)
+	}
+
+	entries := o.buildPriceEntries(markets, prices, now)
+	if len(entries) == 0 {
+		return nil
+	}
+	msg := pricefeed.NewMsgPostPrices(o.cliCtx.GetFromAddress(), entries)
+	if err := o.broadcastWithRetry([]sdk.Msg{msg}); err != nil {
+		return err
+	}
+	for _, entry := range entries {
+		o.posted[entry.MarketID] = PostedPrice{Price: entry.Price, Time: now}
+		o.logger.Info("posted price", "market_id", entry.MarketID, "price", entry.Price)
+	}
+	return nil
+}
+
+// buildPriceEntries returns a price entry for each market with a price that should be posted
+func (o *Oracle) buildPriceEntries(markets []string, prices map[string]sdk.Dec, now time.Time) []pricefeed.PriceEntry {
+	var entries []pricefeed.PriceEntry
+	for _, marketID := range markets {
+		price, found := prices[marketID]
+		if !found {
+			o.logger.Info("no price from source", "market_id", marketID)
+			continue
+		}
+		var last *PostedPrice
+		if p, found := o.posted[marketID]; found {
+			last = &p
+		}
+		if !o.config.Rules.ShouldPost(last, price, now) {
+			continue
+		}
+		entries = append(entries, pricefeed.NewPriceEntry(marketID, price, now.Add(o.config.Expiry)))
+	}
+	return entries
+}
+
+// oracleMarkets returns the ids of the active, oracle-priced markets the from address is an oracle for
+func (o *Oracle) oracleMarkets() ([]string, error) {
+	res, _, err := o.cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", pricefeed.QuerierRoute, pricefeed.QueryMarkets), nil)
+	if err != nil {
+		return nil, err
+	}
+	var markets pricefeed.Markets
+	if err := o.cliCtx.Codec.UnmarshalJSON(res, &markets); err != nil {
+		return nil, err
+	}
+
+	from := o.cliCtx.GetFromAddress()
+	var ids []string
+	for _, market := range markets {
+		if !market.Active || market.IsDerived() {
+			continue
+		}
+		for _, oracle := range market.Oracles {
+			if oracle.Equals(from) {
+				ids = append(ids, market.MarketID)
+				break
+			}
+		}
+	}
+	return ids, nil
+}
+
+// broadcastWithRetry signs and broadcasts msgs, retrying up to the configured number of attempts.
+// The account number and sequence are fetched before every attempt so a failed or
+// concurrently sent tx does not leave the oracle stuck on a stale sequence.
+func (o *Oracle) broadcastWithRetry(msgs []sdk.Msg) error {
+	attempts := o.config.MaxRetries + 1
+	var err error
+	for i := 0; i < attempts; i++ {
+		if i > 0 {
+			o.logger.Info("retrying broadcast", "attempt", i+1, "err", err)
+			time.Sleep(o.config.RetryDelay)
+		}
+		if err = o.broadcast(msgs); err == nil {
+			return nil
+		}
+	}
+	return fmt.Errorf("failed to broadcast after %d attempts: %w", attempts, err)
+}
+
+func (o *Oracle) broadcast(msgs []sdk.Msg) error {
+	num, seq, err := auth.NewAccountRetriever(o.cliCtx).GetAccountNumberSequence(o.cliCtx.GetFromAddress())
+	if err != nil {
+		return err
+	}
+	txBldr := o.txBldr.WithAccountNumber(num).WithSequence(seq)
+	if txBldr.SimulateAndExecute() {
+		txBldr, err = utils.EnrichWithGas(txBldr, o.cliCtx, msgs)
+		if err != nil {
+			return err
+		}
+	}
+
+	txBytes, err := txBldr.BuildAndSign(o.cliCtx.GetFromName(), keys.DefaultKeyPass, msgs)
+	if err != nil {
+		return err
+	}
+	res, err := o.cliCtx.BroadcastTx(txBytes)
+	if err != nil {
+		return err
+	}
+	if res.Code != 0 {
+		return fmt.Errorf("tx %s failed with code %d: %s", res.TxHash, res.Code, res.RawLog)
+	}
+	return nil
+}
@@ -0,0 +1,45 @@
+package main
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// PostedPrice is the last price the oracle posted for a market
+type PostedPrice struct {
+	Price sdk.Dec
+	Time  time.Time
+}
+
+// PostingRules decide when a new price should be posted for a market.
+// A price is posted when it moves more than Deviation (a fraction, eg 0.01 for 1%) away from
+// the last posted price, or when Heartbeat has elapsed since the last post, whichever is first.
+type PostingRules struct {
+	Deviation sdk.Dec
+	Heartbeat time.Duration
+}
+
+// NewPostingRules returns a new PostingRules
+func NewPostingRules(deviation sdk.Dec, heartbeat time.Duration) PostingRules {
+	return PostingRules{
+		Deviation: deviation,
+		Heartbeat: heartbeat,
+	}
+}
+
+// ShouldPost returns true if price should be posted given the last posted price.
+// A nil last price means nothing has been posted for the market yet.
+func (r PostingRules) ShouldPost(last *PostedPrice, price sdk.Dec, now time.Time) bool {
+	if last == nil {
+		return true
+	}
+	if r.Heartbeat > 0 && !now.Before(last.Time.Add(r.Heartbeat)) {
+		return true
+	}
+	if last.Price.IsZero() {
+		return !price.IsZero()
+	}
+	change := price.Sub(last.Price).Quo(last.Price).Abs()
+	return change.GT(r.Deviation)
+}
@@ -0,0 +1,39 @@
+package main
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestPostingRules_ShouldPost(t *testing.T) {
+	now := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	rules := NewPostingRules(sdk.MustNewDecFromStr("0.01"), 10*time.Minute)
+	last := &PostedPrice{Price: sdk.MustNewDecFromStr("100.00"), Time: now}
+
+	testCases := []struct {
+		name   string
+		rules  PostingRules
+		last   *PostedPrice
+		price  sdk.Dec
+		now    time.Time
+		expect bool
+	}{
+		{"nothing posted", rules, nil, sdk.MustNewDecFromStr("100.00"), now, true},
+		{"unchanged", rules, last, sdk.MustNewDecFromStr("100.00"), now.Add(time.Minute), false},
+		{"within deviation", rules, last, sdk.MustNewDecFromStr("100.99"), now.Add(time.Minute), false},
+		{"at deviation", rules, last, sdk.MustNewDecFromStr("101.00"), now.Add(time.Minute), false},
+		{"above deviation", rules, last, sdk.MustNewDecFromStr("101.01"), now.Add(time.Minute), true},
+		{"below deviation", rules, last, sdk.MustNewDecFromStr("98.99"), now.Add(time.Minute), true},
+		{"heartbeat elapsed", rules, last, sdk.MustNewDecFromStr("100.00"), now.Add(10 * time.Minute), true},
+		{"heartbeat disabled", NewPostingRules(sdk.MustNewDecFromStr("0.01"), 0), last, sdk.MustNewDecFromStr("100.00"), now.Add(time.Hour), false},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expect, tc.rules.ShouldPost(tc.last, tc.price, tc.now))
+		})
+	}
+}
@@ -0,0 +1,115 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Price source types selectable from the command line
+const (
+	SourceStatic = "static"
+	SourceHTTP   = "http"
+)
+
+// PriceSource provides the latest prices for a set of markets, keyed by market id
+type PriceSource interface {
+	Prices() (map[string]sdk.Dec, error)
+}
+
+// NewPriceSource returns the price source of the given type
+func NewPriceSource(sourceType, file, url string, timeout time.Duration) (PriceSource, error) {
+	switch sourceType {
+	case SourceStatic:
+		if file == "" {
+			return nil, fmt.Errorf("a file must be provided for the %s price source", SourceStatic)
+		}
+		return NewStaticFileSource(file), nil
+	case SourceHTTP:
+		if url == "" {
+			return nil, fmt.Errorf("a url must be provided for the %s price source", SourceHTTP)
+		}
+		return NewHTTPSource(url, timeout), nil
+	default:
+		return nil, fmt.Errorf("invalid price source %s, must be one of: %s, %s", sourceType, SourceStatic, SourceHTTP)
+	}
+}
+
+// StaticFileSource reads prices from a JSON file, which is re-read on every poll
+type StaticFileSource struct {
+	Path string
+}
+
+var _ PriceSource = StaticFileSource{}
+
+// NewStaticFileSource returns a new StaticFileSource
+func NewStaticFileSource(path string) StaticFileSource {
+	return StaticFileSource{Path: path}
+}
+
+// Prices returns the prices contained in the file
+func (s StaticFileSource) Prices() (map[string]sdk.Dec, error) {
+	bz, err := ioutil.ReadFile(s.Path)
+	if err != nil {
+		return nil, err
+	}
+	return parsePrices(bz)
+}
+
+// HTTPSource fetches prices from an HTTP endpoint returning JSON
+type HTTPSource struct {
+	URL    string
+	Client *http.Client
+}
+
+var _ PriceSource = HTTPSource{}
+
+// NewHTTPSource returns a new HTTPSource
+func NewHTTPSource(url string, timeout time.Duration) HTTPSource {
+	return HTTPSource{
+		URL:    url,
+		Client: &http.Client{Timeout: timeout},
+	}
+}
+
+// Prices returns the prices served by the endpoint
+func (s HTTPSource) Prices() (map[string]sdk.Dec, error) {
+	resp, err := s.Client.Get(s.URL)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("price source %s returned status %d", s.URL, resp.StatusCode)
+	}
+	bz, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	return parsePrices(bz)
+}
+
+// parsePrices parses a JSON object mapping market ids to decimal strings, eg {"bnb:usd": "17.25"}
+func parsePrices(bz []byte) (map[string]sdk.Dec, error) {
+	var raw map[string]string
+	if err := json.Unmarshal(bz, &raw); err != nil {
+		return nil, fmt.Errorf("could not parse prices: %w", err)
+	}
+	prices := make(map[string]sdk.Dec, len(raw))
+	for marketID, value := range raw {
+		price, err := sdk.NewDecFromStr(value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid price for market %s: %w", marketID, err)
+		}
+		if !price.IsPositive() {
+			return nil, fmt.Errorf("price for market %s must be positive: %s", marketID, price)
+		}
+		prices[marketID] = price
+	}
+	return prices, nil
+}
@@ -0,0 +1,67 @@
+package main
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestStaticFileSource(t *testing.T) {
+	dir, err := ioutil.TempDir("", "kvoracle")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "prices.json")
+	require.NoError(t, ioutil.WriteFile(path, []byte(`{"bnb:usd": "17.25", "btc:usd": "9000"}`), 0644))
+
+	prices, err := NewStaticFileSource(path).Prices()
+	require.NoError(t, err)
+	require.Equal(t, map[string]sdk.Dec{
+		"bnb:usd": sdk.MustNewDecFromStr("17.25"),
+		"btc:usd": sdk.MustNewDecFromStr("9000"),
+	}, prices)
+
+	_, err = NewStaticFileSource(filepath.Join(dir, "missing.json")).Prices()
+	require.Error(t, err)
+}
+
+func TestHTTPSource(t *testing.T) {
+	testCases := []struct {
+		name       string
+		status     int
+		body       string
+		expectPass bool
+	}{
+		{"valid", http.StatusOK, `{"bnb:usd": "17.25"}`, true},
+		{"bad status", http.StatusInternalServerError, `{"bnb:usd": "17.25"}`, false},
+		{"invalid json", http.StatusOK, `["17.25"]`, false},
+		{"invalid price", http.StatusOK, `{"bnb:usd": "abc"}`, false},
+		{"negative price", http.StatusOK, `{"bnb:usd": "-1.0"}`, false},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(tc.status)
+				fmt.Fprint(w, tc.body)
+			}))
+			defer server.Close()
+
+			prices, err := NewHTTPSource(server.URL, time.Second).Prices()
+			if tc.expectPass {
+				require.NoError(t, err)
+				require.Equal(t, map[string]sdk.Dec{"bnb:usd": sdk.MustNewDecFromStr("17.25")}, prices)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
@@ -8,98 +8,130 @@ import (
 )
 
 const (
-	AttributeKeyAuctionID     = types.AttributeKeyAuctionID
-	AttributeKeyAuctionType   = types.AttributeKeyAuctionType
-	AttributeKeyBid           = types.AttributeKeyBid
-	AttributeKeyBidder        = types.AttributeKeyBidder
-	AttributeKeyCloseBlock    = types.AttributeKeyCloseBlock
-	AttributeKeyEndTime       = types.AttributeKeyEndTime
-	AttributeKeyLot           = types.AttributeKeyLot
-	AttributeKeyMaxBid        = types.AttributeKeyMaxBid
-	AttributeValueCategory    = types.AttributeValueCategory
-	DefaultBidDuration        = types.DefaultBidDuration
-	DefaultMaxAuctionDuration = types.DefaultMaxAuctionDuration
-	DefaultNextAuctionID      = types.DefaultNextAuctionID
-	DefaultParamspace         = types.DefaultParamspace
-	EventTypeAuctionBid       = types.EventTypeAuctionBid
-	EventTypeAuctionClose     = types.EventTypeAuctionClose
-	EventTypeAuctionStart     = types.EventTypeAuctionStart
-	ModuleName                = types.ModuleName
-	QuerierRoute              = types.QuerierRoute
-	QueryGetAuction           = types.QueryGetAuction
-	QueryGetAuctions          = types.QueryGetAuctions
-	QueryGetParams            = types.QueryGetParams
-	RouterKey                 = types.RouterKey
-	StoreKey                  = types.StoreKey
+	AttributeKeyAuctionID        = types.AttributeKeyAuctionID
+	AttributeKeyAuctionType      = types.AttributeKeyAuctionType
+	AttributeKeyBid              = types.AttributeKeyBid
+	AttributeKeyBidder           = types.AttributeKeyBidder
+	AttributeKeyCloseBlock       = types.AttributeKeyCloseBlock
+	AttributeKeyEndTime          = types.AttributeKeyEndTime
+	AttributeKeyLot              = types.AttributeKeyLot
+	AttributeKeyMaxBid           = types.AttributeKeyMaxBid
+	AttributeKeyRecipient        = types.AttributeKeyRecipient
+	AttributeKeyRestarts         = types.AttributeKeyRestarts
+	AttributeValueCategory       = types.AttributeValueCategory
+	DefaultBidDuration           = types.DefaultBidDuration
+	DefaultMaxAuctionDuration    = types.DefaultMaxAuctionDuration
+	DefaultMaxCollateralRestarts = types.DefaultMaxCollateralRestarts
+	DefaultNextAuctionID         = types.DefaultNextAuctionID
+	DefaultParamspace            = types.DefaultParamspace
+	DefaultSurplusMode           = types.DefaultSurplusMode
+	DefaultSurplusRecipient      = types.DefaultSurplusRecipient
+	EventTypeAuctionBid          = types.EventTypeAuctionBid
+	EventTypeAuctionClose        = types.EventTypeAuctionClose
+	EventTypeAuctionRestart      = types.EventTypeAuctionRestart
+	EventTypeAuctionStart        = types.EventTypeAuctionStart
+	EventTypeAuctionUnsold       = types.EventTypeAuctionUnsold
+	EventTypeSurplusTransfer     = types.EventTypeSurplusTransfer
+	ModuleName                   = types.ModuleName
+	QuerierRoute                 = types.QuerierRoute
+	QueryGetAuction              = types.QueryGetAuction
+	QueryGetAuctions             = types.QueryGetAuctions
+	QueryGetParams               = types.QueryGetParams
+	RouterKey                    = types.RouterKey
+	StoreKey                     = types.StoreKey
+	SurplusModeBurn              = types.SurplusModeBurn
+	SurplusModeCommunityPool     = types.SurplusModeCommunityPool
+	SurplusModeModuleAccount     = types.SurplusModeModuleAccount
+	SurplusModeSkipAuction       = types.SurplusModeSkipAuction
 )
 
 var (
 	// function aliases
-	ModuleAccountInvariants  = keeper.ModuleAccountInvariants
-	NewKeeper                = keeper.NewKeeper
-	NewQuerier               = keeper.NewQuerier
-	RegisterInvariants       = keeper.RegisterInvariants
-	ValidAuctionInvariant    = keeper.ValidAuctionInvariant
-	ValidIndexInvariant      = keeper.ValidIndexInvariant
-	DefaultGenesisState      = types.DefaultGenesisState
-	DefaultParams            = types.DefaultParams
-	GetAuctionByTimeKey      = types.GetAuctionByTimeKey
-	GetAuctionKey            = types.GetAuctionKey
-	NewAuctionWithPhase      = types.NewAuctionWithPhase
-	NewCollateralAuction     = types.NewCollateralAuction
-	NewDebtAuction           = types.NewDebtAuction
-	NewGenesisState          = types.NewGenesisState
-	NewMsgPlaceBid           = types.NewMsgPlaceBid
-	NewParams                = types.NewParams
-	NewQueryAllAuctionParams = types.NewQueryAllAuctionParams
-	NewSurplusAuction        = types.NewSurplusAuction
-	NewWeightedAddresses     = types.NewWeightedAddresses
-	ParamKeyTable            = types.ParamKeyTable
-	RegisterCodec            = types.RegisterCodec
-	Uint64FromBytes          = types.Uint64FromBytes
-	Uint64ToBytes            = types.Uint64ToBytes
+	ModuleAccountInvariants   = keeper.ModuleAccountInvariants
+	NewKeeper                 = keeper.NewKeeper
+	NewQuerier                = keeper.NewQuerier
+	RegisterInvariants        = keeper.RegisterInvariants
+	ValidAuctionInvariant     = keeper.ValidAuctionInvariant
+	ValidIndexInvariant       = keeper.ValidIndexInvariant
+	DefaultGenesisState       = types.DefaultGenesisState
+	DefaultParams             = types.DefaultParams
+	GetAuctionByTimeKey       = types.GetAuctionByTimeKey
+	GetAuctionKey             = types.GetAuctionKey
+	GetParamsFromSubspace     = types.GetParamsFromSubspace
+	LegacyParamKey            = types.LegacyParamKey
+	NewAuctionWithPhase       = types.NewAuctionWithPhase
+	NewCollateralAuction      = types.NewCollateralAuction
+	NewCollateralAuctionParam = types.NewCollateralAuctionParam
+	NewDebtAuction            = types.NewDebtAuction
+	NewGenesisState           = types.NewGenesisState
+	NewMsgPlaceBid            = types.NewMsgPlaceBid
+	NewParams                 = types.NewParams
+	NewQueryAllAuctionParams  = types.NewQueryAllAuctionParams
+	NewSurplusAuction         = types.NewSurplusAuction
+	NewWeightedAddresses      = types.NewWeightedAddresses
+	ParamKeyTable             = types.ParamKeyTable
+	RegisterCodec             = types.RegisterCodec
+	Uint64FromBytes           = types.Uint64FromBytes
+	Uint64ToBytes             = types.Uint64ToBytes
 
 	// variable aliases
-	AuctionByTimeKeyPrefix     = types.AuctionByTimeKeyPrefix
-	AuctionKeyPrefix           = types.AuctionKeyPrefix
-	DefaultIncrement           = types.DefaultIncrement
-	DistantFuture              = types.DistantFuture
-	ErrAuctionHasExpired       = types.ErrAuctionHasExpired
-	ErrAuctionHasNotExpired    = types.ErrAuctionHasNotExpired
-	ErrAuctionNotFound         = types.ErrAuctionNotFound
-	ErrBidTooLarge             = types.ErrBidTooLarge
-	ErrBidTooSmall             = types.ErrBidTooSmall
-	ErrInvalidBidDenom         = types.ErrInvalidBidDenom
-	ErrInvalidInitialAuctionID = types.ErrInvalidInitialAuctionID
-	ErrInvalidLotDenom         = types.ErrInvalidLotDenom
-	ErrLotTooLarge             = types.ErrLotTooLarge
-	ErrLotTooSmall             = types.ErrLotTooSmall
-	ErrUnrecognizedAuctionType = types.ErrUnrecognizedAuctionType
-	KeyBidDuration             = types.KeyBidDuration
-	KeyIncrementCollateral     = types.KeyIncrementCollateral
-	KeyIncrementDebt           = types.KeyIncrementDebt
-	KeyIncrementSurplus        = types.KeyIncrementSurplus
-	KeyMaxAuctionDuration      = types.KeyMaxAuctionDuration
-	ModuleCdc                  = types.ModuleCdc
-	NextAuctionIDKey           = types.NextAuctionIDKey
+	AuctionByTimeKeyPrefix           = types.AuctionByTimeKeyPrefix
+	AuctionKeyPrefix                 = types.AuctionKeyPrefix
+	DefaultCollateralAuctionParams   = types.DefaultCollateralAuctionParams
+	DefaultCollateralRestartDiscount = types.DefaultCollateralRestartDiscount
+	DefaultIncrement                 = types.DefaultIncrement
+	DistantFuture                    = types.DistantFuture
+	ErrAuctionHasExpired             = types.ErrAuctionHasExpired
+	ErrAuctionHasNotExpired          = types.ErrAuctionHasNotExpired
+	ErrAuctionNotFound               = types.ErrAuctionNotFound
+	ErrBidTooLarge                   = types.ErrBidTooLarge
+	ErrBidTooSmall                   = types.ErrBidTooSmall
+	ErrInvalidBidDenom               = types.ErrInvalidBidDenom
+	ErrInvalidInitialAuctionID       = types.ErrInvalidInitialAuctionID
+	ErrInvalidLotDenom               = types.ErrInvalidLotDenom
+	ErrLotTooLarge                   = types.ErrLotTooLarge
+	ErrLotTooSmall                   = types.ErrLotTooSmall
+	ErrSurplusRecipientNotFound      = types.ErrSurplusRecipientNotFound
+	ErrUnrecognizedAuctionType       = types.ErrUnrecognizedAuctionType
+	KeyBidDuration                   = types.KeyBidDuration
+	KeyBidDurationCollateral         = types.KeyBidDurationCollateral
+	KeyBidDurationDebt               = types.KeyBidDurationDebt
+	KeyBidDurationSurplus            = types.KeyBidDurationSurplus
+	KeyCollateralAuctionParams       = types.KeyCollateralAuctionParams
+	KeyCollateralRestartDiscount     = types.KeyCollateralRestartDiscount
+	KeyIncrementCollateral           = types.KeyIncrementCollateral
+	KeyIncrementDebt                 = types.KeyIncrementDebt
+	KeyMaxAuctionDuration            = types.KeyMaxAuctionDuration
+	KeyIncrementSurplus              = types.KeyIncrementSurplus
+	KeyMaxAuctionDurationCollateral  = types.KeyMaxAuctionDurationCollateral
+	KeyMaxAuctionDurationDebt        = types.KeyMaxAuctionDurationDebt
+	KeyMaxAuctionDurationSurplus     = types.KeyMaxAuctionDurationSurplus
+	KeyMaxCollateralRestarts         = types.KeyMaxCollateralRestarts
+	KeySurplusMode                   = types.KeySurplusMode
+	KeySurplusRecipient              = types.KeySurplusRecipient
+	ModuleCdc                        = types.ModuleCdc
+	NextAuctionIDKey                 = types.NextAuctionIDKey
 )
 
 type (
-	Keeper                = keeper.Keeper
-	Auction               = types.Auction
-	AuctionWithPhase      = types.AuctionWithPhase
-	Auctions              = types.Auctions
-	BaseAuction           = types.BaseAuction
-	CollateralAuction     = types.CollateralAuction
-	DebtAuction           = types.DebtAuction
-	GenesisAuction        = types.GenesisAuction
-	GenesisAuctions       = types.GenesisAuctions
-	GenesisState          = types.GenesisState
-	MsgPlaceBid           = types.MsgPlaceBid
-	Params                = types.Params
-	QueryAllAuctionParams = types.QueryAllAuctionParams
-	QueryAuctionParams    = types.QueryAuctionParams
-	SupplyKeeper          = types.SupplyKeeper
-	SurplusAuction        = types.SurplusAuction
-	WeightedAddresses     = types.WeightedAddresses
+	Keeper                  = keeper.Keeper
+	Auction                 = types.Auction
+	AuctionWithPhase        = types.AuctionWithPhase
+	Auctions                = types.Auctions
+	BaseAuction             = types.BaseAuction
+	CollateralAuctionParam  = types.CollateralAuctionParam
+	CollateralAuctionParams = types.CollateralAuctionParams
+	CollateralAuction       = types.CollateralAuction
+	DebtAuction             = types.DebtAuction
+	DistributionKeeper      = types.DistributionKeeper
+	GenesisAuction          = types.GenesisAuction
+	GenesisAuctions         = types.GenesisAuctions
+	GenesisState            = types.GenesisState
+	MsgPlaceBid             = types.MsgPlaceBid
+	Params                  = types.Params
+	QueryAllAuctionParams   = types.QueryAllAuctionParams
+	QueryAuctionParams      = types.QueryAuctionParams
+	SupplyKeeper            = types.SupplyKeeper
+	SurplusAuction          = types.SurplusAuction
+	WeightedAddresses       = types.WeightedAddresses
 )
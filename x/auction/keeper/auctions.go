@@ -12,7 +12,18 @@ import (
 )
 
 // StartSurplusAuction starts a new surplus (forward) auction.
+// If the surplus mode param is set to skip auctions, the lot is sent directly to the surplus recipient, no auction is created, and the returned ID is 0.
 func (k Keeper) StartSurplusAuction(ctx sdk.Context, seller string, lot sdk.Coin, bidDenom string) (uint64, error) {
+	params := k.GetParams(ctx)
+	if params.SurplusMode == types.SurplusModeSkipAuction {
+		if k.supplyKeeper.GetModuleAddress(params.SurplusRecipient) != nil {
+			return 0, k.transferSurplus(ctx, seller, params.SurplusRecipient, lot)
+		}
+		// Params can be changed one key at a time, so the recipient may not be a module account.
+		// Auction the surplus and burn the proceeds instead of returning an error that would halt the cdp begin blocker.
+		k.Logger(ctx).Error(fmt.Sprintf("surplus recipient '%s' is not a module account, auctioning surplus instead", params.SurplusRecipient))
+	}
+
 	auction := types.NewSurplusAuction(
 		seller,
 		lot,
@@ -100,6 +111,9 @@ func (k Keeper) StartCollateralAuction(
 		weightedAddresses,
 		debt,
 	)
+	// collateral auctions that don't receive any bids before they expire are restarted, see CloseAuction
+	// MaxEndTime is left in the distant future, it is set on receipt of the first bid
+	auction.EndTime = ctx.BlockTime().Add(k.GetParams(ctx).GetCollateralAuctionParam(lot.Denom).MaxAuctionDuration)
 
 	// NOTE: for the duration of the auction the auction module account holds the debt and the lot
 	err = k.supplyKeeper.SendCoinsFromModuleToModule(ctx, seller, types.ModuleName, sdk.NewCoins(lot))
@@ -199,12 +213,8 @@ func (k Keeper) PlaceBidSurplus(ctx sdk.Context, a types.SurplusAuction, bidder
 			return a, err
 		}
 	}
-	// Increase in bid is burned
-	err := k.supplyKeeper.SendCoinsFromAccountToModule(ctx, bidder, a.Initiator, sdk.NewCoins(bid.Sub(a.Bid)))
-	if err != nil {
-		return a, err
-	}
-	err = k.supplyKeeper.BurnCoins(ctx, a.Initiator, sdk.NewCoins(bid.Sub(a.Bid)))
+	// Increase in bid is burned, or sent to the surplus recipient
+	err := k.handleSurplusProceeds(ctx, a.Initiator, bidder, bid.Sub(a.Bid))
 	if err != nil {
 		return a, err
 	}
@@ -213,9 +223,9 @@ func (k Keeper) PlaceBidSurplus(ctx sdk.Context, a types.SurplusAuction, bidder
 	a.Bidder = bidder
 	a.Bid = bid
 	if !a.HasReceivedBids {
-		a.MaxEndTime = ctx.BlockTime().Add(k.GetParams(ctx).MaxAuctionDuration) // set maximum ending time on receipt of first bid
+		a.MaxEndTime = ctx.BlockTime().Add(k.GetParams(ctx).MaxAuctionDurationSurplus) // set maximum ending time on receipt of first bid
 	}
-	a.EndTime = earliestTime(ctx.BlockTime().Add(k.GetParams(ctx).BidDuration), a.MaxEndTime) // increment timeout, up to MaxEndTime
+	a.EndTime = earliestTime(ctx.BlockTime().Add(k.GetParams(ctx).BidDurationSurplus), a.MaxEndTime) // increment timeout, up to MaxEndTime
 	a.HasReceivedBids = true
 
 	ctx.EventManager().EmitEvent(
@@ -231,6 +241,50 @@ func (k Keeper) PlaceBidSurplus(ctx sdk.Context, a types.SurplusAuction, bidder
 	return a, nil
 }
 
+// handleSurplusProceeds moves the proceeds of a surplus auction bid according to the surplus mode param.
+func (k Keeper) handleSurplusProceeds(ctx sdk.Context, initiator string, bidder sdk.AccAddress, proceeds sdk.Coin) error {
+	params := k.GetParams(ctx)
+	switch params.SurplusMode {
+	case types.SurplusModeCommunityPool:
+		return k.distrKeeper.FundCommunityPool(ctx, sdk.NewCoins(proceeds), bidder)
+	case types.SurplusModeModuleAccount:
+		if k.supplyKeeper.GetModuleAddress(params.SurplusRecipient) != nil {
+			return k.supplyKeeper.SendCoinsFromAccountToModule(ctx, bidder, params.SurplusRecipient, sdk.NewCoins(proceeds))
+		}
+		// the recipient may not be a module account as params can be changed one key at a time, so burn the proceeds instead
+		k.Logger(ctx).Error(fmt.Sprintf("surplus recipient '%s' is not a module account, burning surplus auction proceeds instead", params.SurplusRecipient))
+		fallthrough
+	default:
+		// surplus auctions are not run in skip auction mode, but bids on auctions started before the mode changed,
+		// or started because the surplus recipient is not a module account, are still burned
+		err := k.supplyKeeper.SendCoinsFromAccountToModule(ctx, bidder, initiator, sdk.NewCoins(proceeds))
+		if err != nil {
+			return err
+		}
+		return k.supplyKeeper.BurnCoins(ctx, initiator, sdk.NewCoins(proceeds))
+	}
+}
+
+// transferSurplus sends surplus directly from the seller module account to the recipient module account, bypassing the auction.
+func (k Keeper) transferSurplus(ctx sdk.Context, seller, recipient string, lot sdk.Coin) error {
+	if k.supplyKeeper.GetModuleAddress(recipient) == nil {
+		return sdkerrors.Wrap(types.ErrSurplusRecipientNotFound, recipient)
+	}
+	err := k.supplyKeeper.SendCoinsFromModuleToModule(ctx, seller, recipient, sdk.NewCoins(lot))
+	if err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSurplusTransfer,
+			sdk.NewAttribute(types.AttributeKeyLot, lot.String()),
+			sdk.NewAttribute(types.AttributeKeyRecipient, recipient),
+		),
+	)
+	return nil
+}
+
 // PlaceForwardBidCollateral places a forward bid on a collateral auction, moving coins and returning the updated auction.
 func (k Keeper) PlaceForwardBidCollateral(ctx sdk.Context, a types.CollateralAuction, bidder sdk.AccAddress, bid sdk.Coin) (types.CollateralAuction, error) {
 	// Validate new bid
@@ -243,7 +297,7 @@ func (k Keeper) PlaceForwardBidCollateral(ctx sdk.Context, a types.CollateralAuc
 	minNewBidAmt := a.Bid.Amount.Add( // new bids must be some % greater than old bid, and at least 1 larger to avoid replacing an old bid at no cost
 		sdk.MaxInt(
 			sdk.NewInt(1),
-			sdk.NewDecFromInt(a.Bid.Amount).Mul(k.GetParams(ctx).IncrementCollateral).RoundInt(),
+			sdk.NewDecFromInt(a.Bid.Amount).Mul(k.GetParams(ctx).GetCollateralAuctionParam(a.Lot.Denom).Increment).RoundInt(),
 		),
 	)
 	minNewBidAmt = sdk.MinInt(minNewBidAmt, a.MaxBid.Amount) // allow new bids to hit MaxBid even though it may be less than the increment %
@@ -289,9 +343,9 @@ func (k Keeper) PlaceForwardBidCollateral(ctx sdk.Context, a types.CollateralAuc
 	a.Bidder = bidder
 	a.Bid = bid
 	if !a.HasReceivedBids {
-		a.MaxEndTime = ctx.BlockTime().Add(k.GetParams(ctx).MaxAuctionDuration) // set maximum ending time on receipt of first bid
+		a.MaxEndTime = ctx.BlockTime().Add(k.GetParams(ctx).GetCollateralAuctionParam(a.Lot.Denom).MaxAuctionDuration) // set maximum ending time on receipt of first bid
 	}
-	a.EndTime = earliestTime(ctx.BlockTime().Add(k.GetParams(ctx).BidDuration), a.MaxEndTime) // increment timeout, up to MaxEndTime
+	a.EndTime = earliestTime(ctx.BlockTime().Add(k.GetParams(ctx).GetCollateralAuctionParam(a.Lot.Denom).BidDuration), a.MaxEndTime) // increment timeout, up to MaxEndTime
 	a.HasReceivedBids = true
 
 	ctx.EventManager().EmitEvent(
@@ -319,7 +373,7 @@ func (k Keeper) PlaceReverseBidCollateral(ctx sdk.Context, a types.CollateralAuc
 	maxNewLotAmt := a.Lot.Amount.Sub( // new lot must be some % less than old lot, and at least 1 smaller to avoid replacing an old bid at no cost
 		sdk.MaxInt(
 			sdk.NewInt(1),
-			sdk.NewDecFromInt(a.Lot.Amount).Mul(k.GetParams(ctx).IncrementCollateral).RoundInt(),
+			sdk.NewDecFromInt(a.Lot.Amount).Mul(k.GetParams(ctx).GetCollateralAuctionParam(a.Lot.Denom).Increment).RoundInt(),
 		),
 	)
 	if lot.Amount.GT(maxNewLotAmt) {
@@ -363,9 +417,9 @@ func (k Keeper) PlaceReverseBidCollateral(ctx sdk.Context, a types.CollateralAuc
 	a.Bidder = bidder
 	a.Lot = lot
 	if !a.HasReceivedBids {
-		a.MaxEndTime = ctx.BlockTime().Add(k.GetParams(ctx).MaxAuctionDuration) // set maximum ending time on receipt of first bid
+		a.MaxEndTime = ctx.BlockTime().Add(k.GetParams(ctx).GetCollateralAuctionParam(a.Lot.Denom).MaxAuctionDuration) // set maximum ending time on receipt of first bid
 	}
-	a.EndTime = earliestTime(ctx.BlockTime().Add(k.GetParams(ctx).BidDuration), a.MaxEndTime) // increment timeout, up to MaxEndTime
+	a.EndTime = earliestTime(ctx.BlockTime().Add(k.GetParams(ctx).GetCollateralAuctionParam(a.Lot.Denom).BidDuration), a.MaxEndTime) // increment timeout, up to MaxEndTime
 	a.HasReceivedBids = true
 
 	ctx.EventManager().EmitEvent(
@@ -429,9 +483,9 @@ func (k Keeper) PlaceBidDebt(ctx sdk.Context, a types.DebtAuction, bidder sdk.Ac
 	a.Bidder = bidder
 	a.Lot = lot
 	if !a.HasReceivedBids {
-		a.MaxEndTime = ctx.BlockTime().Add(k.GetParams(ctx).MaxAuctionDuration) // set maximum ending time on receipt of first bid
+		a.MaxEndTime = ctx.BlockTime().Add(k.GetParams(ctx).MaxAuctionDurationDebt) // set maximum ending time on receipt of first bid
 	}
-	a.EndTime = earliestTime(ctx.BlockTime().Add(k.GetParams(ctx).BidDuration), a.MaxEndTime) // increment timeout, up to MaxEndTime
+	a.EndTime = earliestTime(ctx.BlockTime().Add(k.GetParams(ctx).BidDurationDebt), a.MaxEndTime) // increment timeout, up to MaxEndTime
 	a.HasReceivedBids = true
 
 	ctx.EventManager().EmitEvent(
@@ -470,6 +524,15 @@ func (k Keeper) CloseAuction(ctx sdk.Context, auctionID uint64) error {
 			return err
 		}
 	case types.CollateralAuction:
+		if !auc.HasReceivedBids {
+			if auc.Restarts < k.GetParams(ctx).MaxCollateralRestarts {
+				return k.RestartCollateralAuction(ctx, auc)
+			}
+			if err := k.ReturnUnsoldCollateralAuction(ctx, auc); err != nil {
+				return err
+			}
+			break
+		}
 		if err := k.PayoutCollateralAuction(ctx, auc); err != nil {
 			return err
 		}
@@ -539,6 +602,59 @@ func (k Keeper) PayoutCollateralAuction(ctx sdk.Context, a types.CollateralAucti
 	return nil
 }
 
+// RestartCollateralAuction restarts a collateral auction that closed without receiving any bids.
+// The auction is given a fresh end time and its max bid is reduced by the collateral restart discount.
+func (k Keeper) RestartCollateralAuction(ctx sdk.Context, a types.CollateralAuction) error {
+	params := k.GetParams(ctx)
+
+	// max bid is kept positive so the auction doesn't start in reverse phase
+	discountedMaxBid := sdk.NewDecFromInt(a.MaxBid.Amount).Mul(sdk.OneDec().Sub(params.CollateralRestartDiscount)).TruncateInt()
+	a.MaxBid = sdk.NewCoin(a.MaxBid.Denom, sdk.MaxInt(sdk.OneInt(), discountedMaxBid))
+
+	a.EndTime = ctx.BlockTime().Add(params.GetCollateralAuctionParam(a.Lot.Denom).MaxAuctionDuration)
+	a.Restarts++
+
+	k.SetAuction(ctx, a)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeAuctionRestart,
+			sdk.NewAttribute(types.AttributeKeyAuctionID, fmt.Sprintf("%d", a.ID)),
+			sdk.NewAttribute(types.AttributeKeyRestarts, fmt.Sprintf("%d", a.Restarts)),
+			sdk.NewAttribute(types.AttributeKeyLot, a.Lot.String()),
+			sdk.NewAttribute(types.AttributeKeyMaxBid, a.MaxBid.String()),
+			sdk.NewAttribute(types.AttributeKeyEndTime, fmt.Sprintf("%d", a.EndTime.Unix())),
+		),
+	)
+	return nil
+}
+
+// ReturnUnsoldCollateralAuction sends the lot and any remaining debt of a collateral auction that has used up all its restarts back to the initiator.
+func (k Keeper) ReturnUnsoldCollateralAuction(ctx sdk.Context, a types.CollateralAuction) error {
+	err := k.supplyKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, a.Initiator, sdk.NewCoins(a.Lot))
+	if err != nil {
+		return err
+	}
+
+	if a.CorrespondingDebt.IsPositive() {
+		err = k.supplyKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, a.Initiator, sdk.NewCoins(a.CorrespondingDebt))
+		if err != nil {
+			return err
+		}
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeAuctionUnsold,
+			sdk.NewAttribute(types.AttributeKeyAuctionID, fmt.Sprintf("%d", a.ID)),
+			sdk.NewAttribute(types.AttributeKeyRestarts, fmt.Sprintf("%d", a.Restarts)),
+			sdk.NewAttribute(types.AttributeKeyLot, a.Lot.String()),
+			sdk.NewAttribute(types.AttributeKeyRecipient, a.Initiator),
+		),
+	)
+	return nil
+}
+
 // CloseExpiredAuctions finds all auctions that are past (or at) their ending times and closes them, paying out to the highest bidder.
 func (k Keeper) CloseExpiredAuctions(ctx sdk.Context) error {
 	var expiredAuctions []uint64
@@ -14,8 +14,10 @@ import (
 	abci "github.com/tendermint/tendermint/abci/types"
 
 	"github.com/kava-labs/kava/app"
+	"github.com/kava-labs/kava/x/auction/keeper"
 	"github.com/kava-labs/kava/x/auction/types"
 	"github.com/kava-labs/kava/x/cdp"
+	"github.com/kava-labs/kava/x/kavadist"
 )
 
 func TestSurplusAuctionBasic(t *testing.T) {
@@ -62,6 +64,69 @@ func TestSurplusAuctionBasic(t *testing.T) {
 	tApp.CheckBalance(t, ctx, buyer, cs(c("token1", 120), c("token2", 80)))
 }
 
+func TestSurplusAuctionModes(t *testing.T) {
+	testCases := []struct {
+		name              string
+		mode              string
+		recipient         string
+		expectAuction     bool
+		expectedRecipient sdk.Coins
+		expectedPool      sdk.DecCoins
+	}{
+		{"burn", types.SurplusModeBurn, "", true, nil, nil},
+		{"community pool", types.SurplusModeCommunityPool, "", true, nil, sdk.NewDecCoinsFromCoins(c("token2", 20))},
+		{"module account", types.SurplusModeModuleAccount, kavadist.KavaDistMacc, true, cs(c("token2", 20)), nil},
+		{"skip auction", types.SurplusModeSkipAuction, kavadist.KavaDistMacc, false, cs(c("token1", 20)), nil},
+		{"module account, unknown recipient", types.SurplusModeModuleAccount, "unknown", true, nil, nil},
+		{"skip auction, unknown recipient", types.SurplusModeSkipAuction, "", true, nil, nil},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// Setup
+			_, addrs := app.GeneratePrivKeyAddressPairs(1)
+			buyer := addrs[0]
+			sellerModName := cdp.LiquidatorMacc
+			sellerAddr := supply.NewModuleAddress(sellerModName)
+
+			tApp := app.NewTestApp()
+
+			sellerAcc := supply.NewEmptyModuleAccount(sellerModName, supply.Burner)
+			require.NoError(t, sellerAcc.SetCoins(cs(c("token1", 100), c("token2", 100))))
+			tApp.InitializeFromGenesisStates(
+				NewAuthGenStateFromAccs(authexported.GenesisAccounts{
+					auth.NewBaseAccount(buyer, cs(c("token1", 100), c("token2", 100)), nil, 0, 0),
+					sellerAcc,
+				}),
+			)
+			ctx := tApp.NewContext(false, abci.Header{})
+			keeper := tApp.GetAuctionKeeper()
+			params := keeper.GetParams(ctx)
+			params.SurplusMode = tc.mode
+			params.SurplusRecipient = tc.recipient
+			keeper.SetParams(ctx, params)
+
+			// Create an auction (lot: 20 token1, initialBid: 0 token2)
+			auctionID, err := keeper.StartSurplusAuction(ctx, sellerModName, c("token1", 20), "token2")
+			require.NoError(t, err)
+			tApp.CheckBalance(t, ctx, sellerAddr, cs(c("token1", 80), c("token2", 100)))
+
+			_, found := keeper.GetAuction(ctx, auctionID)
+			require.Equal(t, tc.expectAuction, found)
+			if tc.expectAuction {
+				require.NoError(t, keeper.PlaceBid(ctx, auctionID, buyer, c("token2", 20)))
+				tApp.CheckBalance(t, ctx, buyer, cs(c("token1", 100), c("token2", 80)))
+				// Check seller's coins have not increased
+				tApp.CheckBalance(t, ctx, sellerAddr, cs(c("token1", 80), c("token2", 100)))
+			}
+
+			if tc.expectedRecipient != nil {
+				tApp.CheckBalance(t, ctx, supply.NewModuleAddress(tc.recipient), tc.expectedRecipient)
+			}
+			require.Equal(t, tc.expectedPool, tApp.GetDistrKeeper().GetFeePoolCommunityCoins(ctx))
+		})
+	}
+}
+
 func TestDebtAuctionBasic(t *testing.T) {
 	// Setup
 	_, addrs := app.GeneratePrivKeyAddressPairs(1)
@@ -257,6 +322,142 @@ func TestCollateralAuctionDebtRemaining(t *testing.T) {
 	tApp.CheckBalance(t, ctx, sellerAddr, cs(c("token1", 80), c("token2", 110), c("debt", 100)))
 }
 
+func TestCollateralAuctionRestart(t *testing.T) {
+	// Setup
+	_, addrs := app.GeneratePrivKeyAddressPairs(2)
+	buyer := addrs[0]
+	returnAddrs := addrs[1:]
+	returnWeights := is(30)
+	sellerModName := cdp.LiquidatorMacc
+	sellerAddr := supply.NewModuleAddress(sellerModName)
+
+	tApp := app.NewTestApp()
+	sellerAcc := supply.NewEmptyModuleAccount(sellerModName)
+	require.NoError(t, sellerAcc.SetCoins(cs(c("token1", 100), c("token2", 100), c("debt", 100))))
+	tApp.InitializeFromGenesisStates(
+		NewAuthGenStateFromAccs(authexported.GenesisAccounts{
+			auth.NewBaseAccount(buyer, cs(c("token1", 100), c("token2", 100)), nil, 0, 0),
+			auth.NewBaseAccount(returnAddrs[0], cs(c("token1", 100), c("token2", 100)), nil, 0, 0),
+			sellerAcc,
+		}),
+	)
+	ctx := tApp.NewContext(false, abci.Header{Time: time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)})
+	k := tApp.GetAuctionKeeper()
+	// Override the collateral auction durations for the lot denom
+	params := k.GetParams(ctx)
+	maxAuctionDuration := 6 * time.Hour
+	params.CollateralAuctionParams = types.CollateralAuctionParams{
+		types.NewCollateralAuctionParam("token1", maxAuctionDuration, time.Hour, types.DefaultIncrement),
+	}
+	k.SetParams(ctx, params)
+	checkInvariants := func(ctx sdk.Context) {
+		for _, invariant := range []sdk.Invariant{keeper.ModuleAccountInvariants(k), keeper.ValidAuctionInvariant(k), keeper.ValidIndexInvariant(k)} {
+			msg, broken := invariant(ctx)
+			require.False(t, broken, msg)
+		}
+	}
+
+	// Start auction
+	auctionID, err := k.StartCollateralAuction(ctx, sellerModName, c("token1", 20), c("token2", 50), returnAddrs, returnWeights, c("debt", 40))
+	require.NoError(t, err)
+	tApp.CheckBalance(t, ctx, sellerAddr, cs(c("token1", 80), c("token2", 100), c("debt", 60)))
+
+	// Auctions that receive no bids are restarted with a lower max bid
+	expectedMaxBid := c("token2", 50)
+	for restarts := uint64(1); restarts <= params.MaxCollateralRestarts; restarts++ {
+		ctx = ctx.WithBlockTime(ctx.BlockTime().Add(maxAuctionDuration))
+		require.NoError(t, k.CloseAuction(ctx, auctionID))
+
+		auction, found := k.GetAuction(ctx, auctionID)
+		require.True(t, found)
+		collateralAuction, ok := auction.(types.CollateralAuction)
+		require.True(t, ok)
+		expectedMaxBid = c("token2", sdk.NewDecFromInt(expectedMaxBid.Amount).Mul(sdk.OneDec().Sub(params.CollateralRestartDiscount)).TruncateInt64())
+		require.Equal(t, restarts, collateralAuction.Restarts)
+		require.Equal(t, expectedMaxBid, collateralAuction.MaxBid)
+		require.Equal(t, ctx.BlockTime().Add(maxAuctionDuration), collateralAuction.EndTime)
+		require.Equal(t, types.DistantFuture, collateralAuction.MaxEndTime)
+
+		// Check coins remain in the auction
+		tApp.CheckBalance(t, ctx, sellerAddr, cs(c("token1", 80), c("token2", 100), c("debt", 60)))
+		checkInvariants(ctx)
+	}
+
+	// Once restarts are used up, the lot and debt are returned to the seller
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(maxAuctionDuration))
+	require.NoError(t, k.CloseAuction(ctx, auctionID))
+	_, found := k.GetAuction(ctx, auctionID)
+	require.False(t, found)
+	tApp.CheckBalance(t, ctx, sellerAddr, cs(c("token1", 100), c("token2", 100), c("debt", 100)))
+	tApp.CheckBalance(t, ctx, buyer, cs(c("token1", 100), c("token2", 100)))
+	checkInvariants(ctx)
+}
+
+func TestCollateralAuctionBidsNotCappedByRestartDeadline(t *testing.T) {
+	// Setup
+	_, addrs := app.GeneratePrivKeyAddressPairs(2)
+	buyer := addrs[0]
+	returnAddrs := addrs[1:]
+	returnWeights := is(30)
+	sellerModName := cdp.LiquidatorMacc
+
+	tApp := app.NewTestApp()
+	sellerAcc := supply.NewEmptyModuleAccount(sellerModName)
+	require.NoError(t, sellerAcc.SetCoins(cs(c("token1", 100), c("token2", 100), c("debt", 100))))
+	tApp.InitializeFromGenesisStates(
+		NewAuthGenStateFromAccs(authexported.GenesisAccounts{
+			auth.NewBaseAccount(buyer, cs(c("token1", 100), c("token2", 100)), nil, 0, 0),
+			auth.NewBaseAccount(returnAddrs[0], cs(c("token1", 100), c("token2", 100)), nil, 0, 0),
+			sellerAcc,
+		}),
+	)
+	startTime := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	ctx := tApp.NewContext(false, abci.Header{Time: startTime})
+	k := tApp.GetAuctionKeeper()
+	params := k.GetParams(ctx)
+	maxAuctionDuration := 6 * time.Hour
+	bidDuration := time.Hour
+	params.CollateralAuctionParams = types.CollateralAuctionParams{
+		types.NewCollateralAuctionParam("token1", maxAuctionDuration, bidDuration, types.DefaultIncrement),
+	}
+	k.SetParams(ctx, params)
+
+	// Start auction, only the end time is set to the restart deadline
+	auctionID, err := k.StartCollateralAuction(ctx, sellerModName, c("token1", 20), c("token2", 50), returnAddrs, returnWeights, c("debt", 40))
+	require.NoError(t, err)
+	auction, found := k.GetAuction(ctx, auctionID)
+	require.True(t, found)
+	require.Equal(t, startTime.Add(maxAuctionDuration), auction.GetEndTime())
+	require.Equal(t, types.DistantFuture, auction.(types.CollateralAuction).MaxEndTime)
+
+	// Bid just before the restart deadline
+	ctx = ctx.WithBlockTime(startTime.Add(maxAuctionDuration - time.Minute))
+	require.NoError(t, k.PlaceBid(ctx, auctionID, buyer, c("token2", 2)))
+	firstBidTime := ctx.BlockTime()
+
+	// Keep bidding past the restart deadline until the max end time is reached
+	for i := int64(2); ; i++ {
+		ctx = ctx.WithBlockTime(ctx.BlockTime().Add(bidDuration - time.Minute))
+		auction, found = k.GetAuction(ctx, auctionID)
+		require.True(t, found)
+		if !ctx.BlockTime().Before(auction.GetEndTime()) {
+			break
+		}
+		require.NoError(t, k.PlaceBid(ctx, auctionID, buyer, c("token2", 2*i)))
+	}
+	auction, found = k.GetAuction(ctx, auctionID)
+	require.True(t, found)
+	collateralAuction := auction.(types.CollateralAuction)
+	require.True(t, collateralAuction.EndTime.After(startTime.Add(maxAuctionDuration)))
+	require.Equal(t, firstBidTime.Add(maxAuctionDuration), collateralAuction.MaxEndTime)
+	require.Equal(t, collateralAuction.MaxEndTime, collateralAuction.EndTime)
+
+	// The auction closes at its max end time without being restarted
+	require.NoError(t, k.CloseAuction(ctx, auctionID))
+	_, found = k.GetAuction(ctx, auctionID)
+	require.False(t, found)
+}
+
 func TestStartSurplusAuction(t *testing.T) {
 	someTime := time.Date(1998, time.January, 1, 0, 0, 0, 0, time.UTC)
 	type args struct {
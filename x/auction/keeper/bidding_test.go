@@ -262,7 +262,7 @@ func TestAuctionBidding(t *testing.T) {
 			nil,
 			bidArgs{buyer, c("badtoken", 10)},
 			types.ErrInvalidBidDenom,
-			types.DistantFuture,
+			someTime.Add(types.DefaultMaxAuctionDuration),
 			nil,
 			c("token2", 0),
 			false,
@@ -286,7 +286,7 @@ func TestAuctionBidding(t *testing.T) {
 			nil,
 			bidArgs{buyer, c("token2", 0)},
 			types.ErrBidTooSmall,
-			types.DistantFuture,
+			someTime.Add(types.DefaultMaxAuctionDuration),
 			nil,
 			c("token2", 0),
 			false,
@@ -322,7 +322,7 @@ func TestAuctionBidding(t *testing.T) {
 			nil,
 			bidArgs{buyer, c("token2", 101)},
 			types.ErrBidTooLarge,
-			types.DistantFuture,
+			someTime.Add(types.DefaultMaxAuctionDuration),
 			nil,
 			c("token2", 0),
 			false,
@@ -10,7 +10,7 @@ func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
 	k.paramSubspace.SetParamSet(ctx, &params)
 }
 
-func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
-	k.paramSubspace.GetParamSet(ctx, &params)
-	return
+// GetParams returns the auction params, falling back to the legacy shared durations until the per auction type durations are set.
+func (k Keeper) GetParams(ctx sdk.Context) types.Params {
+	return types.GetParamsFromSubspace(ctx, k.paramSubspace)
 }
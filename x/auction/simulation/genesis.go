@@ -15,6 +15,7 @@ import (
 
 	"github.com/kava-labs/kava/x/auction/types"
 	cdptypes "github.com/kava-labs/kava/x/cdp/types"
+	kavadisttypes "github.com/kava-labs/kava/x/kavadist/types"
 )
 
 const (
@@ -51,6 +52,34 @@ func GenIncrementCollateral(r *rand.Rand) sdk.Dec {
 var GenIncrementDebt = GenIncrementCollateral
 var GenIncrementSurplus = GenIncrementCollateral
 
+// GenCollateralAuctionParams randomly overrides the collateral auction params for the bnb collateral used in the cdp simulation
+func GenCollateralAuctionParams(r *rand.Rand) types.CollateralAuctionParams {
+	if r.Intn(2) == 0 {
+		return types.DefaultCollateralAuctionParams
+	}
+	return types.CollateralAuctionParams{
+		types.NewCollateralAuctionParam("bnb", GenMaxAuctionDuration(r), GenBidDuration(r), GenIncrementCollateral(r)),
+	}
+}
+
+func GenMaxCollateralRestarts(r *rand.Rand) uint64 {
+	return uint64(r.Intn(5))
+}
+
+func GenCollateralRestartDiscount(r *rand.Rand) sdk.Dec {
+	return simulation.RandomDecAmount(r, sdk.MustNewDecFromStr("0.5"))
+}
+
+func GenSurplusMode(r *rand.Rand) string {
+	modes := []string{types.SurplusModeBurn, types.SurplusModeCommunityPool, types.SurplusModeModuleAccount, types.SurplusModeSkipAuction}
+	return modes[r.Intn(len(modes))]
+}
+
+// GenSurplusRecipient returns the kavadist module account, which is always present in the app
+func GenSurplusRecipient(r *rand.Rand) string {
+	return kavadisttypes.KavaDistMacc
+}
+
 // RandomizedGenState generates a random GenesisState for auction
 func RandomizedGenState(simState *module.SimulationState) {
 
@@ -58,8 +87,17 @@ func RandomizedGenState(simState *module.SimulationState) {
 		GenMaxAuctionDuration(simState.Rand),
 		GenBidDuration(simState.Rand),
 		GenIncrementSurplus(simState.Rand),
+		GenMaxAuctionDuration(simState.Rand),
+		GenBidDuration(simState.Rand),
 		GenIncrementDebt(simState.Rand),
+		GenMaxAuctionDuration(simState.Rand),
+		GenBidDuration(simState.Rand),
 		GenIncrementCollateral(simState.Rand),
+		GenCollateralAuctionParams(simState.Rand),
+		GenMaxCollateralRestarts(simState.Rand),
+		GenCollateralRestartDiscount(simState.Rand),
+		GenSurplusMode(simState.Rand),
+		GenSurplusRecipient(simState.Rand),
 	)
 	if err := p.Validate(); err != nil {
 		panic(err)
@@ -125,6 +125,11 @@ func generateBidAmount(
 	bidder authexported.Account, blockTime time.Time) (sdk.Coin, error) {
 	bidderBalance := bidder.SpendableCoins(blockTime)
 
+	// Check auction has not passed its end time, auctions with a DistantFuture end time can expire when the random genesis time is late enough
+	if blockTime.After(auc.GetEndTime()) {
+		return sdk.Coin{}, errorCantReceiveBids
+	}
+
 	switch a := auc.(type) {
 
 	case types.DebtAuction:
@@ -172,7 +177,7 @@ func generateBidAmount(
 		minNewBidAmt := a.Bid.Amount.Add( // new bids must be some % greater than old bid, and at least 1 larger to avoid replacing an old bid at no cost
 			sdk.MaxInt(
 				sdk.NewInt(1),
-				sdk.NewDecFromInt(a.Bid.Amount).Mul(params.IncrementCollateral).RoundInt(),
+				sdk.NewDecFromInt(a.Bid.Amount).Mul(params.GetCollateralAuctionParam(a.Lot.Denom).Increment).RoundInt(),
 			),
 		)
 		minNewBidAmt = sdk.MinInt(minNewBidAmt, a.MaxBid.Amount) // allow new bids to hit MaxBid even though it may be less than the increment %
@@ -188,7 +193,7 @@ func generateBidAmount(
 			maxNewLotAmt := a.Lot.Amount.Sub( // new lot must be some % less than old lot, and at least 1 smaller to avoid replacing an old bid at no cost
 				sdk.MaxInt(
 					sdk.NewInt(1),
-					sdk.NewDecFromInt(a.Lot.Amount).Mul(params.IncrementCollateral).RoundInt(),
+					sdk.NewDecFromInt(a.Lot.Amount).Mul(params.GetCollateralAuctionParam(a.Lot.Denom).Increment).RoundInt(),
 				),
 			)
 			amt, err := RandIntInclusive(r, sdk.ZeroInt(), maxNewLotAmt) // maxNewLotAmt shouldn't be < 0 given the check above
@@ -17,12 +17,32 @@ func ParamChanges(r *rand.Rand) []simulation.ParamChange {
 	// as strings in JSON (such as time.Duration) have the escaped quotes.
 	// TODO should we encode the values properly with ModuleCdc.MustMarshalJSON()?
 	return []simulation.ParamChange{
-		simulation.NewSimParamChange(types.ModuleName, string(types.KeyBidDuration),
+		simulation.NewSimParamChange(types.ModuleName, string(types.KeyBidDurationSurplus),
 			func(r *rand.Rand) string {
 				return fmt.Sprintf("%d", GenBidDuration(r))
 			},
 		),
-		simulation.NewSimParamChange(types.ModuleName, string(types.KeyMaxAuctionDuration),
+		simulation.NewSimParamChange(types.ModuleName, string(types.KeyMaxAuctionDurationSurplus),
+			func(r *rand.Rand) string {
+				return fmt.Sprintf("%d", GenMaxAuctionDuration(r))
+			},
+		),
+		simulation.NewSimParamChange(types.ModuleName, string(types.KeyBidDurationDebt),
+			func(r *rand.Rand) string {
+				return fmt.Sprintf("%d", GenBidDuration(r))
+			},
+		),
+		simulation.NewSimParamChange(types.ModuleName, string(types.KeyMaxAuctionDurationDebt),
+			func(r *rand.Rand) string {
+				return fmt.Sprintf("%d", GenMaxAuctionDuration(r))
+			},
+		),
+		simulation.NewSimParamChange(types.ModuleName, string(types.KeyBidDurationCollateral),
+			func(r *rand.Rand) string {
+				return fmt.Sprintf("%d", GenBidDuration(r))
+			},
+		),
+		simulation.NewSimParamChange(types.ModuleName, string(types.KeyMaxAuctionDurationCollateral),
 			func(r *rand.Rand) string {
 				return fmt.Sprintf("%d", GenMaxAuctionDuration(r))
 			},
@@ -42,5 +62,15 @@ func ParamChanges(r *rand.Rand) []simulation.ParamChange {
 				return fmt.Sprintf("%d", GenIncrementSurplus(r))
 			},
 		),
+		simulation.NewSimParamChange(types.ModuleName, string(types.KeyMaxCollateralRestarts),
+			func(r *rand.Rand) string {
+				return fmt.Sprintf("\"%d\"", GenMaxCollateralRestarts(r))
+			},
+		),
+		simulation.NewSimParamChange(types.ModuleName, string(types.KeyCollateralRestartDiscount),
+			func(r *rand.Rand) string {
+				return fmt.Sprintf("\"%s\"", GenCollateralRestartDiscount(r))
+			},
+		),
 	}
 }
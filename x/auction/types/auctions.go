@@ -207,6 +207,7 @@ type CollateralAuction struct {
 	CorrespondingDebt sdk.Coin          `json:"corresponding_debt" yaml:"corresponding_debt"`
 	MaxBid            sdk.Coin          `json:"max_bid" yaml:"max_bid"`
 	LotReturns        WeightedAddresses `json:"lot_returns" yaml:"lot_returns"`
+	Restarts          uint64            `json:"restarts" yaml:"restarts"` // Number of times the auction has been restarted after closing with no bids.
 }
 
 // WithID returns an auction with the ID set.
@@ -259,10 +260,12 @@ func (a CollateralAuction) String() string {
   End Time:   						%s
 	Max End Time:      			%s
 	Max Bid									%s
-	LotReturns						%s`,
+	LotReturns						%s
+	Restarts						%d`,
 		a.GetID(), a.Initiator, a.Lot,
 		a.Bidder, a.Bid, a.GetEndTime().String(),
 		a.MaxEndTime.String(), a.MaxBid, a.LotReturns,
+		a.Restarts,
 	)
 }
 
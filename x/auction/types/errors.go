@@ -27,4 +27,6 @@ var (
 	ErrLotTooSmall = sdkerrors.Register(ModuleName, 11, "lot is not greater than auction's min new lot amount")
 	// ErrLotTooLarge error for when lot is not smaller than auction's max new lot amount
 	ErrLotTooLarge = sdkerrors.Register(ModuleName, 12, "lot is greater than auction's max new lot amount")
+	// ErrSurplusRecipientNotFound error for when the surplus recipient module account does not exist
+	ErrSurplusRecipientNotFound = sdkerrors.Register(ModuleName, 13, "surplus recipient module account not found")
 )
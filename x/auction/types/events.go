@@ -2,9 +2,12 @@ package types
 
 // Events for the module
 const (
-	EventTypeAuctionStart = "auction_start"
-	EventTypeAuctionBid   = "auction_bid"
-	EventTypeAuctionClose = "auction_close"
+	EventTypeAuctionStart    = "auction_start"
+	EventTypeAuctionBid      = "auction_bid"
+	EventTypeAuctionClose    = "auction_close"
+	EventTypeAuctionRestart  = "auction_restart"
+	EventTypeAuctionUnsold   = "auction_unsold"
+	EventTypeSurplusTransfer = "surplus_transfer"
 
 	AttributeValueCategory  = ModuleName
 	AttributeKeyAuctionID   = "auction_id"
@@ -15,4 +18,6 @@ const (
 	AttributeKeyBid         = "bid"
 	AttributeKeyEndTime     = "end_time"
 	AttributeKeyCloseBlock  = "close_block"
+	AttributeKeyRestarts    = "restarts"
+	AttributeKeyRecipient   = "recipient"
 )
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"errors"
 	"fmt"
+	"strings"
 	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -19,38 +20,98 @@ const (
 	DefaultMaxAuctionDuration time.Duration = 2 * 24 * time.Hour
 	// DefaultBidDuration how long an auction gets extended when someone bids
 	DefaultBidDuration time.Duration = 1 * time.Hour
+	// DefaultMaxCollateralRestarts number of times a collateral auction with no bids is restarted before the lot is returned
+	DefaultMaxCollateralRestarts uint64 = 3
+	// DefaultSurplusMode burns the proceeds of surplus auctions
+	DefaultSurplusMode = SurplusModeBurn
+	// DefaultSurplusRecipient no recipient is needed when surplus auction proceeds are burned
+	DefaultSurplusRecipient = ""
+)
+
+// Surplus modes determine how the cdp system surplus is handled
+const (
+	// SurplusModeBurn sells surplus in auctions and burns the proceeds
+	SurplusModeBurn = "burn"
+	// SurplusModeCommunityPool sells surplus in auctions and sends the proceeds to the community pool
+	SurplusModeCommunityPool = "community_pool"
+	// SurplusModeModuleAccount sells surplus in auctions and sends the proceeds to the SurplusRecipient module account
+	SurplusModeModuleAccount = "module_account"
+	// SurplusModeSkipAuction sends surplus directly to the SurplusRecipient module account without running an auction
+	SurplusModeSkipAuction = "skip_auction"
 )
 
 var (
 	// DefaultIncrement is the smallest percent change a new bid must have from the old one
 	DefaultIncrement sdk.Dec = sdk.MustNewDecFromStr("0.05")
+	// DefaultCollateralRestartDiscount is the percent the max bid of a collateral auction is reduced by when it is restarted
+	DefaultCollateralRestartDiscount sdk.Dec = sdk.MustNewDecFromStr("0.1")
+	// DefaultCollateralAuctionParams no collateral denoms override the collateral auction params
+	DefaultCollateralAuctionParams CollateralAuctionParams = nil
 	// ParamStoreKeyParams Param store key for auction params
-	KeyBidDuration         = []byte("BidDuration")
-	KeyMaxAuctionDuration  = []byte("MaxAuctionDuration")
-	KeyIncrementSurplus    = []byte("IncrementSurplus")
-	KeyIncrementDebt       = []byte("IncrementDebt")
-	KeyIncrementCollateral = []byte("IncrementCollateral")
+	KeyMaxAuctionDurationSurplus    = []byte("MaxAuctionDurationSurplus")
+	KeyBidDurationSurplus           = []byte("BidDurationSurplus")
+	KeyIncrementSurplus             = []byte("IncrementSurplus")
+	KeyMaxAuctionDurationDebt       = []byte("MaxAuctionDurationDebt")
+	KeyBidDurationDebt              = []byte("BidDurationDebt")
+	KeyIncrementDebt                = []byte("IncrementDebt")
+	KeyMaxAuctionDurationCollateral = []byte("MaxAuctionDurationCollateral")
+	KeyBidDurationCollateral        = []byte("BidDurationCollateral")
+	KeyIncrementCollateral          = []byte("IncrementCollateral")
+	KeyCollateralAuctionParams      = []byte("CollateralAuctionParams")
+	KeyMaxCollateralRestarts        = []byte("MaxCollateralRestarts")
+	KeyCollateralRestartDiscount    = []byte("CollateralRestartDiscount")
+	KeySurplusMode                  = []byte("SurplusMode")
+	KeySurplusRecipient             = []byte("SurplusRecipient")
+
+	// KeyMaxAuctionDuration and KeyBidDuration store the durations shared by all auction types before they were
+	// split by auction type. They are no longer written, but are read as fallbacks for the per type durations.
+	KeyMaxAuctionDuration = []byte("MaxAuctionDuration")
+	KeyBidDuration        = []byte("BidDuration")
 )
 
 var _ subspace.ParamSet = &Params{}
 
 // Params is the governance parameters for the auction module.
 type Params struct {
-	MaxAuctionDuration  time.Duration `json:"max_auction_duration" yaml:"max_auction_duration"` // max length of auction
-	BidDuration         time.Duration `json:"bid_duration" yaml:"bid_duration"`                 // additional time added to the auction end time after each bid, capped by the expiry.
-	IncrementSurplus    sdk.Dec       `json:"increment_surplus" yaml:"increment_surplus"`       // percentage change (of auc.Bid) required for a new bid on a surplus auction
-	IncrementDebt       sdk.Dec       `json:"increment_debt" yaml:"increment_debt"`             // percentage change (of auc.Lot) required for a new bid on a debt auction
-	IncrementCollateral sdk.Dec       `json:"increment_collateral" yaml:"increment_collateral"` // percentage change (of auc.Bid or auc.Lot) required for a new bid on a collateral auction
+	MaxAuctionDurationSurplus    time.Duration           `json:"max_auction_duration_surplus" yaml:"max_auction_duration_surplus"`       // max length of a surplus auction
+	BidDurationSurplus           time.Duration           `json:"bid_duration_surplus" yaml:"bid_duration_surplus"`                       // additional time added to a surplus auction end time after each bid, capped by the expiry.
+	IncrementSurplus             sdk.Dec                 `json:"increment_surplus" yaml:"increment_surplus"`                             // percentage change (of auc.Bid) required for a new bid on a surplus auction
+	MaxAuctionDurationDebt       time.Duration           `json:"max_auction_duration_debt" yaml:"max_auction_duration_debt"`             // max length of a debt auction
+	BidDurationDebt              time.Duration           `json:"bid_duration_debt" yaml:"bid_duration_debt"`                             // additional time added to a debt auction end time after each bid, capped by the expiry.
+	IncrementDebt                sdk.Dec                 `json:"increment_debt" yaml:"increment_debt"`                                   // percentage change (of auc.Lot) required for a new bid on a debt auction
+	MaxAuctionDurationCollateral time.Duration           `json:"max_auction_duration_collateral" yaml:"max_auction_duration_collateral"` // max length of a collateral auction
+	BidDurationCollateral        time.Duration           `json:"bid_duration_collateral" yaml:"bid_duration_collateral"`                 // additional time added to a collateral auction end time after each bid, capped by the expiry.
+	IncrementCollateral          sdk.Dec                 `json:"increment_collateral" yaml:"increment_collateral"`                       // percentage change (of auc.Bid or auc.Lot) required for a new bid on a collateral auction
+	CollateralAuctionParams      CollateralAuctionParams `json:"collateral_auction_params" yaml:"collateral_auction_params"`             // per collateral denom overrides of the collateral auction durations and increment
+	MaxCollateralRestarts        uint64                  `json:"max_collateral_restarts" yaml:"max_collateral_restarts"`                 // number of times a collateral auction that receives no bids is restarted before the lot is returned to the initiator
+	CollateralRestartDiscount    sdk.Dec                 `json:"collateral_restart_discount" yaml:"collateral_restart_discount"`         // percentage reduction of auc.MaxBid applied each time a collateral auction is restarted
+	SurplusMode                  string                  `json:"surplus_mode" yaml:"surplus_mode"`                                       // how surplus auction proceeds are handled, or whether surplus auctions are skipped
+	SurplusRecipient             string                  `json:"surplus_recipient" yaml:"surplus_recipient"`                             // module account that receives surplus auction proceeds, or surplus directly when auctions are skipped
 }
 
 // NewParams returns a new Params object.
-func NewParams(maxAuctionDuration, bidDuration time.Duration, incrementSurplus, incrementDebt, incrementCollateral sdk.Dec) Params {
+func NewParams(
+	maxAuctionDurationSurplus, bidDurationSurplus time.Duration, incrementSurplus sdk.Dec,
+	maxAuctionDurationDebt, bidDurationDebt time.Duration, incrementDebt sdk.Dec,
+	maxAuctionDurationCollateral, bidDurationCollateral time.Duration, incrementCollateral sdk.Dec,
+	collateralAuctionParams CollateralAuctionParams, maxCollateralRestarts uint64, collateralRestartDiscount sdk.Dec,
+	surplusMode, surplusRecipient string,
+) Params {
 	return Params{
-		MaxAuctionDuration:  maxAuctionDuration,
-		BidDuration:         bidDuration,
-		IncrementSurplus:    incrementSurplus,
-		IncrementDebt:       incrementDebt,
-		IncrementCollateral: incrementCollateral,
+		MaxAuctionDurationSurplus:    maxAuctionDurationSurplus,
+		BidDurationSurplus:           bidDurationSurplus,
+		IncrementSurplus:             incrementSurplus,
+		MaxAuctionDurationDebt:       maxAuctionDurationDebt,
+		BidDurationDebt:              bidDurationDebt,
+		IncrementDebt:                incrementDebt,
+		MaxAuctionDurationCollateral: maxAuctionDurationCollateral,
+		BidDurationCollateral:        bidDurationCollateral,
+		IncrementCollateral:          incrementCollateral,
+		CollateralAuctionParams:      collateralAuctionParams,
+		MaxCollateralRestarts:        maxCollateralRestarts,
+		CollateralRestartDiscount:    collateralRestartDiscount,
+		SurplusMode:                  surplusMode,
+		SurplusRecipient:             surplusRecipient,
 	}
 }
 
@@ -60,8 +121,17 @@ func DefaultParams() Params {
 		DefaultMaxAuctionDuration,
 		DefaultBidDuration,
 		DefaultIncrement,
+		DefaultMaxAuctionDuration,
+		DefaultBidDuration,
 		DefaultIncrement,
+		DefaultMaxAuctionDuration,
+		DefaultBidDuration,
 		DefaultIncrement,
+		DefaultCollateralAuctionParams,
+		DefaultMaxCollateralRestarts,
+		DefaultCollateralRestartDiscount,
+		DefaultSurplusMode,
+		DefaultSurplusRecipient,
 	)
 }
 
@@ -73,12 +143,62 @@ func ParamKeyTable() subspace.KeyTable {
 // ParamSetPairs implements the ParamSet interface and returns all the key/value pairs.
 func (p *Params) ParamSetPairs() subspace.ParamSetPairs {
 	return subspace.ParamSetPairs{
-		params.NewParamSetPair(KeyBidDuration, &p.BidDuration, validateBidDurationParam),
-		params.NewParamSetPair(KeyMaxAuctionDuration, &p.MaxAuctionDuration, validateMaxAuctionDurationParam),
+		params.NewParamSetPair(KeyMaxAuctionDurationSurplus, &p.MaxAuctionDurationSurplus, validateMaxAuctionDurationParam),
+		params.NewParamSetPair(KeyBidDurationSurplus, &p.BidDurationSurplus, validateBidDurationParam),
 		params.NewParamSetPair(KeyIncrementSurplus, &p.IncrementSurplus, validateIncrementSurplusParam),
+		params.NewParamSetPair(KeyMaxAuctionDurationDebt, &p.MaxAuctionDurationDebt, validateMaxAuctionDurationParam),
+		params.NewParamSetPair(KeyBidDurationDebt, &p.BidDurationDebt, validateBidDurationParam),
 		params.NewParamSetPair(KeyIncrementDebt, &p.IncrementDebt, validateIncrementDebtParam),
+		params.NewParamSetPair(KeyMaxAuctionDurationCollateral, &p.MaxAuctionDurationCollateral, validateMaxAuctionDurationParam),
+		params.NewParamSetPair(KeyBidDurationCollateral, &p.BidDurationCollateral, validateBidDurationParam),
 		params.NewParamSetPair(KeyIncrementCollateral, &p.IncrementCollateral, validateIncrementCollateralParam),
+		params.NewParamSetPair(KeyCollateralAuctionParams, &p.CollateralAuctionParams, validateCollateralAuctionParams),
+		params.NewParamSetPair(KeyMaxCollateralRestarts, &p.MaxCollateralRestarts, validateMaxCollateralRestartsParam),
+		params.NewParamSetPair(KeyCollateralRestartDiscount, &p.CollateralRestartDiscount, validateCollateralRestartDiscountParam),
+		params.NewParamSetPair(KeySurplusMode, &p.SurplusMode, validateSurplusModeParam),
+		params.NewParamSetPair(KeySurplusRecipient, &p.SurplusRecipient, validateSurplusRecipientParam),
+	}
+}
+
+// LegacyParamKey returns the key a param was stored under before the auction durations were split by auction type.
+func LegacyParamKey(key []byte) ([]byte, bool) {
+	switch {
+	case bytes.Equal(key, KeyMaxAuctionDurationSurplus),
+		bytes.Equal(key, KeyMaxAuctionDurationDebt),
+		bytes.Equal(key, KeyMaxAuctionDurationCollateral):
+		return KeyMaxAuctionDuration, true
+	case bytes.Equal(key, KeyBidDurationSurplus),
+		bytes.Equal(key, KeyBidDurationDebt),
+		bytes.Equal(key, KeyBidDurationCollateral):
+		return KeyBidDuration, true
+	default:
+		return nil, false
+	}
+}
+
+// GetParamsFromSubspace reads the auction params from a param subspace. Per auction type durations that have not been set
+// since the durations were split by auction type fall back to the shared durations stored under the legacy keys, and
+// params added since then keep their zero value until they are set.
+func GetParamsFromSubspace(ctx sdk.Context, paramSubspace subspace.Subspace) (p Params) {
+	for _, pair := range p.ParamSetPairs() {
+		key := pair.Key
+		if legacyKey, ok := LegacyParamKey(key); ok && !paramSubspace.Has(ctx, key) {
+			key = legacyKey
+		}
+		paramSubspace.GetIfExists(ctx, key, pair.Value)
+	}
+	return p
+}
+
+// GetCollateralAuctionParam returns the collateral auction durations and increment for a collateral denom.
+// If the denom has no override, the default collateral auction params are returned.
+func (p Params) GetCollateralAuctionParam(denom string) CollateralAuctionParam {
+	for _, cp := range p.CollateralAuctionParams {
+		if cp.Denom == denom {
+			return cp
+		}
 	}
+	return NewCollateralAuctionParam(denom, p.MaxAuctionDurationCollateral, p.BidDurationCollateral, p.IncrementCollateral)
 }
 
 // Equal returns a boolean determining if two Params types are identical.
@@ -91,37 +211,164 @@ func (p Params) Equal(p2 Params) bool {
 // String implements stringer interface
 func (p Params) String() string {
 	return fmt.Sprintf(`Auction Params:
-	Max Auction Duration: %s
-	Bid Duration: %s
+	Max Auction Duration Surplus: %s
+	Bid Duration Surplus: %s
 	Increment Surplus: %s
+	Max Auction Duration Debt: %s
+	Bid Duration Debt: %s
 	Increment Debt: %s
-	Increment Collateral: %s`,
-		p.MaxAuctionDuration, p.BidDuration, p.IncrementSurplus, p.IncrementDebt, p.IncrementCollateral)
+	Max Auction Duration Collateral: %s
+	Bid Duration Collateral: %s
+	Increment Collateral: %s
+	Collateral Auction Params: %s
+	Max Collateral Restarts: %d
+	Collateral Restart Discount: %s
+	Surplus Mode: %s
+	Surplus Recipient: %s`,
+		p.MaxAuctionDurationSurplus, p.BidDurationSurplus, p.IncrementSurplus,
+		p.MaxAuctionDurationDebt, p.BidDurationDebt, p.IncrementDebt,
+		p.MaxAuctionDurationCollateral, p.BidDurationCollateral, p.IncrementCollateral,
+		p.CollateralAuctionParams, p.MaxCollateralRestarts, p.CollateralRestartDiscount, p.SurplusMode, p.SurplusRecipient)
 }
 
 // Validate checks that the parameters have valid values.
 func (p Params) Validate() error {
-	if err := validateBidDurationParam(p.BidDuration); err != nil {
+	if err := validateDurations(p.MaxAuctionDurationSurplus, p.BidDurationSurplus); err != nil {
+		return fmt.Errorf("surplus auction: %w", err)
+	}
+
+	if err := validateIncrementSurplusParam(p.IncrementSurplus); err != nil {
 		return err
 	}
 
-	if err := validateMaxAuctionDurationParam(p.MaxAuctionDuration); err != nil {
+	if err := validateDurations(p.MaxAuctionDurationDebt, p.BidDurationDebt); err != nil {
+		return fmt.Errorf("debt auction: %w", err)
+	}
+
+	if err := validateIncrementDebtParam(p.IncrementDebt); err != nil {
 		return err
 	}
 
-	if p.BidDuration > p.MaxAuctionDuration {
-		return errors.New("bid duration param cannot be larger than max auction duration")
+	if err := validateDurations(p.MaxAuctionDurationCollateral, p.BidDurationCollateral); err != nil {
+		return fmt.Errorf("collateral auction: %w", err)
 	}
 
-	if err := validateIncrementSurplusParam(p.IncrementSurplus); err != nil {
+	if err := validateIncrementCollateralParam(p.IncrementCollateral); err != nil {
 		return err
 	}
 
-	if err := validateIncrementDebtParam(p.IncrementDebt); err != nil {
+	if err := validateCollateralAuctionParams(p.CollateralAuctionParams); err != nil {
+		return err
+	}
+
+	if err := validateMaxCollateralRestartsParam(p.MaxCollateralRestarts); err != nil {
+		return err
+	}
+
+	if err := validateCollateralRestartDiscountParam(p.CollateralRestartDiscount); err != nil {
+		return err
+	}
+
+	if err := validateSurplusModeParam(p.SurplusMode); err != nil {
+		return err
+	}
+
+	if err := validateSurplusRecipientParam(p.SurplusRecipient); err != nil {
+		return err
+	}
+
+	if (p.SurplusMode == SurplusModeModuleAccount || p.SurplusMode == SurplusModeSkipAuction) && p.SurplusRecipient == "" {
+		return fmt.Errorf("surplus recipient cannot be blank for surplus mode %s", p.SurplusMode)
+	}
+
+	return nil
+}
+
+// CollateralAuctionParam overrides the collateral auction durations and increment for a collateral denom.
+type CollateralAuctionParam struct {
+	Denom              string        `json:"denom" yaml:"denom"`                               // denom of the collateral being auctioned (auc.Lot)
+	MaxAuctionDuration time.Duration `json:"max_auction_duration" yaml:"max_auction_duration"` // max length of auction
+	BidDuration        time.Duration `json:"bid_duration" yaml:"bid_duration"`                 // additional time added to the auction end time after each bid, capped by the expiry.
+	Increment          sdk.Dec       `json:"increment" yaml:"increment"`                       // percentage change (of auc.Bid or auc.Lot) required for a new bid
+}
+
+// NewCollateralAuctionParam returns a new CollateralAuctionParam.
+func NewCollateralAuctionParam(denom string, maxAuctionDuration, bidDuration time.Duration, increment sdk.Dec) CollateralAuctionParam {
+	return CollateralAuctionParam{
+		Denom:              denom,
+		MaxAuctionDuration: maxAuctionDuration,
+		BidDuration:        bidDuration,
+		Increment:          increment,
+	}
+}
+
+// String implements fmt.Stringer
+func (cp CollateralAuctionParam) String() string {
+	return fmt.Sprintf(`Collateral Auction Param:
+		Denom: %s
+		Max Auction Duration: %s
+		Bid Duration: %s
+		Increment: %s`,
+		cp.Denom, cp.MaxAuctionDuration, cp.BidDuration, cp.Increment)
+}
+
+// Validate checks that the collateral auction param has valid values.
+func (cp CollateralAuctionParam) Validate() error {
+	if err := sdk.ValidateDenom(cp.Denom); err != nil {
+		return fmt.Errorf("collateral auction param denom invalid: %w", err)
+	}
+	if err := validateDurations(cp.MaxAuctionDuration, cp.BidDuration); err != nil {
+		return fmt.Errorf("collateral auction param %s: %w", cp.Denom, err)
+	}
+	return validateIncrementCollateralParam(cp.Increment)
+}
+
+// CollateralAuctionParams is a slice of CollateralAuctionParam
+type CollateralAuctionParams []CollateralAuctionParam
+
+// String implements fmt.Stringer
+func (caps CollateralAuctionParams) String() string {
+	out := "Collateral Auction Params\n"
+	for _, cp := range caps {
+		out += fmt.Sprintf("%s\n", cp)
+	}
+	return out
+}
+
+func validateCollateralAuctionParams(i interface{}) error {
+	collateralAuctionParams, ok := i.(CollateralAuctionParams)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	denoms := make(map[string]bool)
+	for _, cp := range collateralAuctionParams {
+		if err := cp.Validate(); err != nil {
+			return err
+		}
+		if denoms[cp.Denom] {
+			return fmt.Errorf("duplicate collateral auction param denom %s", cp.Denom)
+		}
+		denoms[cp.Denom] = true
+	}
+
+	return nil
+}
+
+func validateDurations(maxAuctionDuration, bidDuration time.Duration) error {
+	if err := validateBidDurationParam(bidDuration); err != nil {
+		return err
+	}
+
+	if err := validateMaxAuctionDurationParam(maxAuctionDuration); err != nil {
 		return err
 	}
 
-	return validateIncrementCollateralParam(p.IncrementCollateral)
+	if bidDuration > maxAuctionDuration {
+		return errors.New("bid duration param cannot be larger than max auction duration")
+	}
+
+	return nil
 }
 
 func validateBidDurationParam(i interface{}) error {
@@ -200,3 +447,60 @@ func validateIncrementCollateralParam(i interface{}) error {
 
 	return nil
 }
+
+func validateMaxCollateralRestartsParam(i interface{}) error {
+	_, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
+
+func validateCollateralRestartDiscountParam(i interface{}) error {
+	discount, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if discount == emptyDec || discount.IsNil() {
+		return errors.New("collateral restart discount cannot be nil or empty")
+	}
+
+	if discount.IsNegative() {
+		return fmt.Errorf("collateral restart discount cannot be less than zero %s", discount)
+	}
+
+	if discount.GTE(sdk.OneDec()) {
+		return fmt.Errorf("collateral restart discount must be less than one %s", discount)
+	}
+
+	return nil
+}
+
+func validateSurplusModeParam(i interface{}) error {
+	mode, ok := i.(string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	switch mode {
+	case SurplusModeBurn, SurplusModeCommunityPool, SurplusModeModuleAccount, SurplusModeSkipAuction:
+		return nil
+	default:
+		return fmt.Errorf("invalid surplus mode %s", mode)
+	}
+}
+
+func validateSurplusRecipientParam(i interface{}) error {
+	recipient, ok := i.(string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if recipient != strings.TrimSpace(recipient) {
+		return fmt.Errorf("surplus recipient cannot contain leading or trailing whitespace '%s'", recipient)
+	}
+
+	return nil
+}
@@ -8,7 +8,11 @@ import (
 )
 
 func TestParams_Validate(t *testing.T) {
-	type fields struct {
+	// withChanges returns default params with some modifications applied
+	withChanges := func(modify func(*Params)) Params {
+		p := DefaultParams()
+		modify(&p)
+		return p
 	}
 	testCases := []struct {
 		name string
@@ -22,68 +26,110 @@ func TestParams_Validate(t *testing.T) {
 		},
 		{
 			"negativeBid",
-			Params{
-				MaxAuctionDuration:  24 * time.Hour,
-				BidDuration:         -1 * time.Hour,
-				IncrementSurplus:    d("0.05"),
-				IncrementDebt:       d("0.05"),
-				IncrementCollateral: d("0.05"),
-			},
+			withChanges(func(p *Params) { p.BidDurationSurplus = -1 * time.Hour }),
 			true,
 		},
 		{
 			"negativeAuction",
-			Params{
-				MaxAuctionDuration:  -24 * time.Hour,
-				BidDuration:         1 * time.Hour,
-				IncrementSurplus:    d("0.05"),
-				IncrementDebt:       d("0.05"),
-				IncrementCollateral: d("0.05"),
-			},
+			withChanges(func(p *Params) { p.MaxAuctionDurationDebt = -24 * time.Hour }),
 			true,
 		},
 		{
 			"bid>auction",
-			Params{
-				MaxAuctionDuration:  1 * time.Hour,
-				BidDuration:         24 * time.Hour,
-				IncrementSurplus:    d("0.05"),
-				IncrementDebt:       d("0.05"),
-				IncrementCollateral: d("0.05"),
-			},
+			withChanges(func(p *Params) {
+				p.MaxAuctionDurationCollateral = 1 * time.Hour
+				p.BidDurationCollateral = 24 * time.Hour
+			}),
 			true,
 		},
 		{
 			"negative increment surplus",
-			Params{
-				MaxAuctionDuration:  24 * time.Hour,
-				BidDuration:         1 * time.Hour,
-				IncrementSurplus:    d("-0.05"),
-				IncrementDebt:       d("0.05"),
-				IncrementCollateral: d("0.05"),
-			},
+			withChanges(func(p *Params) { p.IncrementSurplus = d("-0.05") }),
 			true,
 		},
 		{
 			"negative increment debt",
-			Params{
-				MaxAuctionDuration:  24 * time.Hour,
-				BidDuration:         1 * time.Hour,
-				IncrementSurplus:    d("0.05"),
-				IncrementDebt:       d("-0.05"),
-				IncrementCollateral: d("0.05"),
-			},
+			withChanges(func(p *Params) { p.IncrementDebt = d("-0.05") }),
 			true,
 		},
 		{
 			"negative increment collateral",
-			Params{
-				MaxAuctionDuration:  24 * time.Hour,
-				BidDuration:         1 * time.Hour,
-				IncrementSurplus:    d("0.05"),
-				IncrementDebt:       d("0.05"),
-				IncrementCollateral: d("-0.05"),
-			},
+			withChanges(func(p *Params) { p.IncrementCollateral = d("-0.05") }),
+			true,
+		},
+		{
+			"collateral auction params",
+			withChanges(func(p *Params) {
+				p.CollateralAuctionParams = CollateralAuctionParams{
+					NewCollateralAuctionParam("bnb", 6*time.Hour, 10*time.Minute, d("0.01")),
+					NewCollateralAuctionParam("btc", 24*time.Hour, 1*time.Hour, d("0.02")),
+				}
+			}),
+			false,
+		},
+		{
+			"collateral auction params bid>auction",
+			withChanges(func(p *Params) {
+				p.CollateralAuctionParams = CollateralAuctionParams{
+					NewCollateralAuctionParam("bnb", 1*time.Hour, 24*time.Hour, d("0.01")),
+				}
+			}),
+			true,
+		},
+		{
+			"collateral auction params nil increment",
+			withChanges(func(p *Params) {
+				p.CollateralAuctionParams = CollateralAuctionParams{
+					{Denom: "bnb", MaxAuctionDuration: 6 * time.Hour, BidDuration: 10 * time.Minute},
+				}
+			}),
+			true,
+		},
+		{
+			"collateral auction params duplicate denom",
+			withChanges(func(p *Params) {
+				p.CollateralAuctionParams = CollateralAuctionParams{
+					NewCollateralAuctionParam("bnb", 6*time.Hour, 10*time.Minute, d("0.01")),
+					NewCollateralAuctionParam("bnb", 24*time.Hour, 1*time.Hour, d("0.02")),
+				}
+			}),
+			true,
+		},
+		{
+			"collateral auction params invalid denom",
+			withChanges(func(p *Params) {
+				p.CollateralAuctionParams = CollateralAuctionParams{
+					NewCollateralAuctionParam("", 6*time.Hour, 10*time.Minute, d("0.01")),
+				}
+			}),
+			true,
+		},
+		{
+			"negative collateral restart discount",
+			withChanges(func(p *Params) { p.CollateralRestartDiscount = d("-0.1") }),
+			true,
+		},
+		{
+			"collateral restart discount of one",
+			withChanges(func(p *Params) { p.CollateralRestartDiscount = d("1") }),
+			true,
+		},
+		{
+			"invalid surplus mode",
+			withChanges(func(p *Params) { p.SurplusMode = "mint" }),
+			true,
+		},
+		{
+			"module account surplus mode",
+			withChanges(func(p *Params) {
+				p.SurplusMode = SurplusModeModuleAccount
+				p.SurplusRecipient = "kavadist"
+			}),
+			false,
+		},
+		{
+			"skip auction surplus mode without recipient",
+			withChanges(func(p *Params) { p.SurplusMode = SurplusModeSkipAuction }),
 			true,
 		},
 		{
@@ -103,3 +149,31 @@ func TestParams_Validate(t *testing.T) {
 		})
 	}
 }
+
+func TestParams_GetCollateralAuctionParam(t *testing.T) {
+	p := DefaultParams()
+	p.CollateralAuctionParams = CollateralAuctionParams{
+		NewCollateralAuctionParam("bnb", 6*time.Hour, 10*time.Minute, d("0.01")),
+	}
+
+	require.Equal(t, NewCollateralAuctionParam("bnb", 6*time.Hour, 10*time.Minute, d("0.01")), p.GetCollateralAuctionParam("bnb"))
+	require.Equal(t,
+		NewCollateralAuctionParam("btc", p.MaxAuctionDurationCollateral, p.BidDurationCollateral, p.IncrementCollateral),
+		p.GetCollateralAuctionParam("btc"),
+	)
+}
+
+func TestLegacyParamKey(t *testing.T) {
+	for _, key := range [][]byte{KeyMaxAuctionDurationSurplus, KeyMaxAuctionDurationDebt, KeyMaxAuctionDurationCollateral} {
+		legacyKey, ok := LegacyParamKey(key)
+		require.True(t, ok)
+		require.Equal(t, KeyMaxAuctionDuration, legacyKey)
+	}
+	for _, key := range [][]byte{KeyBidDurationSurplus, KeyBidDurationDebt, KeyBidDurationCollateral} {
+		legacyKey, ok := LegacyParamKey(key)
+		require.True(t, ok)
+		require.Equal(t, KeyBidDuration, legacyKey)
+	}
+	_, ok := LegacyParamKey(KeyIncrementCollateral)
+	require.False(t, ok)
+}
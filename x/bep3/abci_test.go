@@ -17,12 +17,13 @@ import (
 
 type ABCITestSuite struct {
 	suite.Suite
-	keeper        bep3.Keeper
-	app           app.TestApp
-	ctx           sdk.Context
-	addrs         []sdk.AccAddress
-	swapIDs       []tmbytes.HexBytes
-	randomNumbers []tmbytes.HexBytes
+	keeper             bep3.Keeper
+	app                app.TestApp
+	ctx                sdk.Context
+	addrs              []sdk.AccAddress
+	swapIDs            []tmbytes.HexBytes
+	randomNumbers      []tmbytes.HexBytes
+	randomNumberHashes []tmbytes.HexBytes
 }
 
 func (suite *ABCITestSuite) SetupTest() {
@@ -50,6 +51,7 @@ func (suite *ABCITestSuite) ResetKeeper() {
 
 	var swapIDs []tmbytes.HexBytes
 	var randomNumbers []tmbytes.HexBytes
+	var randomNumberHashes []tmbytes.HexBytes
 	for i := 0; i < 10; i++ {
 		// Set up atomic swap variables
 		expireHeight := uint64(360)
@@ -65,12 +67,14 @@ func (suite *ABCITestSuite) ResetKeeper() {
 		suite.Nil(err)
 
 		// Store swap's calculated ID and secret random number
-		swapID := bep3.CalculateSwapID(randomNumberHash, suite.addrs[0], TestSenderOtherChain)
+		swapID := bep3.CalculateSwapID(randomNumberHash, suite.addrs[0], TestSenderOtherChain, bep3.DefaultChainID)
 		swapIDs = append(swapIDs, swapID)
 		randomNumbers = append(randomNumbers, randomNumber[:])
+		randomNumberHashes = append(randomNumberHashes, randomNumberHash)
 	}
 	suite.swapIDs = swapIDs
 	suite.randomNumbers = randomNumbers
+	suite.randomNumberHashes = randomNumberHashes
 }
 
 func (suite *ABCITestSuite) TestBeginBlocker_UpdateExpiredAtomicSwaps() {
@@ -147,6 +151,33 @@ func (suite *ABCITestSuite) TestBeginBlocker_UpdateExpiredAtomicSwaps() {
 	}
 }
 
+func (suite *ABCITestSuite) TestBeginBlocker_ReleaseIncomingSupply() {
+	expiredCtx := suite.ctx.WithBlockHeight(suite.ctx.BlockHeight() + 400)
+	supply, found := suite.keeper.GetAssetSupply(suite.ctx, []byte("bnb"))
+	suite.Require().True(found)
+	suite.Require().Equal(c("bnb", 1000), supply.IncomingSupply)
+
+	// Expired incoming swaps no longer count towards the incoming supply
+	cacheCtx, _ := expiredCtx.CacheContext()
+	suite.keeper.UpdateExpiredAtomicSwaps(cacheCtx)
+	expiredSupply, found := suite.keeper.GetAssetSupply(cacheCtx, []byte("bnb"))
+	suite.Require().True(found)
+	suite.True(expiredSupply.IncomingSupply.IsZero())
+
+	// An incoming supply that is out of step with the swaps is left unchanged instead of halting the chain
+	supply.IncomingSupply = c("bnb", 150)
+	suite.keeper.SetAssetSupply(expiredCtx, supply, []byte("bnb"))
+	suite.NotPanics(func() { suite.keeper.UpdateExpiredAtomicSwaps(expiredCtx) })
+	for _, swapID := range suite.swapIDs {
+		storedSwap, found := suite.keeper.GetAtomicSwap(expiredCtx, swapID)
+		suite.True(found)
+		suite.Equal(bep3.Expired, storedSwap.Status)
+	}
+	brokenSupply, found := suite.keeper.GetAssetSupply(expiredCtx, []byte("bnb"))
+	suite.Require().True(found)
+	suite.Equal(c("bnb", 50), brokenSupply.IncomingSupply)
+}
+
 func (suite *ABCITestSuite) TestBeginBlocker_DeleteClosedAtomicSwapsFromLongtermStorage() {
 	type Action int
 	const (
@@ -227,13 +258,82 @@ func (suite *ABCITestSuite) TestBeginBlocker_DeleteClosedAtomicSwapsFromLongterm
 			bep3.BeginBlocker(tc.secondCtx, suite.keeper)
 
 			// Check each swap's availibility and status
-			for _, swapID := range suite.swapIDs {
+			for i, swapID := range suite.swapIDs {
 				_, found := suite.keeper.GetAtomicSwap(tc.secondCtx, swapID)
+				// Swaps can be looked up by random number hash until they are deleted
+				lookedUp := suite.keeper.GetAtomicSwapsByRandomNumberHash(tc.secondCtx, suite.randomNumberHashes[i])
 				if tc.expectInStorage {
 					suite.True(found)
+					suite.Len(lookedUp, 1)
 				} else {
 					suite.False(found)
+					suite.Empty(lookedUp)
+				}
+			}
+		})
+	}
+}
+
+func (suite *ABCITestSuite) TestBeginBlocker_RefundExpiredAtomicSwaps() {
+	testCases := []struct {
+		name             string
+		autoRefund       bool
+		maxAutoRefunds   uint64
+		expectedRefunded []int // number of swaps refunded after each begin blocker
+	}{
+		{
+			name:             "auto refund disabled",
+			autoRefund:       false,
+			maxAutoRefunds:   4,
+			expectedRefunded: []int{0, 0, 0},
+		},
+		{
+			name:             "bounded per block",
+			autoRefund:       true,
+			maxAutoRefunds:   4,
+			expectedRefunded: []int{4, 8, 10},
+		},
+		{
+			name:             "all in one block",
+			autoRefund:       true,
+			maxAutoRefunds:   100,
+			expectedRefunded: []int{10, 10, 10},
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.SetupTest()
+		suite.Run(tc.name, func() {
+			params := suite.keeper.GetParams(suite.ctx)
+			params.AutoRefund = tc.autoRefund
+			params.MaxAutoRefunds = tc.maxAutoRefunds
+			suite.keeper.SetParams(suite.ctx, params)
+
+			ak := suite.app.GetAccountKeeper()
+			deputyBalance := ak.GetAccount(suite.ctx, suite.addrs[0]).GetCoins().AmountOf("bnb")
+			swapAmount := sdk.NewInt(100)
+
+			for i, refunded := range tc.expectedRefunded {
+				ctx := suite.ctx.WithBlockHeight(suite.ctx.BlockHeight() + 400 + int64(i)).WithEventManager(sdk.NewEventManager())
+				bep3.BeginBlocker(ctx, suite.keeper)
+
+				completed := 0
+				for _, swapID := range suite.swapIDs {
+					storedSwap, found := suite.keeper.GetAtomicSwap(ctx, swapID)
+					suite.True(found)
+					if storedSwap.Status == bep3.Completed {
+						completed++
+					} else {
+						suite.Equal(bep3.Expired, storedSwap.Status)
+					}
 				}
+				suite.Equal(refunded, completed)
+
+				// Refunded coins are returned to the deputy, expired swaps were already removed from the incoming supply
+				suite.Equal(deputyBalance.Add(swapAmount.MulRaw(int64(refunded))), ak.GetAccount(ctx, suite.addrs[0]).GetCoins().AmountOf("bnb"))
+				supply, found := suite.keeper.GetAssetSupply(ctx, []byte("bnb"))
+				suite.True(found)
+				suite.True(supply.IncomingSupply.IsZero())
 			}
 		})
 	}
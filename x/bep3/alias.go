@@ -7,128 +7,174 @@ import (
 )
 
 const (
-	AddrByteCount                  = types.AddrByteCount
-	AttributeKeyAmount             = types.AttributeKeyAmount
-	AttributeKeyAtomicSwapID       = types.AttributeKeyAtomicSwapID
-	AttributeKeyAtomicSwapIDs      = types.AttributeKeyAtomicSwapIDs
-	AttributeKeyClaimSender        = types.AttributeKeyClaimSender
-	AttributeKeyDirection          = types.AttributeKeyDirection
-	AttributeKeyExpireHeight       = types.AttributeKeyExpireHeight
-	AttributeKeyRandomNumber       = types.AttributeKeyRandomNumber
-	AttributeKeyRandomNumberHash   = types.AttributeKeyRandomNumberHash
-	AttributeKeyRecipient          = types.AttributeKeyRecipient
-	AttributeKeyRefundSender       = types.AttributeKeyRefundSender
-	AttributeKeySender             = types.AttributeKeySender
-	AttributeKeySenderOtherChain   = types.AttributeKeySenderOtherChain
-	AttributeKeyTimestamp          = types.AttributeKeyTimestamp
-	AttributeValueCategory         = types.AttributeValueCategory
-	CalcSwapID                     = types.CalcSwapID
-	ClaimAtomicSwap                = types.ClaimAtomicSwap
-	Completed                      = types.Completed
-	CreateAtomicSwap               = types.CreateAtomicSwap
-	DefaultLongtermStorageDuration = types.DefaultLongtermStorageDuration
-	DefaultParamspace              = types.DefaultParamspace
-	EventTypeClaimAtomicSwap       = types.EventTypeClaimAtomicSwap
-	EventTypeCreateAtomicSwap      = types.EventTypeCreateAtomicSwap
-	EventTypeRefundAtomicSwap      = types.EventTypeRefundAtomicSwap
-	EventTypeSwapsExpired          = types.EventTypeSwapsExpired
-	Expired                        = types.Expired
-	INVALID                        = types.INVALID
-	Incoming                       = types.Incoming
-	Int64Size                      = types.Int64Size
-	MaxExpectedIncomeLength        = types.MaxExpectedIncomeLength
-	MaxOtherChainAddrLength        = types.MaxOtherChainAddrLength
-	ModuleName                     = types.ModuleName
-	NULL                           = types.NULL
-	Open                           = types.Open
-	Outgoing                       = types.Outgoing
-	QuerierRoute                   = types.QuerierRoute
-	QueryGetAssetSupply            = types.QueryGetAssetSupply
-	QueryGetAtomicSwap             = types.QueryGetAtomicSwap
-	QueryGetAtomicSwaps            = types.QueryGetAtomicSwaps
-	QueryGetParams                 = types.QueryGetParams
-	RandomNumberHashLength         = types.RandomNumberHashLength
-	RandomNumberLength             = types.RandomNumberLength
-	RefundAtomicSwap               = types.RefundAtomicSwap
-	RouterKey                      = types.RouterKey
-	StoreKey                       = types.StoreKey
-	SwapIDLength                   = types.SwapIDLength
+	AddrByteCount                            = types.AddrByteCount
+	AddressFormatBech32                      = types.AddressFormatBech32
+	AddressFormatHex                         = types.AddressFormatHex
+	AttributeKeyAmount                       = types.AttributeKeyAmount
+	AttributeKeyAtomicSwapID                 = types.AttributeKeyAtomicSwapID
+	AttributeKeyAtomicSwapIDs                = types.AttributeKeyAtomicSwapIDs
+	AttributeKeyClaimSender                  = types.AttributeKeyClaimSender
+	AttributeKeyDeputy                       = types.AttributeKeyDeputy
+	AttributeKeyDirection                    = types.AttributeKeyDirection
+	AttributeKeyExpireHeight                 = types.AttributeKeyExpireHeight
+	AttributeKeyOtherChainID                 = types.AttributeKeyOtherChainID
+	AttributeKeyRandomNumber                 = types.AttributeKeyRandomNumber
+	AttributeKeyRandomNumberHash             = types.AttributeKeyRandomNumberHash
+	AttributeKeyRecipient                    = types.AttributeKeyRecipient
+	AttributeKeyRefundSender                 = types.AttributeKeyRefundSender
+	AttributeKeySender                       = types.AttributeKeySender
+	AttributeKeySenderOtherChain             = types.AttributeKeySenderOtherChain
+	AttributeKeyTimestamp                    = types.AttributeKeyTimestamp
+	AttributeValueCategory                   = types.AttributeValueCategory
+	CalcSwapID                               = types.CalcSwapID
+	ClaimAtomicSwap                          = types.ClaimAtomicSwap
+	Completed                                = types.Completed
+	CreateAtomicSwap                         = types.CreateAtomicSwap
+	DefaultLongtermStorageDuration           = types.DefaultLongtermStorageDuration
+	DefaultParamspace                        = types.DefaultParamspace
+	EventTypeClaimAtomicSwap                 = types.EventTypeClaimAtomicSwap
+	EventTypeCreateAtomicSwap                = types.EventTypeCreateAtomicSwap
+	EventTypeRefundAtomicSwap                = types.EventTypeRefundAtomicSwap
+	EventTypeSwapsExpired                    = types.EventTypeSwapsExpired
+	Expired                                  = types.Expired
+	INVALID                                  = types.INVALID
+	Incoming                                 = types.Incoming
+	Int64Size                                = types.Int64Size
+	MaxExpectedIncomeLength                  = types.MaxExpectedIncomeLength
+	MaxOtherChainAddrLength                  = types.MaxOtherChainAddrLength
+	ModuleName                               = types.ModuleName
+	NULL                                     = types.NULL
+	Open                                     = types.Open
+	Outgoing                                 = types.Outgoing
+	QuerierRoute                             = types.QuerierRoute
+	QueryGetAssetSupply                      = types.QueryGetAssetSupply
+	QueryGetAtomicSwap                       = types.QueryGetAtomicSwap
+	QueryGetAtomicSwaps                      = types.QueryGetAtomicSwaps
+	QueryGetAtomicSwapsByRandomNumberHash    = types.QueryGetAtomicSwapsByRandomNumberHash
+	QueryGetAtomicSwapsByRecipientOtherChain = types.QueryGetAtomicSwapsByRecipientOtherChain
+	QueryGetAtomicSwapsBySenderOtherChain    = types.QueryGetAtomicSwapsBySenderOtherChain
+	QueryGetParams                           = types.QueryGetParams
+	RandomNumberHashLength                   = types.RandomNumberHashLength
+	RandomNumberLength                       = types.RandomNumberLength
+	RefundAtomicSwap                         = types.RefundAtomicSwap
+	RouterKey                                = types.RouterKey
+	StoreKey                                 = types.StoreKey
+	SwapIDLength                             = types.SwapIDLength
 )
 
 var (
-	NewKeeper                  = keeper.NewKeeper
-	NewQuerier                 = keeper.NewQuerier
-	RegisterRoutes             = rest.RegisterRoutes
-	CalculateRandomHash        = types.CalculateRandomHash
-	CalculateSwapID            = types.CalculateSwapID
-	DefaultGenesisState        = types.DefaultGenesisState
-	DefaultParams              = types.DefaultParams
-	ErrAssetNotActive          = types.ErrAssetNotActive
-	ErrAssetNotSupported       = types.ErrAssetNotSupported
-	ErrAssetSupplyNotFound     = types.ErrAssetSupplyNotFound
-	ErrAtomicSwapAlreadyExists = types.ErrAtomicSwapAlreadyExists
-	ErrAtomicSwapNotFound      = types.ErrAtomicSwapNotFound
-	ErrExceedsAvailableSupply  = types.ErrExceedsAvailableSupply
-	ErrExceedsSupplyLimit      = types.ErrExceedsSupplyLimit
-	ErrInvalidClaimSecret      = types.ErrInvalidClaimSecret
-	ErrInvalidCurrentSupply    = types.ErrInvalidCurrentSupply
-	ErrInvalidHeightSpan       = types.ErrInvalidHeightSpan
-	ErrInvalidIncomingSupply   = types.ErrInvalidIncomingSupply
-	ErrInvalidOutgoingSupply   = types.ErrInvalidOutgoingSupply
-	ErrInvalidTimestamp        = types.ErrInvalidTimestamp
-	ErrSwapNotClaimable        = types.ErrSwapNotClaimable
-	ErrSwapNotRefundable       = types.ErrSwapNotRefundable
-	GenerateSecureRandomNumber = types.GenerateSecureRandomNumber
-	GetAtomicSwapByHeightKey   = types.GetAtomicSwapByHeightKey
-	NewAssetSupply             = types.NewAssetSupply
-	NewAtomicSwap              = types.NewAtomicSwap
-	NewGenesisState            = types.NewGenesisState
-	NewMsgClaimAtomicSwap      = types.NewMsgClaimAtomicSwap
-	NewMsgCreateAtomicSwap     = types.NewMsgCreateAtomicSwap
-	NewMsgRefundAtomicSwap     = types.NewMsgRefundAtomicSwap
-	NewParams                  = types.NewParams
-	NewQueryAssetSupply        = types.NewQueryAssetSupply
-	NewQueryAtomicSwapByID     = types.NewQueryAtomicSwapByID
-	NewQueryAtomicSwaps        = types.NewQueryAtomicSwaps
-	NewSwapDirectionFromString = types.NewSwapDirectionFromString
-	NewSwapStatusFromString    = types.NewSwapStatusFromString
-	ParamKeyTable              = types.ParamKeyTable
-	RegisterCodec              = types.RegisterCodec
+	IncomingSupplyInvariant                = keeper.IncomingSupplyInvariant
+	ModuleAccountInvariant                 = keeper.ModuleAccountInvariant
+	NewKeeper                              = keeper.NewKeeper
+	NewQuerier                             = keeper.NewQuerier
+	OutgoingSupplyInvariant                = keeper.OutgoingSupplyInvariant
+	RegisterInvariants                     = keeper.RegisterInvariants
+	SupplyLimitInvariant                   = keeper.SupplyLimitInvariant
+	ValidIndexesInvariant                  = keeper.ValidIndexesInvariant
+	RegisterRoutes                         = rest.RegisterRoutes
+	CalculateRandomHash                    = types.CalculateRandomHash
+	CalculateSwapID                        = types.CalculateSwapID
+	DefaultGenesisState                    = types.DefaultGenesisState
+	DefaultParams                          = types.DefaultParams
+	ErrAssetNotActive                      = types.ErrAssetNotActive
+	ErrAssetNotSupported                   = types.ErrAssetNotSupported
+	ErrAssetSupplyNotFound                 = types.ErrAssetSupplyNotFound
+	ErrAtomicSwapAlreadyExists             = types.ErrAtomicSwapAlreadyExists
+	ErrAtomicSwapNotFound                  = types.ErrAtomicSwapNotFound
+	ErrExceedsAvailableSupply              = types.ErrExceedsAvailableSupply
+	ErrExceedsSupplyLimit                  = types.ErrExceedsSupplyLimit
+	ErrExceedsTimeWindowLimit              = types.ErrExceedsTimeWindowLimit
+	ErrInvalidClaimSecret                  = types.ErrInvalidClaimSecret
+	ErrInvalidCurrentSupply                = types.ErrInvalidCurrentSupply
+	ErrInvalidDeputy                       = types.ErrInvalidDeputy
+	ErrInvalidHeightSpan                   = types.ErrInvalidHeightSpan
+	ErrInvalidIncomingSupply               = types.ErrInvalidIncomingSupply
+	ErrInvalidOtherChainAddress            = types.ErrInvalidOtherChainAddress
+	ErrInvalidOutgoingSupply               = types.ErrInvalidOutgoingSupply
+	ErrInvalidSwapAmount                   = types.ErrInvalidSwapAmount
+	ErrInvalidTimestamp                    = types.ErrInvalidTimestamp
+	ErrMixedCounterparties                 = types.ErrMixedCounterparties
+	ErrSwapNotClaimable                    = types.ErrSwapNotClaimable
+	ErrSwapNotRefundable                   = types.ErrSwapNotRefundable
+	GenerateSecureRandomNumber             = types.GenerateSecureRandomNumber
+	GetAtomicSwapByHeightKey               = types.GetAtomicSwapByHeightKey
+	GetAtomicSwapByLookupKey               = types.GetAtomicSwapByLookupKey
+	GetLookupPrefix                        = types.GetLookupPrefix
+	NewAssetFee                            = types.NewAssetFee
+	NewAssetParam                          = types.NewAssetParam
+	NewAssetSupply                         = types.NewAssetSupply
+	NewAtomicSwap                          = types.NewAtomicSwap
+	NewDeputyParam                         = types.NewDeputyParam
+	NewGenesisState                        = types.NewGenesisState
+	NewMsgClaimAtomicSwap                  = types.NewMsgClaimAtomicSwap
+	NewMsgCreateAtomicSwap                 = types.NewMsgCreateAtomicSwap
+	NewMsgRefundAtomicSwap                 = types.NewMsgRefundAtomicSwap
+	NewParams                              = types.NewParams
+	NewQueryAssetSupply                    = types.NewQueryAssetSupply
+	NewQueryAtomicSwapByID                 = types.NewQueryAtomicSwapByID
+	NewQueryAtomicSwaps                    = types.NewQueryAtomicSwaps
+	NewQueryAtomicSwapsByOtherChainAddress = types.NewQueryAtomicSwapsByOtherChainAddress
+	NewQueryAtomicSwapsByRandomNumberHash  = types.NewQueryAtomicSwapsByRandomNumberHash
+	NewSupplyIncrease                      = types.NewSupplyIncrease
+	NewSwapDirectionFromString             = types.NewSwapDirectionFromString
+	NewSwapStatusFromString                = types.NewSwapStatusFromString
+	ParamKeyTable                          = types.ParamKeyTable
+	RegisterCodec                          = types.RegisterCodec
 
 	// variable aliases
-	AbsoluteMaximumBlockLock        = types.AbsoluteMaximumBlockLock
-	AbsoluteMinimumBlockLock        = types.AbsoluteMinimumBlockLock
-	AssetSupplyKeyPrefix            = types.AssetSupplyKeyPrefix
-	AtomicSwapByBlockPrefix         = types.AtomicSwapByBlockPrefix
-	AtomicSwapCoinsAccAddr          = types.AtomicSwapCoinsAccAddr
-	AtomicSwapKeyPrefix             = types.AtomicSwapKeyPrefix
-	AtomicSwapLongtermStoragePrefix = types.AtomicSwapLongtermStoragePrefix
-	DefaultMaxBlockLock             = types.DefaultMaxBlockLock
-	DefaultMinBlockLock             = types.DefaultMinBlockLock
-	DefaultSupportedAssets          = types.DefaultSupportedAssets
-	KeyBnbDeputyAddress             = types.KeyBnbDeputyAddress
-	KeyMaxBlockLock                 = types.KeyMaxBlockLock
-	KeyMinBlockLock                 = types.KeyMinBlockLock
-	KeySupportedAssets              = types.KeySupportedAssets
-	ModuleCdc                       = types.ModuleCdc
+	AbsoluteMaximumBlockLock              = types.AbsoluteMaximumBlockLock
+	AbsoluteMinimumBlockLock              = types.AbsoluteMinimumBlockLock
+	AssetSupplyKeyPrefix                  = types.AssetSupplyKeyPrefix
+	AtomicSwapByBlockPrefix               = types.AtomicSwapByBlockPrefix
+	AtomicSwapByRandomNumberHashPrefix    = types.AtomicSwapByRandomNumberHashPrefix
+	AtomicSwapByRecipientOtherChainPrefix = types.AtomicSwapByRecipientOtherChainPrefix
+	AtomicSwapBySenderOtherChainPrefix    = types.AtomicSwapBySenderOtherChainPrefix
+	AtomicSwapCoinsAccAddr                = types.AtomicSwapCoinsAccAddr
+	AtomicSwapExpiredPrefix               = types.AtomicSwapExpiredPrefix
+	AtomicSwapKeyPrefix                   = types.AtomicSwapKeyPrefix
+	AtomicSwapLongtermStoragePrefix       = types.AtomicSwapLongtermStoragePrefix
+	DefaultAutoRefund                     = types.DefaultAutoRefund
+	DefaultChainID                        = types.DefaultChainID
+	DefaultFee                            = types.DefaultFee
+	DefaultMaxAutoRefunds                 = types.DefaultMaxAutoRefunds
+	DefaultMaxBlockLock                   = types.DefaultMaxBlockLock
+	DefaultMaxSwapAmount                  = types.DefaultMaxSwapAmount
+	DefaultMinBlockLock                   = types.DefaultMinBlockLock
+	DefaultMinSwapAmount                  = types.DefaultMinSwapAmount
+	DefaultSupportedAssets                = types.DefaultSupportedAssets
+	KeyAutoRefund                         = types.KeyAutoRefund
+	KeyDeputies                           = types.KeyDeputies
+	KeyMaxAutoRefunds                     = types.KeyMaxAutoRefunds
+	KeyMaxBlockLock                       = types.KeyMaxBlockLock
+	KeyMinBlockLock                       = types.KeyMinBlockLock
+	KeySupportedAssets                    = types.KeySupportedAssets
+	MaxFeeRate                            = types.MaxFeeRate
+	ModuleCdc                             = types.ModuleCdc
 )
 
 type (
-	Keeper              = keeper.Keeper
-	AssetParam          = types.AssetParam
-	AssetParams         = types.AssetParams
-	AssetSupplies       = types.AssetSupplies
-	AssetSupply         = types.AssetSupply
-	AtomicSwap          = types.AtomicSwap
-	AtomicSwaps         = types.AtomicSwaps
-	GenesisState        = types.GenesisState
-	MsgClaimAtomicSwap  = types.MsgClaimAtomicSwap
-	MsgCreateAtomicSwap = types.MsgCreateAtomicSwap
-	MsgRefundAtomicSwap = types.MsgRefundAtomicSwap
-	Params              = types.Params
-	QueryAssetSupply    = types.QueryAssetSupply
-	QueryAtomicSwapByID = types.QueryAtomicSwapByID
-	QueryAtomicSwaps    = types.QueryAtomicSwaps
-	SwapDirection       = types.SwapDirection
-	SwapStatus          = types.SwapStatus
+	Keeper                              = keeper.Keeper
+	AssetFee                            = types.AssetFee
+	AssetParam                          = types.AssetParam
+	AssetParams                         = types.AssetParams
+	AssetSupplies                       = types.AssetSupplies
+	AssetSupply                         = types.AssetSupply
+	AtomicSwap                          = types.AtomicSwap
+	AtomicSwaps                         = types.AtomicSwaps
+	DeputyParam                         = types.DeputyParam
+	DeputyParams                        = types.DeputyParams
+	GenesisState                        = types.GenesisState
+	MsgClaimAtomicSwap                  = types.MsgClaimAtomicSwap
+	MsgCreateAtomicSwap                 = types.MsgCreateAtomicSwap
+	MsgRefundAtomicSwap                 = types.MsgRefundAtomicSwap
+	Params                              = types.Params
+	QueryAssetSupply                    = types.QueryAssetSupply
+	QueryAtomicSwapByID                 = types.QueryAtomicSwapByID
+	QueryAtomicSwaps                    = types.QueryAtomicSwaps
+	QueryAtomicSwapsByOtherChainAddress = types.QueryAtomicSwapsByOtherChainAddress
+	QueryAtomicSwapsByRandomNumberHash  = types.QueryAtomicSwapsByRandomNumberHash
+	SupplyIncrease                      = types.SupplyIncrease
+	SupplyIncreases                     = types.SupplyIncreases
+	SwapDirection                       = types.SwapDirection
+	SwapStatus                          = types.SwapStatus
 )
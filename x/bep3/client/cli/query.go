@@ -24,6 +24,7 @@ const (
 	flagExpiration = "expiration"
 	flagStatus     = "status"
 	flagDirection  = "direction"
+	flagOtherChain = "other-chain-id"
 )
 
 // GetQueryCmd returns the cli query commands for this module
@@ -44,6 +45,9 @@ func GetQueryCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
 		QueryGetAssetSuppliesCmd(queryRoute, cdc),
 		QueryGetAtomicSwapCmd(queryRoute, cdc),
 		QueryGetAtomicSwapsCmd(queryRoute, cdc),
+		QueryGetAtomicSwapsByRandomNumberHashCmd(queryRoute, cdc),
+		QueryGetAtomicSwapsBySenderOtherChainCmd(queryRoute, cdc),
+		QueryGetAtomicSwapsByRecipientOtherChainCmd(queryRoute, cdc),
 		QueryParamsCmd(queryRoute, cdc),
 	)...)
 
@@ -94,13 +98,13 @@ func QueryCalcRandomNumberHashCmd(queryRoute string, cdc *codec.Codec) *cobra.Co
 	}
 }
 
-// QueryCalcSwapIDCmd calculates the swapID for a random number hash, sender, and sender other chain
+// QueryCalcSwapIDCmd calculates the swapID for a random number hash, sender, sender other chain, and other chain ID
 func QueryCalcSwapIDCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
 	return &cobra.Command{
-		Use:     "calc-swapid [random-number-hash] [sender] [sender-other-chain]",
-		Short:   "calculate swap ID for the given random number hash, sender, and sender other chain",
-		Example: "bep3 calc-swapid 0677bd8a303dd981810f34d8e5cc6507f13b391899b84d3c1be6c6045a17d747 kava1l0xsq2z7gqd7yly0g40y5836g0appumark77ny bnb1ud3q90r98l3mhd87kswv3h8cgrymzeljct8qn7",
-		Args:    cobra.MinimumNArgs(3),
+		Use:     "calc-swapid [random-number-hash] [sender] [sender-other-chain] [other-chain-id]",
+		Short:   "calculate swap ID for the given random number hash, sender, sender other chain, and other chain ID",
+		Example: "bep3 calc-swapid 0677bd8a303dd981810f34d8e5cc6507f13b391899b84d3c1be6c6045a17d747 kava1l0xsq2z7gqd7yly0g40y5836g0appumark77ny bnb1ud3q90r98l3mhd87kswv3h8cgrymzeljct8qn7 Binance-Chain-Tigris",
+		Args:    cobra.MinimumNArgs(4),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			cliCtx := context.NewCLIContext().WithCodec(cdc)
 
@@ -111,9 +115,10 @@ func QueryCalcSwapIDCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
 			}
 			sender := sdk.AccAddress(args[1])
 			senderOtherChain := args[2]
+			otherChainID := args[3]
 
 			// Calculate swap ID and convert to human-readable string
-			swapID := types.CalculateSwapID(randomNumberHash, sender, senderOtherChain)
+			swapID := types.CalculateSwapID(randomNumberHash, sender, senderOtherChain, otherChainID)
 			return cliCtx.PrintOutput(hex.EncodeToString(swapID))
 		},
 	}
@@ -224,6 +229,7 @@ $ kvcli q bep3 swaps --involve=kava1l0xsq2z7gqd7yly0g40y5836g0appumark77ny
 $ kvcli q bep3 swaps --expiration=280
 $ kvcli q bep3 swaps --status=(Open|Completed|Expired)
 $ kvcli q bep3 swaps --direction=(Incoming|Outgoing)
+$ kvcli q bep3 swaps --other-chain-id=Binance-Chain-Tigris
 $ kvcli q bep3 swaps --page=2 --limit=100
 `,
 		),
@@ -232,6 +238,7 @@ $ kvcli q bep3 swaps --page=2 --limit=100
 			strExpiration := viper.GetString(flagExpiration)
 			strSwapStatus := viper.GetString(flagStatus)
 			strSwapDirection := viper.GetString(flagDirection)
+			otherChainID := viper.GetString(flagOtherChain)
 			page := viper.GetInt(flags.FlagPage)
 			limit := viper.GetInt(flags.FlagLimit)
 
@@ -240,7 +247,7 @@ $ kvcli q bep3 swaps --page=2 --limit=100
 			var swapStatus types.SwapStatus
 			var swapDirection types.SwapDirection
 
-			params := types.NewQueryAtomicSwaps(page, limit, involveAddr, expiration, swapStatus, swapDirection)
+			params := types.NewQueryAtomicSwaps(page, limit, involveAddr, expiration, swapStatus, swapDirection, otherChainID)
 
 			if len(bechInvolveAddr) != 0 {
 				involveAddr, err := sdk.AccAddressFromBech32(bechInvolveAddr)
@@ -304,6 +311,105 @@ $ kvcli q bep3 swaps --page=2 --limit=100
 	cmd.Flags().String(flagExpiration, "", "(optional) filter by atomic swaps that expire before a block height")
 	cmd.Flags().String(flagStatus, "", "(optional) filter by atomic swap status, status: open/completed/expired")
 	cmd.Flags().String(flagDirection, "", "(optional) filter by atomic swap direction, direction: incoming/outgoing")
+	cmd.Flags().String(flagOtherChain, "", "(optional) filter by atomic swaps with a counterparty chain")
+
+	return cmd
+}
+
+// QueryGetAtomicSwapsByRandomNumberHashCmd queries the AtomicSwaps with a random number hash
+func QueryGetAtomicSwapsByRandomNumberHashCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "swaps-by-rnh [random-number-hash]",
+		Short:   "get the atomic swaps with a random number hash",
+		Example: "bep3 swaps-by-rnh 0677bd8a303dd981810f34d8e5cc6507f13b391899b84d3c1be6c6045a17d747",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			// Decode random number hash's hex encoded string to []byte
+			randomNumberHash, err := hex.DecodeString(args[0])
+			if err != nil {
+				return err
+			}
+
+			// Prepare query params
+			params := types.NewQueryAtomicSwapsByRandomNumberHash(viper.GetInt(flags.FlagPage), viper.GetInt(flags.FlagLimit), randomNumberHash)
+			bz, err := cdc.MarshalJSON(params)
+			if err != nil {
+				return err
+			}
+
+			// Execute query
+			res, height, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryGetAtomicSwapsByRandomNumberHash), bz)
+			if err != nil {
+				return err
+			}
+
+			var atomicSwaps types.AtomicSwaps
+			cdc.MustUnmarshalJSON(res, &atomicSwaps)
+
+			if len(atomicSwaps) == 0 {
+				return fmt.Errorf("No matching atomic swaps found")
+			}
+
+			cliCtx = cliCtx.WithHeight(height)
+			return cliCtx.PrintOutput(atomicSwaps.String())
+		},
+	}
+
+	cmd.Flags().Int(flags.FlagPage, 1, "pagination page of atomic swaps to to query for")
+	cmd.Flags().Int(flags.FlagLimit, 100, "pagination limit of atomic swaps to query for")
+	return cmd
+}
+
+// QueryGetAtomicSwapsBySenderOtherChainCmd queries the AtomicSwaps sent from an address on the other chain
+func QueryGetAtomicSwapsBySenderOtherChainCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return queryAtomicSwapsByOtherChainAddressCmd(queryRoute, cdc, types.QueryGetAtomicSwapsBySenderOtherChain,
+		"swaps-by-sender-other-chain", "get the atomic swaps sent from an address on the other chain")
+}
+
+// QueryGetAtomicSwapsByRecipientOtherChainCmd queries the AtomicSwaps sent to an address on the other chain
+func QueryGetAtomicSwapsByRecipientOtherChainCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return queryAtomicSwapsByOtherChainAddressCmd(queryRoute, cdc, types.QueryGetAtomicSwapsByRecipientOtherChain,
+		"swaps-by-recipient-other-chain", "get the atomic swaps sent to an address on the other chain")
+}
+
+func queryAtomicSwapsByOtherChainAddressCmd(queryRoute string, cdc *codec.Codec, queryPath, use, short string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     fmt.Sprintf("%s [address]", use),
+		Short:   short,
+		Example: fmt.Sprintf("bep3 %s bnb1ud3q90r98l3mhd87kswv3h8cgrymzeljct8qn7 --page=1 --limit=100", use),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			// Prepare query params
+			params := types.NewQueryAtomicSwapsByOtherChainAddress(viper.GetInt(flags.FlagPage), viper.GetInt(flags.FlagLimit), args[0])
+			bz, err := cdc.MarshalJSON(params)
+			if err != nil {
+				return err
+			}
+
+			// Execute query
+			res, height, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", queryRoute, queryPath), bz)
+			if err != nil {
+				return err
+			}
+
+			var atomicSwaps types.AtomicSwaps
+			cdc.MustUnmarshalJSON(res, &atomicSwaps)
+
+			if len(atomicSwaps) == 0 {
+				return fmt.Errorf("No matching atomic swaps found")
+			}
+
+			cliCtx = cliCtx.WithHeight(height)
+			return cliCtx.PrintOutput(atomicSwaps.String())
+		},
+	}
+
+	cmd.Flags().Int(flags.FlagPage, 1, "pagination page of atomic swaps to to query for")
+	cmd.Flags().Int(flags.FlagLimit, 100, "pagination limit of atomic swaps to query for")
 
 	return cmd
 }
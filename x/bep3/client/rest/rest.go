@@ -13,10 +13,11 @@ import (
 // REST Variable names
 // nolint
 const (
-	RestExpiration = "expiration"
-	RestInvolve    = "involve"
-	RestStatus     = "status"
-	RestDirection  = "direction"
+	RestExpiration   = "expiration"
+	RestInvolve      = "involve"
+	RestStatus       = "status"
+	RestDirection    = "direction"
+	RestOtherChainID = "other_chain_id"
 )
 
 // RegisterRoutes registers bep3-related REST handlers to a router
@@ -46,10 +46,15 @@ func InitGenesis(ctx sdk.Context, keeper Keeper, supplyKeeper types.SupplyKeeper
 		if err != nil {
 			panic(err)
 		}
-		err = keeper.IncrementIncomingAssetSupply(ctx, supply.IncomingSupply)
-		if err != nil {
-			panic(err)
+		// Incoming supply and its recent increases are restored as exported, they were rate limited when the swaps were created
+		assetSupply, _ := keeper.GetAssetSupply(ctx, []byte(supply.Denom))
+		if assetSupply.SupplyLimit.IsLT(assetSupply.CurrentSupply.Add(supply.IncomingSupply)) {
+			panic(fmt.Sprintf("asset %s current supply %s plus incoming supply %s exceeds limit %s",
+				supply.Denom, assetSupply.CurrentSupply, supply.IncomingSupply, assetSupply.SupplyLimit))
 		}
+		assetSupply.IncomingSupply = supply.IncomingSupply
+		assetSupply.RecentIncreases = supply.RecentIncreases
+		keeper.SetAssetSupply(ctx, assetSupply, []byte(supply.Denom))
 		err = keeper.IncrementOutgoingAssetSupply(ctx, supply.OutgoingSupply)
 		if err != nil {
 			panic(err)
@@ -57,19 +62,24 @@ func InitGenesis(ctx sdk.Context, keeper Keeper, supplyKeeper types.SupplyKeeper
 	}
 
 	var incomingSupplies sdk.Coins
+	var expiredIncomingSupplies sdk.Coins
 	var outgoingSupplies sdk.Coins
 	for _, swap := range gs.AtomicSwaps {
 		if swap.Validate() != nil {
 			panic(fmt.Sprintf("invalid swap %s", swap.GetSwapID()))
 		}
+		// Swaps exported before other chains were supported are on the default chain, their IDs are unchanged
+		swap.OtherChainID = swap.GetOtherChainID()
 
 		// Atomic swap assets must be both supported and active
-		err := keeper.ValidateLiveAsset(ctx, swap.Amount[0])
-		if err != nil {
-			panic(err)
+		for _, coin := range swap.Amount {
+			if err := keeper.ValidateLiveAsset(ctx, coin); err != nil {
+				panic(err)
+			}
 		}
 
 		keeper.SetAtomicSwap(ctx, swap)
+		keeper.InsertIntoLookupIndexes(ctx, swap)
 
 		// Add swap to block index or longterm storage based on swap.Status
 		// Increment incoming or outgoing supply based on swap.Direction
@@ -81,7 +91,9 @@ func InitGenesis(ctx sdk.Context, keeper Keeper, supplyKeeper types.SupplyKeeper
 				keeper.InsertIntoByBlockIndex(ctx, swap)
 				incomingSupplies = incomingSupplies.Add(swap.Amount...)
 			case Expired:
-				incomingSupplies = incomingSupplies.Add(swap.Amount...)
+				// This index stores swaps until they are refunded, expired incoming swaps are not part of the incoming supply
+				keeper.InsertIntoExpiredIndex(ctx, swap)
+				expiredIncomingSupplies = expiredIncomingSupplies.Add(swap.Amount...)
 			case Completed:
 				// This index stores swaps until deletion
 				keeper.InsertIntoLongtermStorage(ctx, swap)
@@ -94,6 +106,7 @@ func InitGenesis(ctx sdk.Context, keeper Keeper, supplyKeeper types.SupplyKeeper
 				keeper.InsertIntoByBlockIndex(ctx, swap)
 				outgoingSupplies = outgoingSupplies.Add(swap.Amount...)
 			case Expired:
+				keeper.InsertIntoExpiredIndex(ctx, swap)
 				outgoingSupplies = outgoingSupplies.Add(swap.Amount...)
 			case Completed:
 				keeper.InsertIntoLongtermStorage(ctx, swap)
@@ -109,6 +122,12 @@ func InitGenesis(ctx sdk.Context, keeper Keeper, supplyKeeper types.SupplyKeeper
 	supplies := keeper.GetAllAssetSupplies(ctx)
 	for _, supply := range supplies {
 		incomingSupply := incomingSupplies.AmountOf(supply.Denom)
+		// Genesis exported before expired incoming swaps released their incoming supply still counts them, so remove them
+		expiredIncomingSupply := expiredIncomingSupplies.AmountOf(supply.Denom)
+		if expiredIncomingSupply.IsPositive() && supply.IncomingSupply.Amount.Equal(incomingSupply.Add(expiredIncomingSupply)) {
+			supply.IncomingSupply = sdk.NewCoin(supply.Denom, incomingSupply)
+			keeper.SetAssetSupply(ctx, supply, []byte(supply.Denom))
+		}
 		if !supply.IncomingSupply.Amount.Equal(incomingSupply) {
 			panic(fmt.Sprintf("asset's incoming supply %s does not match amount %s in incoming atomic swaps",
 				supply.IncomingSupply, incomingSupply))
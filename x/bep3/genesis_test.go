@@ -6,6 +6,9 @@ import (
 	"github.com/stretchr/testify/suite"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	authexported "github.com/cosmos/cosmos-sdk/x/auth/exported"
+	"github.com/cosmos/cosmos-sdk/x/supply"
 
 	abci "github.com/tendermint/tendermint/abci/types"
 	tmtime "github.com/tendermint/tendermint/types/time"
@@ -56,22 +59,34 @@ func (suite *GenesisTestSuite) TestGenesisState() {
 				_, addrs := app.GeneratePrivKeyAddressPairs(3)
 				var swaps bep3.AtomicSwaps
 				var supplies bep3.AssetSupplies
+				swapCoins := sdk.NewCoins()
 				for i := 0; i < 3; i++ {
 					swap, supply := loadSwapAndSupply(addrs[i], i)
 					swaps = append(swaps, swap)
 					supplies = append(supplies, supply)
+					swapCoins = swapCoins.Add(swap.Amount...)
 				}
 				gs.AtomicSwaps = swaps
 				gs.AssetSupplies = supplies
-				return app.GenesisState{"bep3": bep3.ModuleCdc.MustMarshalJSON(gs)}
+
+				// The module account holds the coins locked in the open swaps
+				macc := supply.NewEmptyModuleAccount(bep3.ModuleName)
+				if err := macc.SetCoins(swapCoins); err != nil {
+					panic(err)
+				}
+				authGS := auth.NewGenesisState(auth.DefaultParams(), authexported.GenesisAccounts{macc})
+				return app.GenesisState{
+					"bep3":          bep3.ModuleCdc.MustMarshalJSON(gs),
+					auth.ModuleName: auth.ModuleCdc.MustMarshalJSON(authGS),
+				}
 			},
 			expectPass: true,
 		},
 		{
-			name: "0 deputy fees",
+			name: "0 asset fees",
 			genState: func() app.GenesisState {
 				gs := baseGenState(suite.addrs[0])
-				gs.Params.BnbDeputyFixedFee = 0
+				gs.Params.SupportedAssets[0].Fee = bep3.NewAssetFee(sdk.ZeroInt(), 0, sdk.ZeroInt())
 				return app.GenesisState{"bep3": bep3.ModuleCdc.MustMarshalJSON(gs)}
 			},
 			expectPass: true,
@@ -120,7 +135,7 @@ func (suite *GenesisTestSuite) TestGenesisState() {
 				randomNumberHash := bep3.CalculateRandomHash(randomNumber[:], timestamp)
 				swap := bep3.NewAtomicSwap(cs(c("bnb", overLimitAmount.Int64())), randomNumberHash,
 					uint64(360), timestamp, suite.addrs[0], addrs[1], TestSenderOtherChain,
-					TestRecipientOtherChain, 0, bep3.Open, true, bep3.Incoming)
+					TestRecipientOtherChain, bep3.DefaultChainID, 0, bep3.Open, true, bep3.Incoming)
 				gs.AtomicSwaps = bep3.AtomicSwaps{swap}
 
 				// Set up asset supply with overlimit current supply
@@ -153,7 +168,7 @@ func (suite *GenesisTestSuite) TestGenesisState() {
 				randomNumberHash := bep3.CalculateRandomHash(randomNumber[:], timestamp)
 				swap := bep3.NewAtomicSwap(cs(c("bnb", halfLimit)), randomNumberHash,
 					uint64(360), timestamp, suite.addrs[0], addrs[1], TestSenderOtherChain,
-					TestRecipientOtherChain, 0, bep3.Open, true, bep3.Incoming)
+					TestRecipientOtherChain, bep3.DefaultChainID, 0, bep3.Open, true, bep3.Incoming)
 				gs.AtomicSwaps = bep3.AtomicSwaps{swap}
 
 				// Set up asset supply with overlimit current supply
@@ -197,7 +212,7 @@ func (suite *GenesisTestSuite) TestGenesisState() {
 				randomNumberHash := bep3.CalculateRandomHash(randomNumber[:], timestamp)
 				swap := bep3.NewAtomicSwap(cs(c("fake", 500000)), randomNumberHash,
 					uint64(360), timestamp, suite.addrs[0], addrs[1], TestSenderOtherChain,
-					TestRecipientOtherChain, 0, bep3.Open, true, bep3.Incoming)
+					TestRecipientOtherChain, bep3.DefaultChainID, 0, bep3.Open, true, bep3.Incoming)
 
 				gs.AtomicSwaps = bep3.AtomicSwaps{swap}
 				return app.GenesisState{"bep3": bep3.ModuleCdc.MustMarshalJSON(gs)}
@@ -214,7 +229,7 @@ func (suite *GenesisTestSuite) TestGenesisState() {
 				randomNumberHash := bep3.CalculateRandomHash(randomNumber[:], timestamp)
 				swap := bep3.NewAtomicSwap(cs(c("bnb", 5000)), randomNumberHash,
 					uint64(360), timestamp, suite.addrs[0], addrs[1], TestSenderOtherChain,
-					TestRecipientOtherChain, 0, bep3.NULL, true, bep3.Incoming)
+					TestRecipientOtherChain, bep3.DefaultChainID, 0, bep3.NULL, true, bep3.Incoming)
 
 				gs.AtomicSwaps = bep3.AtomicSwaps{swap}
 				return app.GenesisState{"bep3": bep3.ModuleCdc.MustMarshalJSON(gs)}
@@ -287,6 +302,7 @@ func (suite *GenesisTestSuite) TestGenesisState() {
 	}
 
 	for _, tc := range testCases {
+		suite.SetupTest()
 		if tc.expectPass {
 			suite.NotPanics(func() {
 				suite.app.InitializeFromGenesisStates(tc.genState())
@@ -299,6 +315,60 @@ func (suite *GenesisTestSuite) TestGenesisState() {
 	}
 }
 
+func (suite *GenesisTestSuite) TestImportSwapsWithoutOtherChainID() {
+	gs := baseGenState(suite.addrs[0])
+	swap, assetSupply := loadSwapAndSupply(suite.addrs[1], 0)
+	// swaps exported before other chains were supported have no other chain ID
+	swap.OtherChainID = ""
+	gs.AtomicSwaps = bep3.AtomicSwaps{swap}
+	gs.AssetSupplies = bep3.AssetSupplies{assetSupply}
+
+	macc := supply.NewEmptyModuleAccount(bep3.ModuleName)
+	suite.Require().NoError(macc.SetCoins(swap.Amount))
+	authGS := auth.NewGenesisState(auth.DefaultParams(), authexported.GenesisAccounts{macc})
+
+	suite.NotPanics(func() {
+		suite.app.InitializeFromGenesisStates(app.GenesisState{
+			"bep3":          bep3.ModuleCdc.MustMarshalJSON(gs),
+			auth.ModuleName: auth.ModuleCdc.MustMarshalJSON(authGS),
+		})
+	})
+
+	// the swap keeps its ID and is assigned the default chain
+	legacyID := bep3.CalculateSwapID(swap.RandomNumberHash, swap.Sender, swap.SenderOtherChain, "")
+	storedSwap, found := suite.keeper.GetAtomicSwap(suite.ctx, legacyID)
+	suite.Require().True(found)
+	suite.Equal(bep3.DefaultChainID, storedSwap.OtherChainID)
+	suite.Equal(swap.GetSwapID(), storedSwap.GetSwapID())
+}
+
+func (suite *GenesisTestSuite) TestImportExpiredIncomingSwapsInIncomingSupply() {
+	gs := baseGenState(suite.addrs[0])
+	expiredSwap, assetSupply := loadSwapAndSupply(suite.addrs[1], 0)
+	// genesis exported before expired incoming swaps released their incoming supply counts them in the incoming supply
+	expiredSwap.Status = bep3.Expired
+	openSwap, _ := loadSwapAndSupply(suite.addrs[1], 0)
+	assetSupply.IncomingSupply = assetSupply.IncomingSupply.Add(openSwap.Amount[0])
+	gs.AtomicSwaps = bep3.AtomicSwaps{expiredSwap, openSwap}
+	gs.AssetSupplies = bep3.AssetSupplies{assetSupply}
+
+	macc := supply.NewEmptyModuleAccount(bep3.ModuleName)
+	suite.Require().NoError(macc.SetCoins(expiredSwap.Amount.Add(openSwap.Amount...)))
+	authGS := auth.NewGenesisState(auth.DefaultParams(), authexported.GenesisAccounts{macc})
+
+	suite.NotPanics(func() {
+		suite.app.InitializeFromGenesisStates(app.GenesisState{
+			"bep3":          bep3.ModuleCdc.MustMarshalJSON(gs),
+			auth.ModuleName: auth.ModuleCdc.MustMarshalJSON(authGS),
+		})
+	})
+
+	// only the open swap counts towards the incoming supply
+	storedSupply, found := suite.keeper.GetAssetSupply(suite.ctx, []byte(assetSupply.Denom))
+	suite.Require().True(found)
+	suite.Equal(openSwap.Amount[0], storedSupply.IncomingSupply)
+}
+
 func TestGenesisTestSuite(t *testing.T) {
 	suite.Run(t, new(GenesisTestSuite))
 }
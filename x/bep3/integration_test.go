@@ -37,33 +37,54 @@ func NewBep3GenStateMulti(deputy sdk.AccAddress) app.GenesisState {
 func baseGenState(deputy sdk.AccAddress) bep3.GenesisState {
 	bep3Genesis := bep3.GenesisState{
 		Params: bep3.Params{
-			BnbDeputyAddress: deputy,
-			MinBlockLock:     bep3.DefaultMinBlockLock, // 80
-			MaxBlockLock:     bep3.DefaultMaxBlockLock, // 360
+			Deputies: bep3.DeputyParams{
+				bep3.NewDeputyParam(deputy, nil, []string{"btc", "eth", "bnb", "inc"},
+					cs(c("btc", 1), c("eth", 1), c("bnb", 1), c("inc", 1)),
+					cs(c("btc", StandardSupplyLimit.Int64()), c("eth", StandardSupplyLimit.Int64()), c("bnb", StandardSupplyLimit.Int64()), c("inc", StandardSupplyLimit.Int64()))),
+			},
+			MinBlockLock:   bep3.DefaultMinBlockLock, // 80
+			MaxBlockLock:   bep3.DefaultMaxBlockLock, // 360
+			MaxAutoRefunds: bep3.DefaultMaxAutoRefunds,
 			SupportedAssets: bep3.AssetParams{
 				bep3.AssetParam{
-					Denom:  "btc",
-					CoinID: 714,
-					Limit:  StandardSupplyLimit,
-					Active: true,
+					Denom:         "btc",
+					CoinID:        714,
+					Limit:         StandardSupplyLimit,
+					Active:        true,
+					ChainID:       bep3.DefaultChainID,
+					AddressFormat: bep3.AddressFormatBech32,
+					AddressPrefix: "bnb",
+					Fee:           bep3.DefaultFee,
 				},
 				bep3.AssetParam{
-					Denom:  "eth",
-					CoinID: 999999,
-					Limit:  StandardSupplyLimit,
-					Active: true,
+					Denom:         "eth",
+					CoinID:        999999,
+					Limit:         StandardSupplyLimit,
+					Active:        true,
+					ChainID:       bep3.DefaultChainID,
+					AddressFormat: bep3.AddressFormatBech32,
+					AddressPrefix: "bnb",
+					Fee:           bep3.DefaultFee,
 				},
 				bep3.AssetParam{
-					Denom:  "bnb",
-					CoinID: 99999,
-					Limit:  StandardSupplyLimit,
-					Active: true,
+					Denom:         "bnb",
+					CoinID:        99999,
+					Limit:         StandardSupplyLimit,
+					Active:        true,
+					ChainID:       bep3.DefaultChainID,
+					AddressFormat: bep3.AddressFormatBech32,
+					AddressPrefix: "bnb",
+					Fee:           bep3.DefaultFee,
 				},
 				bep3.AssetParam{
-					Denom:  "inc",
-					CoinID: 9999,
-					Limit:  i(100),
-					Active: false,
+					Denom:         "inc",
+					CoinID:        9999,
+					Limit:         i(100),
+					Active:        false,
+					ChainID:       bep3.DefaultChainID,
+					AddressFormat: bep3.AddressFormatBech32,
+					AddressPrefix: "bnb",
+					Fee:           bep3.DefaultFee,
 				},
 			},
 		},
@@ -79,7 +100,7 @@ func loadSwapAndSupply(addr sdk.AccAddress, index int) (bep3.AtomicSwap, bep3.As
 	randomNumberHash := bep3.CalculateRandomHash(randomNumber[:], timestamp)
 	swap := bep3.NewAtomicSwap(cs(coin), randomNumberHash,
 		expireOffset, timestamp, addr, addr, TestSenderOtherChain,
-		TestRecipientOtherChain, 1, bep3.Open, true, bep3.Incoming)
+		TestRecipientOtherChain, bep3.DefaultChainID, 1, bep3.Open, true, bep3.Incoming)
 
 	supply := bep3.NewAssetSupply(coin.Denom, coin, c(coin.Denom, 0),
 		c(coin.Denom, 0), c(coin.Denom, StandardSupplyLimit.Int64()))
@@ -55,6 +55,19 @@ func (k Keeper) IncrementIncomingAssetSupply(ctx sdk.Context, coin sdk.Coin) err
 		return sdkerrors.Wrapf(types.ErrExceedsSupplyLimit, "increase %s, asset supply %s, limit %s", coin, totalSupply, supply.SupplyLimit)
 	}
 
+	// Result of (increases within the time window + amount) must be under asset's time window limit
+	asset, found := k.GetAssetByDenom(ctx, coin.Denom)
+	if found && asset.IsTimeLimited() {
+		supply.RecentIncreases = supply.RecentIncreases.Since(ctx.BlockTime().Add(-asset.TimeWindow))
+		windowIncrease := supply.RecentIncreases.Total()
+		if asset.TimeWindowLimit.LT(windowIncrease.Add(coin.Amount)) {
+			return sdkerrors.Wrapf(types.ErrExceedsTimeWindowLimit, "increase %s, increase within %s %s, limit %s", coin, asset.TimeWindow, windowIncrease, asset.TimeWindowLimit)
+		}
+		supply.RecentIncreases = append(supply.RecentIncreases, types.NewSupplyIncrease(ctx.BlockTime(), coin.Amount))
+	} else {
+		supply.RecentIncreases = nil
+	}
+
 	supply.IncomingSupply = supply.IncomingSupply.Add(coin)
 	k.SetAssetSupply(ctx, supply, []byte(coin.Denom))
 	return nil
@@ -113,3 +126,14 @@ func (k Keeper) DecrementOutgoingAssetSupply(ctx sdk.Context, coin sdk.Coin) err
 	k.SetAssetSupply(ctx, supply, []byte(coin.Denom))
 	return nil
 }
+
+// pruneSupplyIncreases drops the increases that fall outside the asset's current time window
+func (k Keeper) pruneSupplyIncreases(ctx sdk.Context, supply types.AssetSupply) types.AssetSupply {
+	asset, found := k.GetAssetByDenom(ctx, supply.Denom)
+	if !found || !asset.IsTimeLimited() {
+		supply.RecentIncreases = nil
+		return supply
+	}
+	supply.RecentIncreases = supply.RecentIncreases.Since(ctx.BlockTime().Add(-asset.TimeWindow))
+	return supply
+}
@@ -1,7 +1,9 @@
 package keeper_test
 
 import (
+	"errors"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/suite"
 
@@ -233,6 +235,53 @@ func (suite *AssetTestSuite) TestIncrementIncomingAssetSupply() {
 	}
 }
 
+func (suite *AssetTestSuite) TestIncrementIncomingAssetSupplyTimeWindow() {
+	// Limit bnb supply increases to 10 per hour
+	params := suite.keeper.GetParams(suite.ctx)
+	params.SupportedAssets[0].TimeWindow = time.Hour
+	params.SupportedAssets[0].TimeWindowLimit = sdk.NewInt(10)
+	suite.keeper.SetParams(suite.ctx, params)
+
+	supply := types.NewAssetSupply("bnb", c("bnb", 0), c("bnb", 0), c("bnb", 0), c("bnb", 1000))
+	suite.keeper.SetAssetSupply(suite.ctx, supply, []byte(supply.Denom))
+
+	start := suite.ctx.BlockTime()
+	testCases := []struct {
+		name        string
+		blockTime   time.Time
+		coin        sdk.Coin
+		expectPass  bool
+		windowTotal int64
+	}{
+		{"first increase", start, c("bnb", 6), true, 6},
+		{"exceeds window limit", start.Add(30 * time.Minute), c("bnb", 5), false, 6},
+		{"equal window limit", start.Add(30 * time.Minute), c("bnb", 4), true, 10},
+		{"first increase leaves window", start.Add(61 * time.Minute), c("bnb", 6), true, 10},
+		{"exceeds window limit after expiry", start.Add(62 * time.Minute), c("bnb", 1), false, 10},
+		{"all increases leave window", start.Add(3 * time.Hour), c("bnb", 10), true, 10},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			ctx := suite.ctx.WithBlockTime(tc.blockTime)
+			preSupply, _ := suite.keeper.GetAssetSupply(ctx, []byte("bnb"))
+
+			err := suite.keeper.IncrementIncomingAssetSupply(ctx, tc.coin)
+			postSupply, _ := suite.keeper.GetAssetSupply(ctx, []byte("bnb"))
+
+			if tc.expectPass {
+				suite.NoError(err)
+				suite.Equal(preSupply.IncomingSupply.Add(tc.coin), postSupply.IncomingSupply)
+			} else {
+				suite.Error(err)
+				suite.True(errors.Is(err, types.ErrExceedsTimeWindowLimit))
+				suite.Equal(preSupply, postSupply)
+			}
+			suite.Equal(sdk.NewInt(tc.windowTotal), postSupply.RecentIncreases.Since(tc.blockTime.Add(-time.Hour)).Total())
+		})
+	}
+}
+
 func (suite *AssetTestSuite) TestDecrementIncomingAssetSupply() {
 	type args struct {
 		coin sdk.Coin
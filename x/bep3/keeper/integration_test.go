@@ -34,22 +34,33 @@ func ts(minOffset int) int64                { return tmtime.Now().Add(time.Durat
 func NewBep3GenStateMulti(deputyAddress sdk.AccAddress) app.GenesisState {
 	bep3Genesis := types.GenesisState{
 		Params: bep3.Params{
-			BnbDeputyAddress:  deputyAddress,
-			BnbDeputyFixedFee: types.DefaultBnbDeputyFixedFee, // 1000
-			MinBlockLock:      types.DefaultMinBlockLock,      // 80
-			MaxBlockLock:      types.DefaultMaxBlockLock,      // 360
+			Deputies: types.DeputyParams{
+				types.NewDeputyParam(deputyAddress, nil, []string{"bnb", "inc"},
+					cs(c("bnb", 1), c("inc", 1)), cs(c("bnb", StandardSupplyLimit.Int64()), c("inc", StandardSupplyLimit.Int64()))),
+			},
+			MinBlockLock:   types.DefaultMinBlockLock, // 80
+			MaxBlockLock:   types.DefaultMaxBlockLock, // 360
+			MaxAutoRefunds: types.DefaultMaxAutoRefunds,
 			SupportedAssets: types.AssetParams{
 				types.AssetParam{
-					Denom:  "bnb",
-					CoinID: 714,
-					Limit:  StandardSupplyLimit,
-					Active: true,
+					Denom:         "bnb",
+					CoinID:        714,
+					Limit:         StandardSupplyLimit,
+					Active:        true,
+					ChainID:       types.DefaultChainID,
+					AddressFormat: types.AddressFormatBech32,
+					AddressPrefix: "bnb",
+					Fee:           types.DefaultFee,
 				},
 				types.AssetParam{
-					Denom:  "inc",
-					CoinID: 9999,
-					Limit:  i(100),
-					Active: false,
+					Denom:         "inc",
+					CoinID:        9999,
+					Limit:         i(100),
+					Active:        false,
+					ChainID:       types.DefaultChainID,
+					AddressFormat: types.AddressFormatBech32,
+					AddressPrefix: "bnb",
+					Fee:           types.DefaultFee,
 				},
 			},
 		},
@@ -74,7 +85,7 @@ func atomicSwap(ctx sdk.Context, index int) types.AtomicSwap {
 
 	return types.NewAtomicSwap(cs(c("bnb", 50000)), randomNumberHash,
 		uint64(ctx.BlockHeight())+expireOffset, timestamp, TestUser1, TestUser2,
-		TestSenderOtherChain, TestRecipientOtherChain, 0, types.Open, true,
+		TestSenderOtherChain, TestRecipientOtherChain, types.DefaultChainID, 0, types.Open, true,
 		types.Incoming)
 }
 
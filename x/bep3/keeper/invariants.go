@@ -0,0 +1,199 @@
+package keeper
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kava-labs/kava/x/bep3/types"
+)
+
+// RegisterInvariants registers all bep3 invariants
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+
+	ir.RegisterRoute(types.ModuleName, "incoming-supply",
+		IncomingSupplyInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "outgoing-supply",
+		OutgoingSupplyInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "supply-limit",
+		SupplyLimitInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "module-account",
+		ModuleAccountInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "valid-indexes",
+		ValidIndexesInvariant(k))
+}
+
+// IncomingSupplyInvariant checks that each asset's incoming supply equals the coins in its open incoming swaps
+func IncomingSupplyInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		swapCoins := k.sumAtomicSwaps(ctx, func(swap types.AtomicSwap) bool {
+			return swap.Direction == types.Incoming && swap.Status == types.Open
+		})
+		supplyCoins := sdk.NewCoins()
+		k.IterateAssetSupplies(ctx, func(supply types.AssetSupply) bool {
+			supplyCoins = supplyCoins.Add(supply.IncomingSupply)
+			return false
+		})
+		broken := !coinsEqual(swapCoins, supplyCoins)
+
+		invariantMessage := sdk.FormatInvariant(
+			types.ModuleName,
+			"incoming supply",
+			fmt.Sprintf(
+				"\tincoming supply:               %s\n"+
+					"\tcoins in open incoming swaps: %s\n",
+				supplyCoins, swapCoins),
+		)
+		return invariantMessage, broken
+	}
+}
+
+// OutgoingSupplyInvariant checks that each asset's outgoing supply equals the coins in its open and expired outgoing swaps
+func OutgoingSupplyInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		swapCoins := k.sumAtomicSwaps(ctx, func(swap types.AtomicSwap) bool {
+			return swap.Direction == types.Outgoing && (swap.Status == types.Open || swap.Status == types.Expired)
+		})
+		supplyCoins := sdk.NewCoins()
+		k.IterateAssetSupplies(ctx, func(supply types.AssetSupply) bool {
+			supplyCoins = supplyCoins.Add(supply.OutgoingSupply)
+			return false
+		})
+		broken := !coinsEqual(swapCoins, supplyCoins)
+
+		invariantMessage := sdk.FormatInvariant(
+			types.ModuleName,
+			"outgoing supply",
+			fmt.Sprintf(
+				"\toutgoing supply:                           %s\n"+
+					"\tcoins in open and expired outgoing swaps: %s\n",
+				supplyCoins, swapCoins),
+		)
+		return invariantMessage, broken
+	}
+}
+
+// SupplyLimitInvariant checks that each asset's current supply plus incoming supply does not exceed its supply limit
+func SupplyLimitInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var invalidSupply types.AssetSupply
+		broken := false
+		k.IterateAssetSupplies(ctx, func(supply types.AssetSupply) bool {
+			if supply.SupplyLimit.IsLT(supply.CurrentSupply.Add(supply.IncomingSupply)) {
+				invalidSupply = supply
+				broken = true
+				return true
+			}
+			return false
+		})
+
+		invariantMessage := sdk.FormatInvariant(
+			types.ModuleName,
+			"supply limit",
+			fmt.Sprintf("\tfound current plus incoming supply over the supply limit\n\tasset supply:\n%s\n", invalidSupply),
+		)
+		return invariantMessage, broken
+	}
+}
+
+// ModuleAccountInvariant checks that the module account's coins equal the coins in open and expired swaps
+func ModuleAccountInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		swapCoins := k.sumAtomicSwaps(ctx, func(swap types.AtomicSwap) bool {
+			return swap.Status == types.Open || swap.Status == types.Expired
+		})
+		moduleAccCoins := k.supplyKeeper.GetModuleAccount(ctx, types.ModuleName).GetCoins()
+		broken := !coinsEqual(swapCoins, moduleAccCoins)
+
+		invariantMessage := sdk.FormatInvariant(
+			types.ModuleName,
+			"module account",
+			fmt.Sprintf(
+				"\texpected ModuleAccount coins: %s\n"+
+					"\tactual ModuleAccount coins:   %s\n",
+				swapCoins, moduleAccCoins),
+		)
+		return invariantMessage, broken
+	}
+}
+
+// ValidIndexesInvariant checks that the by-block index holds exactly the open swaps, the expired index exactly
+// the expired swaps, and the longterm storage index exactly the completed swaps, each under its expected height
+func ValidIndexesInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		indexes := []struct {
+			name   string
+			prefix []byte
+			status types.SwapStatus
+			height func(swap types.AtomicSwap) uint64
+		}{
+			{"by-block", types.AtomicSwapByBlockPrefix, types.Open,
+				func(swap types.AtomicSwap) uint64 { return swap.ExpireHeight }},
+			{"expired", types.AtomicSwapExpiredPrefix, types.Expired,
+				func(swap types.AtomicSwap) uint64 { return swap.ExpireHeight }},
+			{"longterm storage", types.AtomicSwapLongtermStoragePrefix, types.Completed,
+				func(swap types.AtomicSwap) uint64 {
+					return uint64(swap.ClosedBlock) + types.DefaultLongtermStorageDuration
+				}},
+		}
+
+		statusCounts := make(map[types.SwapStatus]int)
+		k.IterateAtomicSwaps(ctx, func(swap types.AtomicSwap) bool {
+			statusCounts[swap.Status]++
+			return false
+		})
+
+		for _, index := range indexes {
+			// Every entry must be keyed by the height of an existing swap with the index's status.
+			// Entry keys are unique, so a matching count means every swap with the status is indexed.
+			var invalidKey []byte
+			count := 0
+			store := prefix.NewStore(ctx.KVStore(k.key), index.prefix)
+			iterator := store.Iterator(nil, nil)
+			for ; iterator.Valid(); iterator.Next() {
+				count++
+				swap, found := k.GetAtomicSwap(ctx, iterator.Value())
+				if !found || swap.Status != index.status ||
+					!bytes.Equal(iterator.Key(), types.GetAtomicSwapByHeightKey(index.height(swap), swap.GetSwapID())) {
+					invalidKey = iterator.Key()
+					break
+				}
+			}
+			iterator.Close()
+
+			if invalidKey != nil || count != statusCounts[index.status] {
+				invariantMessage := sdk.FormatInvariant(
+					types.ModuleName,
+					"valid indexes",
+					fmt.Sprintf(
+						"\t%s index does not match %s swaps\n"+
+							"\tinvalid key: %x\n"+
+							"\tindex entries: %d, %s swaps: %d\n",
+						index.name, index.status, invalidKey, count, index.status, statusCounts[index.status]),
+				)
+				return invariantMessage, true
+			}
+		}
+
+		return sdk.FormatInvariant(types.ModuleName, "valid indexes", ""), false
+	}
+}
+
+// sumAtomicSwaps returns the total amount of the swaps matching the filter
+func (k Keeper) sumAtomicSwaps(ctx sdk.Context, filter func(swap types.AtomicSwap) bool) sdk.Coins {
+	total := sdk.NewCoins()
+	k.IterateAtomicSwaps(ctx, func(swap types.AtomicSwap) bool {
+		if filter(swap) {
+			total = total.Add(swap.Amount...)
+		}
+		return false
+	})
+	return total
+}
+
+// coinsEqual returns true if both sets of coins hold the same amount of every denom
+func coinsEqual(a, b sdk.Coins) bool {
+	return a.IsAllGTE(b) && b.IsAllGTE(a)
+}
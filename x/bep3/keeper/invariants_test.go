@@ -0,0 +1,100 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmtime "github.com/tendermint/tendermint/types/time"
+
+	"github.com/kava-labs/kava/app"
+	"github.com/kava-labs/kava/x/bep3/keeper"
+	"github.com/kava-labs/kava/x/bep3/types"
+)
+
+// TestKeeper_Invariants Test that the invariants hold through a swap's lifecycle and catch inconsistent state
+func TestKeeper_Invariants(t *testing.T) {
+	_, addrs := app.GeneratePrivKeyAddressPairs(2)
+	deputy, user := addrs[0], addrs[1]
+	tApp := app.NewTestApp()
+	ctx := tApp.NewContext(true, abci.Header{Height: 1, Time: tmtime.Now()})
+	tApp.InitializeFromGenesisStates(
+		app.NewAuthGenState(addrs, []sdk.Coins{cs(c("bnb", 1000000)), cs(c("bnb", 1000000))}),
+		NewBep3GenStateMulti(deputy),
+	)
+	k := tApp.GetBep3Keeper()
+
+	requireInvariants := func(ctx sdk.Context, incoming, outgoing, limit, moduleAccount, indexes bool) {
+		_, broken := keeper.IncomingSupplyInvariant(k)(ctx)
+		require.Equal(t, incoming, !broken, "incoming supply")
+		_, broken = keeper.OutgoingSupplyInvariant(k)(ctx)
+		require.Equal(t, outgoing, !broken, "outgoing supply")
+		_, broken = keeper.SupplyLimitInvariant(k)(ctx)
+		require.Equal(t, limit, !broken, "supply limit")
+		_, broken = keeper.ModuleAccountInvariant(k)(ctx)
+		require.Equal(t, moduleAccount, !broken, "module account")
+		_, broken = keeper.ValidIndexesInvariant(k)(ctx)
+		require.Equal(t, indexes, !broken, "valid indexes")
+	}
+	requireInvariants(ctx, true, true, true, true, true)
+
+	// an incoming swap from the deputy and an outgoing swap to the deputy
+	require.NoError(t, k.IncrementCurrentAssetSupply(ctx, c("bnb", 1000000)))
+	var swapIDs [][]byte
+	for i, parties := range [][2]sdk.AccAddress{{deputy, user}, {user, deputy}} {
+		timestamp := ts(i)
+		randomNumber, err := types.GenerateSecureRandomNumber()
+		require.NoError(t, err)
+		randomNumberHash := types.CalculateRandomHash(randomNumber, timestamp)
+		require.NoError(t, k.CreateAtomicSwap(ctx, randomNumberHash, timestamp, types.DefaultMinBlockLock,
+			parties[0], parties[1], TestSenderOtherChain, TestRecipientOtherChain, cs(c("bnb", 50000)), true))
+		swapIDs = append(swapIDs, types.CalculateSwapID(randomNumberHash, parties[0], TestSenderOtherChain, types.DefaultChainID))
+	}
+	requireInvariants(ctx, true, true, true, true, true)
+
+	// expiring and refunding the swaps keeps the invariants
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + int64(types.DefaultMinBlockLock))
+	k.UpdateExpiredAtomicSwaps(ctx)
+	requireInvariants(ctx, true, true, true, true, true)
+	supply, found := k.GetAssetSupply(ctx, []byte("bnb"))
+	require.True(t, found)
+	require.True(t, supply.IncomingSupply.IsZero())
+	require.NoError(t, k.RefundAtomicSwap(ctx, user, swapIDs[0]))
+	requireInvariants(ctx, true, true, true, true, true)
+
+	// a supply changed outside the swap lifecycle breaks the supply invariants
+	cached, _ := ctx.CacheContext()
+	brokenSupply := supply
+	brokenSupply.IncomingSupply = c("bnb", 1)
+	brokenSupply.OutgoingSupply = c("bnb", 1)
+	brokenSupply.CurrentSupply = brokenSupply.SupplyLimit
+	k.SetAssetSupply(cached, brokenSupply, []byte("bnb"))
+	requireInvariants(cached, false, false, false, true, true)
+
+	// coins sent directly to the module account break the module account invariant
+	cached, _ = ctx.CacheContext()
+	require.NoError(t, tApp.GetSupplyKeeper().SendCoinsFromAccountToModule(cached, user, types.ModuleName, cs(c("bnb", 1))))
+	requireInvariants(cached, true, true, true, false, true)
+
+	// a swap missing from its index or indexed under the wrong status breaks the index invariant
+	swap, found := k.GetAtomicSwap(ctx, swapIDs[1])
+	require.True(t, found)
+	require.Equal(t, types.Expired, swap.Status)
+	cached, _ = ctx.CacheContext()
+	k.RemoveFromExpiredIndex(cached, swap)
+	requireInvariants(cached, true, true, true, true, false)
+	cached, _ = ctx.CacheContext()
+	k.InsertIntoByBlockIndex(cached, swap)
+	requireInvariants(cached, true, true, true, true, false)
+
+	// the remaining swap is refunded and pruned from longterm storage
+	require.NoError(t, k.RefundAtomicSwap(ctx, deputy, swapIDs[1]))
+	requireInvariants(ctx, true, true, true, true, true)
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + int64(types.DefaultLongtermStorageDuration))
+	k.DeleteClosedAtomicSwapsFromLongtermStorage(ctx)
+	require.Empty(t, k.GetAllAtomicSwaps(ctx))
+	requireInvariants(ctx, true, true, true, true, true)
+}
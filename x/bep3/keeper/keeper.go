@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/store/prefix"
@@ -177,6 +178,143 @@ func (k Keeper) IterateAtomicSwapsLongtermStorage(ctx sdk.Context, inclusiveCuto
 	}
 }
 
+// ------------------------------------------
+//			Atomic Swap Expired Index
+// ------------------------------------------
+
+// InsertIntoExpiredIndex adds an expired swap ID and its expiration height into the expired index.
+// Expired swaps remain in the index until they are refunded.
+func (k Keeper) InsertIntoExpiredIndex(ctx sdk.Context, atomicSwap types.AtomicSwap) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.AtomicSwapExpiredPrefix)
+	store.Set(types.GetAtomicSwapByHeightKey(atomicSwap.ExpireHeight, atomicSwap.GetSwapID()), atomicSwap.GetSwapID())
+}
+
+// RemoveFromExpiredIndex removes a swap from the expired index
+func (k Keeper) RemoveFromExpiredIndex(ctx sdk.Context, atomicSwap types.AtomicSwap) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.AtomicSwapExpiredPrefix)
+	store.Delete(types.GetAtomicSwapByHeightKey(atomicSwap.ExpireHeight, atomicSwap.GetSwapID()))
+}
+
+// IterateExpiredAtomicSwaps provides an iterator over expired AtomicSwaps ordered by expiration height.
+// For each AtomicSwap cb will be called. If cb returns true the iterator will close and stop.
+func (k Keeper) IterateExpiredAtomicSwaps(ctx sdk.Context, cb func(swapID []byte) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.AtomicSwapExpiredPrefix)
+	iterator := store.Iterator(nil, nil)
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+
+		id := iterator.Value()
+
+		if cb(id) {
+			break
+		}
+	}
+}
+
+// ------------------------------------------
+//			Atomic Swap Lookup Indexes
+// ------------------------------------------
+
+// InsertIntoLookupIndexes adds a swap ID into the random number hash, sender other chain, and recipient other chain indexes.
+// Swaps stay in these indexes until they are deleted from longterm storage.
+func (k Keeper) InsertIntoLookupIndexes(ctx sdk.Context, atomicSwap types.AtomicSwap) {
+	swapID := atomicSwap.GetSwapID()
+	store := ctx.KVStore(k.key)
+	prefix.NewStore(store, types.AtomicSwapByRandomNumberHashPrefix).Set(
+		types.GetAtomicSwapByLookupKey(atomicSwap.RandomNumberHash, swapID), swapID)
+	prefix.NewStore(store, types.AtomicSwapBySenderOtherChainPrefix).Set(
+		types.GetAtomicSwapByLookupKey(otherChainAddressLookupValue(atomicSwap.SenderOtherChain), swapID), swapID)
+	prefix.NewStore(store, types.AtomicSwapByRecipientOtherChainPrefix).Set(
+		types.GetAtomicSwapByLookupKey(otherChainAddressLookupValue(atomicSwap.RecipientOtherChain), swapID), swapID)
+}
+
+// RemoveFromLookupIndexes removes a swap from the random number hash, sender other chain, and recipient other chain indexes.
+func (k Keeper) RemoveFromLookupIndexes(ctx sdk.Context, atomicSwap types.AtomicSwap) {
+	swapID := atomicSwap.GetSwapID()
+	store := ctx.KVStore(k.key)
+	prefix.NewStore(store, types.AtomicSwapByRandomNumberHashPrefix).Delete(
+		types.GetAtomicSwapByLookupKey(atomicSwap.RandomNumberHash, swapID))
+	prefix.NewStore(store, types.AtomicSwapBySenderOtherChainPrefix).Delete(
+		types.GetAtomicSwapByLookupKey(otherChainAddressLookupValue(atomicSwap.SenderOtherChain), swapID))
+	prefix.NewStore(store, types.AtomicSwapByRecipientOtherChainPrefix).Delete(
+		types.GetAtomicSwapByLookupKey(otherChainAddressLookupValue(atomicSwap.RecipientOtherChain), swapID))
+}
+
+// otherChainAddressLookupValue normalizes an other chain address for the lookup indexes.
+// Bech32 and hex addresses are case insensitive, so addresses are indexed and queried in lower case.
+func otherChainAddressLookupValue(address string) []byte {
+	return []byte(strings.ToLower(address))
+}
+
+// IterateAtomicSwapsByRandomNumberHash provides an iterator over the AtomicSwaps with a random number hash.
+// For each AtomicSwap cb will be called. If cb returns true the iterator will close and stop.
+func (k Keeper) IterateAtomicSwapsByRandomNumberHash(ctx sdk.Context, randomNumberHash []byte, cb func(swapID []byte) (stop bool)) {
+	k.iterateLookupIndex(ctx, types.AtomicSwapByRandomNumberHashPrefix, randomNumberHash, cb)
+}
+
+// IterateAtomicSwapsBySenderOtherChain provides an iterator over the AtomicSwaps sent from an address on the other chain.
+// Addresses are matched case insensitively.
+// For each AtomicSwap cb will be called. If cb returns true the iterator will close and stop.
+func (k Keeper) IterateAtomicSwapsBySenderOtherChain(ctx sdk.Context, address string, cb func(swapID []byte) (stop bool)) {
+	k.iterateLookupIndex(ctx, types.AtomicSwapBySenderOtherChainPrefix, otherChainAddressLookupValue(address), cb)
+}
+
+// IterateAtomicSwapsByRecipientOtherChain provides an iterator over the AtomicSwaps sent to an address on the other chain.
+// Addresses are matched case insensitively.
+// For each AtomicSwap cb will be called. If cb returns true the iterator will close and stop.
+func (k Keeper) IterateAtomicSwapsByRecipientOtherChain(ctx sdk.Context, address string, cb func(swapID []byte) (stop bool)) {
+	k.iterateLookupIndex(ctx, types.AtomicSwapByRecipientOtherChainPrefix, otherChainAddressLookupValue(address), cb)
+}
+
+func (k Keeper) iterateLookupIndex(ctx sdk.Context, indexPrefix []byte, value []byte, cb func(swapID []byte) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.key), indexPrefix)
+	iterator := sdk.KVStorePrefixIterator(store, types.GetLookupPrefix(value))
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+
+		id := iterator.Value()
+
+		if cb(id) {
+			break
+		}
+	}
+}
+
+// GetAtomicSwapsByRandomNumberHash returns the AtomicSwaps with a random number hash
+func (k Keeper) GetAtomicSwapsByRandomNumberHash(ctx sdk.Context, randomNumberHash []byte) (atomicSwaps types.AtomicSwaps) {
+	k.IterateAtomicSwapsByRandomNumberHash(ctx, randomNumberHash, func(id []byte) bool {
+		if atomicSwap, found := k.GetAtomicSwap(ctx, id); found {
+			atomicSwaps = append(atomicSwaps, atomicSwap)
+		}
+		return false
+	})
+	return
+}
+
+// GetAtomicSwapsBySenderOtherChain returns the AtomicSwaps sent from an address on the other chain
+func (k Keeper) GetAtomicSwapsBySenderOtherChain(ctx sdk.Context, address string) (atomicSwaps types.AtomicSwaps) {
+	k.IterateAtomicSwapsBySenderOtherChain(ctx, address, func(id []byte) bool {
+		if atomicSwap, found := k.GetAtomicSwap(ctx, id); found {
+			atomicSwaps = append(atomicSwaps, atomicSwap)
+		}
+		return false
+	})
+	return
+}
+
+// GetAtomicSwapsByRecipientOtherChain returns the AtomicSwaps sent to an address on the other chain
+func (k Keeper) GetAtomicSwapsByRecipientOtherChain(ctx sdk.Context, address string) (atomicSwaps types.AtomicSwaps) {
+	k.IterateAtomicSwapsByRecipientOtherChain(ctx, address, func(id []byte) bool {
+		if atomicSwap, found := k.GetAtomicSwap(ctx, id); found {
+			atomicSwaps = append(atomicSwaps, atomicSwap)
+		}
+		return false
+	})
+	return
+}
+
 // ------------------------------------------
 //				Asset Supplies
 // ------------------------------------------
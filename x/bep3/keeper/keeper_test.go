@@ -56,7 +56,7 @@ func (suite *KeeperTestSuite) TestGetSetAtomicSwap() {
 	suite.Equal(atomicSwap, s)
 
 	// Check fake atomic swap not in store
-	fakeSwapID := types.CalculateSwapID(atomicSwap.RandomNumberHash, TestUser2, "otheraddress")
+	fakeSwapID := types.CalculateSwapID(atomicSwap.RandomNumberHash, TestUser2, "otheraddress", types.DefaultChainID)
 	_, found = suite.keeper.GetAtomicSwap(suite.ctx, fakeSwapID)
 	suite.False(found)
 }
@@ -182,7 +182,7 @@ func (suite *KeeperTestSuite) TestIterateAtomicSwapsByBlock() {
 
 		atomicSwap := types.NewAtomicSwap(cs(c("bnb", 50000)), randomNumberHash,
 			uint64(blockCtx.BlockHeight()), timestamp, TestUser1, TestUser2,
-			TestSenderOtherChain, TestRecipientOtherChain, 0, types.Open,
+			TestSenderOtherChain, TestRecipientOtherChain, types.DefaultChainID, 0, types.Open,
 			true, types.Incoming)
 
 		// Insert into block index
@@ -273,7 +273,7 @@ func (suite *KeeperTestSuite) TestIterateAtomicSwapsLongtermStorage() {
 
 		atomicSwap := types.NewAtomicSwap(cs(c("bnb", 50000)), randomNumberHash,
 			uint64(suite.ctx.BlockHeight()), timestamp, TestUser1, TestUser2,
-			TestSenderOtherChain, TestRecipientOtherChain, 100, types.Open,
+			TestSenderOtherChain, TestRecipientOtherChain, types.DefaultChainID, 100, types.Open,
 			true, types.Incoming)
 
 		// Set closed block staggered by 100 blocks and insert into longterm storage
@@ -307,6 +307,95 @@ func (suite *KeeperTestSuite) TestIterateAtomicSwapsLongtermStorage() {
 	suite.Equal(expectedSwapIDs, readSwapIDs)
 }
 
+func (suite *KeeperTestSuite) TestInsertRemoveExpiredIndex() {
+	suite.ResetChain()
+
+	// Set expired atomic swap in the expired index
+	atomicSwap := atomicSwap(suite.ctx, 1)
+	atomicSwap.Status = types.Expired
+	suite.keeper.InsertIntoExpiredIndex(suite.ctx, atomicSwap)
+
+	// Expired index lacks getter methods, must use iteration to get count of swaps in store
+	var swapIDs [][]byte
+	suite.keeper.IterateExpiredAtomicSwaps(suite.ctx, func(id []byte) bool {
+		swapIDs = append(swapIDs, id)
+		return false
+	})
+	suite.Equal(1, len(swapIDs))
+	suite.Equal([]byte(atomicSwap.GetSwapID()), swapIDs[0])
+
+	suite.keeper.RemoveFromExpiredIndex(suite.ctx, atomicSwap)
+
+	// Check stored data not in expired index
+	var swapIDsPost [][]byte
+	suite.keeper.IterateExpiredAtomicSwaps(suite.ctx, func(id []byte) bool {
+		swapIDsPost = append(swapIDsPost, id)
+		return false
+	})
+	suite.Equal(0, len(swapIDsPost))
+}
+
+func (suite *KeeperTestSuite) TestIterateExpiredAtomicSwaps() {
+	suite.ResetChain()
+
+	// Set up expired atomic swaps with staggered expiration heights, inserted out of order
+	var swaps types.AtomicSwaps
+	for i := 0; i < 5; i++ {
+		timestamp := tmtime.Now().Unix()
+		randomNumber, _ := types.GenerateSecureRandomNumber()
+		randomNumberHash := types.CalculateRandomHash(randomNumber[:], timestamp)
+
+		atomicSwap := types.NewAtomicSwap(cs(c("bnb", 50000)), randomNumberHash,
+			uint64(500-i*100), timestamp, TestUser1, TestUser2,
+			TestSenderOtherChain, TestRecipientOtherChain, types.DefaultChainID, 0, types.Expired,
+			true, types.Incoming)
+		suite.keeper.InsertIntoExpiredIndex(suite.ctx, atomicSwap)
+		swaps = append(swaps, atomicSwap)
+	}
+
+	// Iteration returns the earliest expired swaps first
+	var readSwapIDs [][]byte
+	suite.keeper.IterateExpiredAtomicSwaps(suite.ctx, func(id []byte) bool {
+		readSwapIDs = append(readSwapIDs, id)
+		return len(readSwapIDs) == 3
+	})
+	suite.Equal(3, len(readSwapIDs))
+	for i, id := range readSwapIDs {
+		suite.Equal([]byte(swaps[len(swaps)-1-i].GetSwapID()), id)
+	}
+}
+
+func (suite *KeeperTestSuite) TestLookupIndexes() {
+	suite.ResetChain()
+
+	// One sender address is a prefix of the other, and two swaps share a random number hash
+	swapA := atomicSwap(suite.ctx, 1)
+	swapA.SenderOtherChain = "bnb1sender"
+	swapB := atomicSwap(suite.ctx, 2)
+	swapB.SenderOtherChain = "bnb1sender2"
+	swapC := atomicSwap(suite.ctx, 3)
+	swapC.RandomNumberHash = swapA.RandomNumberHash
+	swapC.SenderOtherChain = "bnb1sender3"
+	swapC.RecipientOtherChain = "bnb1recipient"
+	for _, swap := range []types.AtomicSwap{swapA, swapB, swapC} {
+		suite.keeper.SetAtomicSwap(suite.ctx, swap)
+		suite.keeper.InsertIntoLookupIndexes(suite.ctx, swap)
+	}
+
+	suite.Equal(2, len(suite.keeper.GetAtomicSwapsByRandomNumberHash(suite.ctx, swapA.RandomNumberHash)))
+	suite.Equal(1, len(suite.keeper.GetAtomicSwapsByRandomNumberHash(suite.ctx, swapB.RandomNumberHash)))
+	suite.Equal(types.AtomicSwaps{swapA}, suite.keeper.GetAtomicSwapsBySenderOtherChain(suite.ctx, "bnb1sender"))
+	suite.Equal(types.AtomicSwaps{swapB}, suite.keeper.GetAtomicSwapsBySenderOtherChain(suite.ctx, "bnb1sender2"))
+	suite.Equal(2, len(suite.keeper.GetAtomicSwapsByRecipientOtherChain(suite.ctx, TestRecipientOtherChain)))
+	suite.Equal(types.AtomicSwaps{swapC}, suite.keeper.GetAtomicSwapsByRecipientOtherChain(suite.ctx, "bnb1recipient"))
+
+	suite.keeper.RemoveFromLookupIndexes(suite.ctx, swapA)
+
+	suite.Equal(types.AtomicSwaps{swapC}, suite.keeper.GetAtomicSwapsByRandomNumberHash(suite.ctx, swapA.RandomNumberHash))
+	suite.Empty(suite.keeper.GetAtomicSwapsBySenderOtherChain(suite.ctx, "bnb1sender"))
+	suite.Equal(types.AtomicSwaps{swapB}, suite.keeper.GetAtomicSwapsByRecipientOtherChain(suite.ctx, TestRecipientOtherChain))
+}
+
 func (suite *KeeperTestSuite) TestGetSetAssetSupply() {
 	suite.ResetChain()
 
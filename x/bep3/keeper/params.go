@@ -18,16 +18,15 @@ func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
 	k.paramSubspace.SetParamSet(ctx, &params)
 }
 
-// GetBnbDeputyAddress returns the Bnbchain's deputy address
-func (k Keeper) GetBnbDeputyAddress(ctx sdk.Context) sdk.AccAddress {
+// GetDeputies returns all deputies
+func (k Keeper) GetDeputies(ctx sdk.Context) types.DeputyParams {
 	params := k.GetParams(ctx)
-	return params.BnbDeputyAddress
+	return params.Deputies
 }
 
-// GetBnbDeputyFixedFee returns the deputy's fixed fee
-func (k Keeper) GetBnbDeputyFixedFee(ctx sdk.Context) uint64 {
-	params := k.GetParams(ctx)
-	return params.BnbDeputyFixedFee
+// GetDeputy returns the deputy with the given address
+func (k Keeper) GetDeputy(ctx sdk.Context, addr sdk.AccAddress) (types.DeputyParam, bool) {
+	return k.GetDeputies(ctx).Get(addr)
 }
 
 // GetMaxBlockLock returns the maximum block lock
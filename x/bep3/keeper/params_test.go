@@ -35,23 +35,24 @@ func (suite *ParamsTestSuite) SetupTest() {
 	suite.addrs = addrs
 }
 
-func (suite *ParamsTestSuite) TestGetSetBnbDeputyAddress() {
+func (suite *ParamsTestSuite) TestGetSetDeputies() {
 	params := suite.keeper.GetParams(suite.ctx)
-	params.BnbDeputyAddress = suite.addrs[1]
+	params.Deputies = append(params.Deputies,
+		types.NewDeputyParam(suite.addrs[1], nil, []string{"bnb"}, cs(c("bnb", 10)), cs(c("bnb", 1000))),
+	)
 	suite.NotPanics(func() { suite.keeper.SetParams(suite.ctx, params) })
 
-	params = suite.keeper.GetParams(suite.ctx)
-	suite.Equal(suite.addrs[1], params.BnbDeputyAddress)
-	addr := suite.keeper.GetBnbDeputyAddress(suite.ctx)
-	suite.Equal(suite.addrs[1], addr)
-}
+	deputies := suite.keeper.GetDeputies(suite.ctx)
+	suite.Equal(params.Deputies, deputies)
 
-func (suite *ParamsTestSuite) TestGetBnbDeputyFixedFee() {
-	params := suite.keeper.GetParams(suite.ctx)
-	bnbDeputyFixedFee := params.BnbDeputyFixedFee
+	deputy, found := suite.keeper.GetDeputy(suite.ctx, suite.addrs[1])
+	suite.True(found)
+	minAmount, maxAmount := deputy.GetSwapAmountLimits("bnb")
+	suite.Equal(i(10), minAmount)
+	suite.Equal(i(1000), maxAmount)
 
-	res := suite.keeper.GetBnbDeputyFixedFee(suite.ctx)
-	suite.Equal(bnbDeputyFixedFee, res)
+	_, found = suite.keeper.GetDeputy(suite.ctx, suite.addrs[2])
+	suite.False(found)
 }
 
 func (suite *ParamsTestSuite) TestGetMaxBlockLock() {
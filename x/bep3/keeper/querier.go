@@ -23,6 +23,12 @@ func NewQuerier(keeper Keeper) sdk.Querier {
 			return queryAtomicSwap(ctx, req, keeper)
 		case types.QueryGetAtomicSwaps:
 			return queryAtomicSwaps(ctx, req, keeper)
+		case types.QueryGetAtomicSwapsByRandomNumberHash:
+			return queryAtomicSwapsByRandomNumberHash(ctx, req, keeper)
+		case types.QueryGetAtomicSwapsBySenderOtherChain:
+			return queryAtomicSwapsByOtherChainAddress(ctx, req, keeper.GetAtomicSwapsBySenderOtherChain)
+		case types.QueryGetAtomicSwapsByRecipientOtherChain:
+			return queryAtomicSwapsByOtherChainAddress(ctx, req, keeper.GetAtomicSwapsByRecipientOtherChain)
 		case types.QueryGetParams:
 			return queryGetParams(ctx, req, keeper)
 		default:
@@ -43,6 +49,7 @@ func queryAssetSupply(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]
 	if !found {
 		return nil, sdkerrors.Wrap(types.ErrAssetSupplyNotFound, string(requestParams.Denom))
 	}
+	assetSupply = keeper.pruneSupplyIncreases(ctx, assetSupply)
 
 	// Encode results
 	bz, err := codec.MarshalJSONIndent(types.ModuleCdc, assetSupply)
@@ -58,6 +65,9 @@ func queryAssetSupplies(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) (
 	if assets == nil {
 		assets = types.AssetSupplies{}
 	}
+	for i, supply := range assets {
+		assets[i] = keeper.pruneSupplyIncreases(ctx, supply)
+	}
 
 	bz, err := codec.MarshalJSONIndent(types.ModuleCdc, assets)
 	if err != nil {
@@ -111,6 +121,53 @@ func queryAtomicSwaps(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]
 	return bz, nil
 }
 
+func queryAtomicSwapsByRandomNumberHash(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, error) {
+	var params types.QueryAtomicSwapsByRandomNumberHash
+	err := types.ModuleCdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+
+	swaps := keeper.GetAtomicSwapsByRandomNumberHash(ctx, params.RandomNumberHash)
+	start, end := client.Paginate(len(swaps), params.Page, params.Limit, 100)
+	if start < 0 || end < 0 {
+		swaps = types.AtomicSwaps{}
+	} else {
+		swaps = swaps[start:end]
+	}
+
+	bz, err := codec.MarshalJSONIndent(types.ModuleCdc, swaps)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return bz, nil
+}
+
+func queryAtomicSwapsByOtherChainAddress(ctx sdk.Context, req abci.RequestQuery,
+	getSwaps func(sdk.Context, string) types.AtomicSwaps) ([]byte, error) {
+	var params types.QueryAtomicSwapsByOtherChainAddress
+	err := types.ModuleCdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+
+	swaps := getSwaps(ctx, params.Address)
+	start, end := client.Paginate(len(swaps), params.Page, params.Limit, 100)
+	if start < 0 || end < 0 {
+		swaps = types.AtomicSwaps{}
+	} else {
+		swaps = swaps[start:end]
+	}
+
+	bz, err := codec.MarshalJSONIndent(types.ModuleCdc, swaps)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return bz, nil
+}
+
 // query params in the bep3 store
 func queryGetParams(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, error) {
 	// Get params
@@ -130,7 +187,7 @@ func filterAtomicSwaps(ctx sdk.Context, swaps types.AtomicSwaps, params types.Qu
 	filteredSwaps := make(types.AtomicSwaps, 0, len(swaps))
 
 	for _, s := range swaps {
-		matchInvolve, matchExpiration, matchStatus, matchDirection := true, true, true, true
+		matchInvolve, matchExpiration, matchStatus, matchDirection, matchOtherChain := true, true, true, true, true
 
 		// match involved address (if supplied)
 		if len(params.Involve) > 0 {
@@ -152,7 +209,12 @@ func filterAtomicSwaps(ctx sdk.Context, swaps types.AtomicSwaps, params types.Qu
 			matchDirection = s.Direction == params.Direction
 		}
 
-		if matchInvolve && matchExpiration && matchStatus && matchDirection {
+		// match other chain (if supplied)
+		if len(params.OtherChainID) > 0 {
+			matchOtherChain = s.GetOtherChainID() == params.OtherChainID
+		}
+
+		if matchInvolve && matchExpiration && matchStatus && matchDirection && matchOtherChain {
 			filteredSwaps = append(filteredSwaps, s)
 		}
 	}
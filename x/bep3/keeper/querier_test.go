@@ -4,6 +4,7 @@ import (
 	"encoding/hex"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/suite"
 
@@ -74,7 +75,7 @@ func (suite *QuerierTestSuite) SetupTest() {
 		suite.Nil(err)
 
 		// Calculate swap ID and save
-		swapID := types.CalculateSwapID(randomNumberHash, addrs[0], TestSenderOtherChain)
+		swapID := types.CalculateSwapID(randomNumberHash, addrs[0], TestSenderOtherChain, types.DefaultChainID)
 		swapIDs = append(swapIDs, swapID)
 		isSwapID[hex.EncodeToString(swapID)] = true
 	}
@@ -106,6 +107,42 @@ func (suite *QuerierTestSuite) TestQueryAssetSupply() {
 	suite.Equal(supply, expectedSupply)
 }
 
+func (suite *QuerierTestSuite) TestQueryAssetSupplyTimeWindow() {
+	ctx := suite.ctx.WithIsCheckTx(false)
+
+	params := suite.keeper.GetParams(ctx)
+	params.SupportedAssets[0].TimeWindow = time.Hour
+	params.SupportedAssets[0].TimeWindowLimit = sdk.NewInt(1000)
+	suite.keeper.SetParams(ctx, params)
+
+	start := ctx.BlockTime()
+	suite.Nil(suite.keeper.IncrementIncomingAssetSupply(ctx, c("bnb", 100)))
+	suite.Nil(suite.keeper.IncrementIncomingAssetSupply(ctx.WithBlockTime(start.Add(30*time.Minute)), c("bnb", 200)))
+
+	querySupply := func(ctx sdk.Context) types.AssetSupply {
+		query := abci.RequestQuery{
+			Path: strings.Join([]string{custom, types.QuerierRoute, types.QueryGetAssetSupply}, "/"),
+			Data: types.ModuleCdc.MustMarshalJSON(types.NewQueryAssetSupply(tmbytes.HexBytes("bnb"))),
+		}
+		bz, err := suite.querier(ctx, []string{types.QueryGetAssetSupply}, query)
+		suite.Nil(err)
+
+		var supply types.AssetSupply
+		suite.Nil(types.ModuleCdc.UnmarshalJSON(bz, &supply))
+		return supply
+	}
+
+	// Both increases are within the time window
+	supply := querySupply(ctx.WithBlockTime(start.Add(45 * time.Minute)))
+	suite.Len(supply.RecentIncreases, 2)
+	suite.Equal(sdk.NewInt(300), supply.RecentIncreases.Total())
+
+	// The first increase has left the time window
+	supply = querySupply(ctx.WithBlockTime(start.Add(75 * time.Minute)))
+	suite.Len(supply.RecentIncreases, 1)
+	suite.Equal(sdk.NewInt(200), supply.RecentIncreases.Total())
+}
+
 func (suite *QuerierTestSuite) TestQueryAtomicSwap() {
 	ctx := suite.ctx.WithIsCheckTx(false)
 
@@ -154,7 +191,7 @@ func (suite *QuerierTestSuite) TestQueryAtomicSwaps() {
 	// Set up request query
 	query := abci.RequestQuery{
 		Path: strings.Join([]string{custom, types.QuerierRoute, types.QueryGetAtomicSwaps}, "/"),
-		Data: types.ModuleCdc.MustMarshalJSON(types.NewQueryAtomicSwaps(1, 100, sdk.AccAddress{}, 0, types.Open, types.Incoming)),
+		Data: types.ModuleCdc.MustMarshalJSON(types.NewQueryAtomicSwaps(1, 100, sdk.AccAddress{}, 0, types.Open, types.Incoming, "")),
 	}
 
 	bz, err := suite.querier(ctx, []string{types.QueryGetAtomicSwaps}, query)
@@ -170,6 +207,91 @@ func (suite *QuerierTestSuite) TestQueryAtomicSwaps() {
 	}
 }
 
+func (suite *QuerierTestSuite) TestQueryAtomicSwapsByOtherChain() {
+	ctx := suite.ctx.WithIsCheckTx(false)
+
+	queryOtherChain := func(otherChainID string) types.AtomicSwaps {
+		query := abci.RequestQuery{
+			Path: strings.Join([]string{custom, types.QuerierRoute, types.QueryGetAtomicSwaps}, "/"),
+			Data: types.ModuleCdc.MustMarshalJSON(types.NewQueryAtomicSwaps(1, 100, sdk.AccAddress{}, 0, types.NULL, types.INVALID, otherChainID)),
+		}
+		bz, err := suite.querier(ctx, []string{types.QueryGetAtomicSwaps}, query)
+		suite.Nil(err)
+
+		var swaps types.AtomicSwaps
+		suite.Nil(types.ModuleCdc.UnmarshalJSON(bz, &swaps))
+		return swaps
+	}
+
+	swaps := queryOtherChain(types.DefaultChainID)
+	suite.Equal(len(suite.swapIDs), len(swaps))
+	for _, swap := range swaps {
+		suite.Equal(types.DefaultChainID, swap.OtherChainID)
+	}
+
+	swaps = queryOtherChain("ethereum-1")
+	suite.Empty(swaps)
+}
+
+func (suite *QuerierTestSuite) TestQueryAtomicSwapsByRandomNumberHash() {
+	ctx := suite.ctx.WithIsCheckTx(false)
+	swap, found := suite.keeper.GetAtomicSwap(ctx, suite.swapIDs[0])
+	suite.True(found)
+
+	queryHash := func(page, limit int) types.AtomicSwaps {
+		query := abci.RequestQuery{
+			Path: strings.Join([]string{custom, types.QuerierRoute, types.QueryGetAtomicSwapsByRandomNumberHash}, "/"),
+			Data: types.ModuleCdc.MustMarshalJSON(types.NewQueryAtomicSwapsByRandomNumberHash(page, limit, swap.RandomNumberHash)),
+		}
+		bz, err := suite.querier(ctx, []string{types.QueryGetAtomicSwapsByRandomNumberHash}, query)
+		suite.Nil(err)
+
+		var swaps types.AtomicSwaps
+		suite.Nil(types.ModuleCdc.UnmarshalJSON(bz, &swaps))
+		return swaps
+	}
+
+	swaps := queryHash(1, 100)
+	suite.Equal(1, len(swaps))
+	suite.Equal(suite.swapIDs[0], tmbytes.HexBytes(swaps[0].GetSwapID()))
+
+	swaps = queryHash(2, 100)
+	suite.Empty(swaps)
+}
+
+func (suite *QuerierTestSuite) TestQueryAtomicSwapsByOtherChainAddress() {
+	ctx := suite.ctx.WithIsCheckTx(false)
+
+	queryAddress := func(path, address string, page, limit int) types.AtomicSwaps {
+		query := abci.RequestQuery{
+			Path: strings.Join([]string{custom, types.QuerierRoute, path}, "/"),
+			Data: types.ModuleCdc.MustMarshalJSON(types.NewQueryAtomicSwapsByOtherChainAddress(page, limit, address)),
+		}
+		bz, err := suite.querier(ctx, []string{path}, query)
+		suite.Nil(err)
+
+		var swaps types.AtomicSwaps
+		suite.Nil(types.ModuleCdc.UnmarshalJSON(bz, &swaps))
+		return swaps
+	}
+
+	swaps := queryAddress(types.QueryGetAtomicSwapsBySenderOtherChain, TestSenderOtherChain, 1, 100)
+	suite.Equal(len(suite.swapIDs), len(swaps))
+	for _, swap := range swaps {
+		suite.True(suite.isSwapID[hex.EncodeToString(swap.GetSwapID())])
+	}
+
+	swaps = queryAddress(types.QueryGetAtomicSwapsByRecipientOtherChain, TestRecipientOtherChain, 2, 4)
+	suite.Equal(4, len(swaps))
+
+	// addresses are matched case insensitively
+	swaps = queryAddress(types.QueryGetAtomicSwapsBySenderOtherChain, strings.ToUpper(TestSenderOtherChain), 1, 100)
+	suite.Equal(len(suite.swapIDs), len(swaps))
+
+	swaps = queryAddress(types.QueryGetAtomicSwapsBySenderOtherChain, TestRecipientOtherChain, 1, 100)
+	suite.Empty(swaps)
+}
+
 func (suite *QuerierTestSuite) TestQueryParams() {
 	ctx := suite.ctx.WithIsCheckTx(false)
 	bz, err := suite.querier(ctx, []string{types.QueryGetParams}, abci.RequestQuery{})
@@ -16,13 +16,6 @@ import (
 func (k Keeper) CreateAtomicSwap(ctx sdk.Context, randomNumberHash []byte, timestamp int64, heightSpan uint64,
 	sender sdk.AccAddress, recipient sdk.AccAddress, senderOtherChain, recipientOtherChain string,
 	amount sdk.Coins, crossChain bool) error {
-	// Confirm that this is not a duplicate swap
-	swapID := types.CalculateSwapID(randomNumberHash, sender, senderOtherChain)
-	_, found := k.GetAtomicSwap(ctx, swapID)
-	if found {
-		return sdkerrors.Wrap(types.ErrAtomicSwapAlreadyExists, hex.EncodeToString(swapID))
-	}
-
 	// Cannot send coins to a module account
 	if k.Maccs[recipient.String()] {
 		return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is a module account", recipient)
@@ -41,23 +34,72 @@ func (k Keeper) CreateAtomicSwap(ctx sdk.Context, randomNumberHash []byte, times
 		return sdkerrors.Wrap(types.ErrInvalidTimestamp, time.Unix(timestamp, 0).UTC().String())
 	}
 
-	if len(amount) != 1 {
-		return fmt.Errorf("amount must contain exactly one coin")
+	if amount.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, "amount cannot be empty")
 	}
 
-	err := k.ValidateLiveAsset(ctx, amount[0])
-	if err != nil {
-		return err
+	// Every coin must be a live asset, and all coins must be bridged from the same counterparty chain
+	var assets types.AssetParams
+	for _, coin := range amount {
+		if err := k.ValidateLiveAsset(ctx, coin); err != nil {
+			return err
+		}
+		asset, _ := k.GetAssetByDenom(ctx, coin.Denom)
+		if len(assets) > 0 && asset.ChainID != assets[0].ChainID {
+			return sdkerrors.Wrapf(types.ErrMixedCounterparties, "%s from %s, %s from %s", assets[0].Denom, assets[0].ChainID, asset.Denom, asset.ChainID)
+		}
+		assets = append(assets, asset)
+	}
+	chainID := assets[0].ChainID
+
+	// Confirm that this is not a duplicate swap
+	swapID := types.CalculateSwapID(randomNumberHash, sender, senderOtherChain, chainID)
+	_, found := k.GetAtomicSwap(ctx, swapID)
+	if found {
+		return sdkerrors.Wrap(types.ErrAtomicSwapAlreadyExists, hex.EncodeToString(swapID))
 	}
 
+	// Addresses on the other chain must match the format of the assets' counterparty chain
+	for _, asset := range assets {
+		if err := asset.ValidateOtherChainAddress(senderOtherChain); err != nil {
+			return sdkerrors.Wrap(types.ErrInvalidOtherChainAddress, err.Error())
+		}
+		if err := asset.ValidateOtherChainAddress(recipientOtherChain); err != nil {
+			return sdkerrors.Wrap(types.ErrInvalidOtherChainAddress, err.Error())
+		}
+	}
+
+	// Swaps sent by a deputy are incoming, swaps sent to a deputy are outgoing
 	var direction types.SwapDirection
-	deputy := k.GetBnbDeputyAddress(ctx)
-	if sender.Equals(deputy) {
+	deputy, found := k.GetDeputy(ctx, sender)
+	if found {
 		direction = types.Incoming
 	} else {
+		deputy, found = k.GetDeputy(ctx, recipient)
+		if !found {
+			return sdkerrors.Wrapf(types.ErrInvalidDeputy, "sender %s, recipient %s", sender, recipient)
+		}
 		direction = types.Outgoing
 	}
 
+	for i, coin := range amount {
+		if !deputy.SupportsAsset(coin.Denom) {
+			return sdkerrors.Wrapf(types.ErrAssetNotSupported, "%s by deputy %s", coin.Denom, deputy.Address)
+		}
+		minAmount, maxAmount := deputy.GetSwapAmountLimits(coin.Denom)
+		if coin.Amount.LT(minAmount) || coin.Amount.GT(maxAmount) {
+			return sdkerrors.Wrapf(types.ErrInvalidSwapAmount, "%s, range %s - %s", coin, minAmount, maxAmount)
+		}
+		// Each coin in outgoing swaps must be greater than the deputy's fee on the asset.
+		// Incoming swaps have already had their fees collected by the deputy during the relay process.
+		if direction == types.Outgoing {
+			fee := deputy.GetAssetFee(assets[i]).Calculate(coin.Amount)
+			if coin.Amount.LTE(fee) {
+				return sdkerrors.Wrapf(types.ErrInsufficientAmount, "%s, fee %s", coin, fee)
+			}
+		}
+	}
+
 	switch direction {
 	case types.Incoming:
 		// If recipient's account doesn't exist, register it in state so that the address can send
@@ -67,23 +109,23 @@ func (k Keeper) CreateAtomicSwap(ctx sdk.Context, randomNumberHash []byte, times
 			newAcc := k.accountKeeper.NewAccountWithAddress(ctx, recipient)
 			k.accountKeeper.SetAccount(ctx, newAcc)
 		}
-		// Incoming swaps have already had their fees collected by the deputy during the relay process.
-		err = k.IncrementIncomingAssetSupply(ctx, amount[0])
+		for _, coin := range amount {
+			if err := k.IncrementIncomingAssetSupply(ctx, coin); err != nil {
+				return err
+			}
+		}
 	case types.Outgoing:
-		// Amount in outgoing swaps must be greater than the deputy's fixed fee.
-		if amount[0].Amount.Uint64() <= k.GetBnbDeputyFixedFee(ctx) {
-			return sdkerrors.Wrap(types.ErrInsufficientAmount, amount[0].String())
+		for _, coin := range amount {
+			if err := k.IncrementOutgoingAssetSupply(ctx, coin); err != nil {
+				return err
+			}
 		}
-		err = k.IncrementOutgoingAssetSupply(ctx, amount[0])
 	default:
-		err = fmt.Errorf("invalid swap direction: %s", direction.String())
-	}
-	if err != nil {
-		return err
+		return fmt.Errorf("invalid swap direction: %s", direction.String())
 	}
 
 	// Transfer coins to module
-	err = k.supplyKeeper.SendCoinsFromAccountToModule(ctx, sender, types.ModuleName, amount)
+	err := k.supplyKeeper.SendCoinsFromAccountToModule(ctx, sender, types.ModuleName, amount)
 	if err != nil {
 		return err
 	}
@@ -91,11 +133,12 @@ func (k Keeper) CreateAtomicSwap(ctx sdk.Context, randomNumberHash []byte, times
 	// Store the details of the swap
 	expireHeight := uint64(ctx.BlockHeight()) + heightSpan
 	atomicSwap := types.NewAtomicSwap(amount, randomNumberHash, expireHeight, timestamp, sender,
-		recipient, senderOtherChain, recipientOtherChain, 0, types.Open, crossChain, direction)
+		recipient, senderOtherChain, recipientOtherChain, chainID, 0, types.Open, crossChain, direction)
 
 	// Insert the atomic swap under both keys
 	k.SetAtomicSwap(ctx, atomicSwap)
 	k.InsertIntoByBlockIndex(ctx, atomicSwap)
+	k.InsertIntoLookupIndexes(ctx, atomicSwap)
 
 	// Emit 'create_atomic_swap' event
 	ctx.EventManager().EmitEvent(
@@ -107,9 +150,11 @@ func (k Keeper) CreateAtomicSwap(ctx sdk.Context, randomNumberHash []byte, times
 			sdk.NewAttribute(types.AttributeKeyRandomNumberHash, hex.EncodeToString(atomicSwap.RandomNumberHash)),
 			sdk.NewAttribute(types.AttributeKeyTimestamp, fmt.Sprintf("%d", atomicSwap.Timestamp)),
 			sdk.NewAttribute(types.AttributeKeySenderOtherChain, atomicSwap.SenderOtherChain),
+			sdk.NewAttribute(types.AttributeKeyOtherChainID, atomicSwap.OtherChainID),
 			sdk.NewAttribute(types.AttributeKeyExpireHeight, fmt.Sprintf("%d", atomicSwap.ExpireHeight)),
 			sdk.NewAttribute(types.AttributeKeyAmount, atomicSwap.Amount.String()),
 			sdk.NewAttribute(types.AttributeKeyDirection, atomicSwap.Direction.String()),
+			sdk.NewAttribute(types.AttributeKeyDeputy, deputy.Address.String()),
 		),
 	)
 
@@ -130,39 +175,36 @@ func (k Keeper) ClaimAtomicSwap(ctx sdk.Context, from sdk.AccAddress, swapID []b
 
 	//  Calculate hashed secret using submitted number
 	hashedSubmittedNumber := types.CalculateRandomHash(randomNumber, atomicSwap.Timestamp)
-	hashedSecret := types.CalculateSwapID(hashedSubmittedNumber, atomicSwap.Sender, atomicSwap.SenderOtherChain)
+	hashedSecret := types.CalculateSwapID(hashedSubmittedNumber, atomicSwap.Sender, atomicSwap.SenderOtherChain, atomicSwap.OtherChainID)
 
 	// Confirm that secret unlocks the atomic swap
 	if !bytes.Equal(hashedSecret, atomicSwap.GetSwapID()) {
 		return sdkerrors.Wrapf(types.ErrInvalidClaimSecret, "the submitted random number is incorrect")
 	}
 
-	var err error
-	switch atomicSwap.Direction {
-	case types.Incoming:
-		err = k.DecrementIncomingAssetSupply(ctx, atomicSwap.Amount[0])
-		if err != nil {
-			return err
+	for _, coin := range atomicSwap.Amount {
+		switch atomicSwap.Direction {
+		case types.Incoming:
+			if err := k.DecrementIncomingAssetSupply(ctx, coin); err != nil {
+				return err
+			}
+			if err := k.IncrementCurrentAssetSupply(ctx, coin); err != nil {
+				return err
+			}
+		case types.Outgoing:
+			if err := k.DecrementOutgoingAssetSupply(ctx, coin); err != nil {
+				return err
+			}
+			if err := k.DecrementCurrentAssetSupply(ctx, coin); err != nil {
+				return err
+			}
+		default:
+			return fmt.Errorf("invalid swap direction: %s", atomicSwap.Direction.String())
 		}
-		err = k.IncrementCurrentAssetSupply(ctx, atomicSwap.Amount[0])
-		if err != nil {
-			return err
-		}
-	case types.Outgoing:
-		err = k.DecrementOutgoingAssetSupply(ctx, atomicSwap.Amount[0])
-		if err != nil {
-			return err
-		}
-		err = k.DecrementCurrentAssetSupply(ctx, atomicSwap.Amount[0])
-		if err != nil {
-			return err
-		}
-	default:
-		return fmt.Errorf("invalid swap direction: %s", atomicSwap.Direction.String())
 	}
 
 	// Send intended recipient coins
-	err = k.supplyKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, atomicSwap.Recipient, atomicSwap.Amount)
+	err := k.supplyKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, atomicSwap.Recipient, atomicSwap.Amount)
 	if err != nil {
 		return err
 	}
@@ -202,22 +244,21 @@ func (k Keeper) RefundAtomicSwap(ctx sdk.Context, from sdk.AccAddress, swapID []
 		return sdkerrors.Wrapf(types.ErrSwapNotRefundable, "status %s", atomicSwap.Status.String())
 	}
 
-	var err error
 	switch atomicSwap.Direction {
 	case types.Incoming:
-		err = k.DecrementIncomingAssetSupply(ctx, atomicSwap.Amount[0])
+		// Incoming supply was decremented when the swap expired
 	case types.Outgoing:
-		err = k.DecrementOutgoingAssetSupply(ctx, atomicSwap.Amount[0])
+		for _, coin := range atomicSwap.Amount {
+			if err := k.DecrementOutgoingAssetSupply(ctx, coin); err != nil {
+				return err
+			}
+		}
 	default:
-		err = fmt.Errorf("invalid swap direction: %s", atomicSwap.Direction.String())
-	}
-
-	if err != nil {
-		return err
+		return fmt.Errorf("invalid swap direction: %s", atomicSwap.Direction.String())
 	}
 
 	// Refund coins to original swap sender
-	err = k.supplyKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, atomicSwap.Sender, atomicSwap.Amount)
+	err := k.supplyKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, atomicSwap.Sender, atomicSwap.Amount)
 	if err != nil {
 		return err
 	}
@@ -227,7 +268,8 @@ func (k Keeper) RefundAtomicSwap(ctx sdk.Context, from sdk.AccAddress, swapID []
 	atomicSwap.ClosedBlock = ctx.BlockHeight()
 	k.SetAtomicSwap(ctx, atomicSwap)
 
-	// Transition to longterm storage
+	// Transition from the expired index to longterm storage
+	k.RemoveFromExpiredIndex(ctx, atomicSwap)
 	k.InsertIntoLongtermStorage(ctx, atomicSwap)
 
 	// Emit 'refund_atomic_swap' event
@@ -257,7 +299,12 @@ func (k Keeper) UpdateExpiredAtomicSwaps(ctx sdk.Context) {
 		atomicSwap.Status = types.Expired
 		// Note: claimed swaps have already been removed from byBlock index.
 		k.RemoveFromByBlockIndex(ctx, atomicSwap)
+		k.InsertIntoExpiredIndex(ctx, atomicSwap)
 		k.SetAtomicSwap(ctx, atomicSwap)
+		// Expired incoming swaps can no longer be claimed, so they no longer count towards the supply limit
+		if atomicSwap.Direction == types.Incoming {
+			k.releaseIncomingAssetSupply(ctx, atomicSwap)
+		}
 		expiredSwapIDs = append(expiredSwapIDs, hex.EncodeToString(atomicSwap.GetSwapID()))
 		return false
 	})
@@ -272,6 +319,48 @@ func (k Keeper) UpdateExpiredAtomicSwaps(ctx sdk.Context) {
 	)
 }
 
+// releaseIncomingAssetSupply removes an expired incoming swap's coins from their assets' incoming supplies.
+// A failed decrement is logged and leaves every incoming supply unchanged, it can only fail if the
+// incoming supply is already out of step with the incoming swaps.
+func (k Keeper) releaseIncomingAssetSupply(ctx sdk.Context, atomicSwap types.AtomicSwap) {
+	cacheCtx, write := ctx.CacheContext()
+	for _, coin := range atomicSwap.Amount {
+		if err := k.DecrementIncomingAssetSupply(cacheCtx, coin); err != nil {
+			k.Logger(ctx).Error(fmt.Sprintf("failed to release incoming supply of expired atomic swap %s: %s", hex.EncodeToString(atomicSwap.GetSwapID()), err))
+			return
+		}
+	}
+	write()
+}
+
+// RefundExpiredAtomicSwaps refunds expired swaps in order of expiration, up to the maximum number of auto refunds per block.
+// Swaps that fail to refund are left expired and can still be refunded with a MsgRefundAtomicSwap.
+func (k Keeper) RefundExpiredAtomicSwaps(ctx sdk.Context) {
+	params := k.GetParams(ctx)
+	if !params.AutoRefund {
+		return
+	}
+
+	var swapIDs [][]byte
+	k.IterateExpiredAtomicSwaps(ctx, func(id []byte) bool {
+		swapIDs = append(swapIDs, id)
+		return uint64(len(swapIDs)) >= params.MaxAutoRefunds
+	})
+
+	moduleAddress := k.supplyKeeper.GetModuleAddress(types.ModuleName)
+	for _, id := range swapIDs {
+		// Refund each swap in a cached context so a failed refund leaves no partial state changes or events
+		cacheCtx, write := ctx.CacheContext()
+		cacheCtx = cacheCtx.WithEventManager(sdk.NewEventManager())
+		if err := k.RefundAtomicSwap(cacheCtx, moduleAddress, id); err != nil {
+			k.Logger(ctx).Error(fmt.Sprintf("failed to refund expired atomic swap %s: %s", hex.EncodeToString(id), err))
+			continue
+		}
+		write()
+		ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+	}
+}
+
 // DeleteClosedAtomicSwapsFromLongtermStorage removes swaps one week after completion.
 func (k Keeper) DeleteClosedAtomicSwapsFromLongtermStorage(ctx sdk.Context) {
 	k.IterateAtomicSwapsLongtermStorage(ctx, uint64(ctx.BlockHeight()), func(id []byte) bool {
@@ -282,6 +371,7 @@ func (k Keeper) DeleteClosedAtomicSwapsFromLongtermStorage(ctx sdk.Context) {
 		}
 		k.RemoveAtomicSwap(ctx, swap.GetSwapID())
 		k.RemoveFromLongtermStorage(ctx, swap)
+		k.RemoveFromLookupIndexes(ctx, swap)
 		return false
 	})
 }
@@ -1,6 +1,7 @@
 package keeper_test
 
 import (
+	"errors"
 	"testing"
 	"time"
 
@@ -146,7 +147,7 @@ func (suite *AtomicSwapTestSuite) TestCreateAtomicSwap() {
 				timestamp:           suite.timestamps[0],
 				heightSpan:          uint64(360),
 				sender:              suite.addrs[1],
-				recipient:           suite.addrs[2],
+				recipient:           suite.deputy,
 				senderOtherChain:    TestSenderOtherChain,
 				recipientOtherChain: TestRecipientOtherChain,
 				coins:               cs(c(BNB_DENOM, 50000)),
@@ -158,23 +159,77 @@ func (suite *AtomicSwapTestSuite) TestCreateAtomicSwap() {
 		},
 		{
 
-			"outgoing swap amount not greater than fixed fee",
+			"outgoing swap amount not greater than fee",
 			currentTmTime,
 			args{
 				randomNumberHash:    suite.randomNumberHashes[1],
 				timestamp:           suite.timestamps[1],
 				heightSpan:          uint64(360),
 				sender:              suite.addrs[1],
+				recipient:           suite.deputy,
+				senderOtherChain:    TestSenderOtherChain,
+				recipientOtherChain: TestRecipientOtherChain,
+				coins:               cs(c(BNB_DENOM, types.DefaultFee.FixedFee.Int64())),
+				crossChain:          true,
+				direction:           types.Outgoing,
+			},
+			false,
+			false,
+		},
+		{
+			"outgoing swap recipient not a deputy",
+			currentTmTime,
+			args{
+				randomNumberHash:    suite.randomNumberHashes[9],
+				timestamp:           suite.timestamps[9],
+				heightSpan:          uint64(360),
+				sender:              suite.addrs[1],
 				recipient:           suite.addrs[2],
 				senderOtherChain:    TestSenderOtherChain,
 				recipientOtherChain: TestRecipientOtherChain,
-				coins:               cs(c(BNB_DENOM, int64(suite.keeper.GetBnbDeputyFixedFee(suite.ctx)))),
+				coins:               cs(c(BNB_DENOM, 50000)),
 				crossChain:          true,
 				direction:           types.Outgoing,
 			},
 			false,
 			false,
 		},
+		{
+			"invalid sender other chain address",
+			currentTmTime,
+			args{
+				randomNumberHash:    suite.randomNumberHashes[9],
+				timestamp:           suite.timestamps[9],
+				heightSpan:          uint64(360),
+				sender:              suite.deputy,
+				recipient:           suite.addrs[1],
+				senderOtherChain:    "0x52908400098527886E0F7030069857D2E4169EE7",
+				recipientOtherChain: TestRecipientOtherChain,
+				coins:               cs(c(BNB_DENOM, 50000)),
+				crossChain:          true,
+				direction:           types.Incoming,
+			},
+			false,
+			false,
+		},
+		{
+			"invalid recipient other chain address",
+			currentTmTime,
+			args{
+				randomNumberHash:    suite.randomNumberHashes[9],
+				timestamp:           suite.timestamps[9],
+				heightSpan:          uint64(360),
+				sender:              suite.deputy,
+				recipient:           suite.addrs[1],
+				senderOtherChain:    TestSenderOtherChain,
+				recipientOtherChain: suite.addrs[1].String(),
+				coins:               cs(c(BNB_DENOM, 50000)),
+				crossChain:          true,
+				direction:           types.Incoming,
+			},
+			false,
+			false,
+		},
 		{
 			"unsupported asset",
 			currentTmTime,
@@ -354,7 +409,7 @@ func (suite *AtomicSwapTestSuite) TestCreateAtomicSwap() {
 			assetSupplyPost, _ := suite.keeper.GetAssetSupply(suite.ctx, []byte(swapAssetDenom))
 
 			// Load expected swap ID
-			expectedSwapID := types.CalculateSwapID(tc.args.randomNumberHash, tc.args.sender, tc.args.senderOtherChain)
+			expectedSwapID := types.CalculateSwapID(tc.args.randomNumberHash, tc.args.sender, tc.args.senderOtherChain, types.DefaultChainID)
 
 			if tc.expectPass {
 				suite.NoError(err)
@@ -388,6 +443,7 @@ func (suite *AtomicSwapTestSuite) TestCreateAtomicSwap() {
 						Recipient:           tc.args.recipient,
 						SenderOtherChain:    tc.args.senderOtherChain,
 						RecipientOtherChain: tc.args.recipientOtherChain,
+						OtherChainID:        types.DefaultChainID,
 						ClosedBlock:         0,
 						Status:              types.Open,
 						CrossChain:          tc.args.crossChain,
@@ -421,6 +477,125 @@ func (suite *AtomicSwapTestSuite) TestCreateAtomicSwap() {
 	}
 }
 
+func (suite *AtomicSwapTestSuite) TestCreateAtomicSwapDeputyLimits() {
+	// Add a second deputy that relays a limited range of bnb swaps, a third that charges its own fixed fee, and activate the inc asset
+	limitedDeputy := suite.addrs[9]
+	feeDeputy := suite.addrs[8]
+	params := suite.keeper.GetParams(suite.ctx)
+	params.Deputies = append(params.Deputies,
+		types.NewDeputyParam(limitedDeputy, nil, []string{BNB_DENOM}, cs(c(BNB_DENOM, 100)), cs(c(BNB_DENOM, 100000))),
+		types.NewDeputyParam(feeDeputy, cs(c(BNB_DENOM, 60000)), []string{BNB_DENOM}, nil, cs(c(BNB_DENOM, StandardSupplyLimit.Int64()))),
+	)
+	params.SupportedAssets[1].Active = true
+	suite.keeper.SetParams(suite.ctx, params)
+	suite.Nil(suite.keeper.IncrementCurrentAssetSupply(suite.ctx, c(BNB_DENOM, 1000000)))
+
+	testCases := []struct {
+		name        string
+		sender      sdk.AccAddress
+		recipient   sdk.AccAddress
+		coins       sdk.Coins
+		expectedErr error
+	}{
+		{"incoming within limits", limitedDeputy, suite.addrs[1], cs(c(BNB_DENOM, 50000)), nil},
+		{"outgoing within limits", suite.addrs[1], limitedDeputy, cs(c(BNB_DENOM, 50000)), nil},
+		{"incoming below minimum", limitedDeputy, suite.addrs[1], cs(c(BNB_DENOM, 99)), types.ErrInvalidSwapAmount},
+		{"outgoing above maximum", suite.addrs[1], limitedDeputy, cs(c(BNB_DENOM, 100001)), types.ErrInvalidSwapAmount},
+		{"asset not relayed by deputy", limitedDeputy, suite.addrs[1], cs(c("inc", 50)), types.ErrAssetNotSupported},
+		{"neither party is a deputy", suite.addrs[1], suite.addrs[2], cs(c(BNB_DENOM, 50000)), types.ErrInvalidDeputy},
+		{"outgoing below deputy's fixed fee", suite.addrs[1], feeDeputy, cs(c(BNB_DENOM, 50000)), types.ErrInsufficientAmount},
+		{"outgoing above deputy's fixed fee", suite.addrs[1], feeDeputy, cs(c(BNB_DENOM, 70000)), nil},
+	}
+
+	for idx, tc := range testCases {
+		suite.Run(tc.name, func() {
+			err := suite.keeper.CreateAtomicSwap(suite.ctx, suite.randomNumberHashes[idx], suite.timestamps[idx],
+				uint64(360), tc.sender, tc.recipient, TestSenderOtherChain, TestRecipientOtherChain, tc.coins, true)
+			if tc.expectedErr == nil {
+				suite.NoError(err)
+			} else {
+				suite.Require().Error(err)
+				suite.True(errors.Is(err, tc.expectedErr), err.Error())
+			}
+		})
+	}
+}
+
+func (suite *AtomicSwapTestSuite) TestAtomicSwapMultiCoin() {
+	// Add btc from the same counterparty chain as bnb with a percentage fee, and weth from another chain
+	params := suite.keeper.GetParams(suite.ctx)
+	params.Deputies[0].SupportedAssets = []string{BNB_DENOM, "inc", "btc", "weth"}
+	params.Deputies[0].MaxSwapAmounts = params.Deputies[0].MaxSwapAmounts.Add(c("btc", 30000), c("weth", StandardSupplyLimit.Int64()))
+	params.SupportedAssets = append(params.SupportedAssets,
+		types.NewAssetParam("btc", 0, StandardSupplyLimit, true, types.DefaultChainID, types.AddressFormatBech32, "bnb",
+			0, sdk.ZeroInt(), types.NewAssetFee(i(10), 100, i(0))),
+		types.NewAssetParam("weth", 60, StandardSupplyLimit, true, "ethereum-1", types.AddressFormatHex, "0x",
+			0, sdk.ZeroInt(), types.DefaultFee),
+	)
+	suite.keeper.SetParams(suite.ctx, params)
+	for _, denom := range []string{"btc", "weth"} {
+		suite.keeper.SetAssetSupply(suite.ctx, types.NewAssetSupply(denom, c(denom, 0), c(denom, 0), c(denom, 0), c(denom, StandardSupplyLimit.Int64())), []byte(denom))
+	}
+	_, err := suite.app.GetBankKeeper().AddCoins(suite.ctx, suite.deputy, cs(c("btc", 1000000), c("weth", 1000000)))
+	suite.Require().NoError(err)
+
+	user := suite.addrs[1]
+	ak := suite.app.GetAccountKeeper()
+	requireSupplies := func(denom string, incoming, outgoing, current int64) {
+		supply, found := suite.keeper.GetAssetSupply(suite.ctx, []byte(denom))
+		suite.Require().True(found)
+		suite.Equal(c(denom, incoming), supply.IncomingSupply, denom)
+		suite.Equal(c(denom, outgoing), supply.OutgoingSupply, denom)
+		suite.Equal(c(denom, current), supply.CurrentSupply, denom)
+	}
+
+	// Each coin must be within the deputy's swap amount limits for its own asset
+	err = suite.keeper.CreateAtomicSwap(suite.ctx, suite.randomNumberHashes[0], suite.timestamps[0], uint64(360),
+		suite.deputy, user, TestSenderOtherChain, TestRecipientOtherChain, cs(c(BNB_DENOM, 50000), c("btc", 40000)), true)
+	suite.True(errors.Is(err, types.ErrInvalidSwapAmount), err)
+
+	// An incoming swap of bnb and btc is claimed with both coins
+	amount := cs(c(BNB_DENOM, 50000), c("btc", 20000))
+	suite.Require().NoError(suite.keeper.CreateAtomicSwap(suite.ctx, suite.randomNumberHashes[0], suite.timestamps[0], uint64(360),
+		suite.deputy, user, TestSenderOtherChain, TestRecipientOtherChain, amount, true))
+	swapID := types.CalculateSwapID(suite.randomNumberHashes[0], suite.deputy, TestSenderOtherChain, types.DefaultChainID)
+	swap, found := suite.keeper.GetAtomicSwap(suite.ctx, swapID)
+	suite.Require().True(found)
+	suite.Equal(amount, swap.Amount)
+	requireSupplies(BNB_DENOM, 50000, 0, 0)
+	requireSupplies("btc", 20000, 0, 0)
+
+	suite.Require().NoError(suite.keeper.ClaimAtomicSwap(suite.ctx, user, swapID, suite.randomNumbers[0]))
+	suite.Equal(int64(20000), ak.GetAccount(suite.ctx, user).GetCoins().AmountOf("btc").Int64())
+	requireSupplies(BNB_DENOM, 0, 0, 50000)
+	requireSupplies("btc", 0, 0, 20000)
+
+	// Each coin of an outgoing swap must be greater than its asset's fee, btc's fee on 10 is its fixed fee of 10
+	err = suite.keeper.CreateAtomicSwap(suite.ctx, suite.randomNumberHashes[1], suite.timestamps[1], uint64(360),
+		user, suite.deputy, TestSenderOtherChain, TestRecipientOtherChain, cs(c(BNB_DENOM, 50000), c("btc", 10)), true)
+	suite.True(errors.Is(err, types.ErrInsufficientAmount), err)
+
+	// Coins bridged from different counterparty chains can't share a swap
+	err = suite.keeper.CreateAtomicSwap(suite.ctx, suite.randomNumberHashes[1], suite.timestamps[1], uint64(360),
+		suite.deputy, user, TestSenderOtherChain, TestRecipientOtherChain, cs(c(BNB_DENOM, 50000), c("weth", 50000)), true)
+	suite.True(errors.Is(err, types.ErrMixedCounterparties), err)
+
+	// An outgoing swap of bnb and btc is refunded with both coins after it expires
+	amount = cs(c(BNB_DENOM, 30000), c("btc", 1000))
+	suite.Require().NoError(suite.keeper.CreateAtomicSwap(suite.ctx, suite.randomNumberHashes[1], suite.timestamps[1], uint64(360),
+		user, suite.deputy, TestSenderOtherChain, TestRecipientOtherChain, amount, true))
+	requireSupplies(BNB_DENOM, 0, 30000, 50000)
+	requireSupplies("btc", 0, 1000, 20000)
+
+	suite.ctx = suite.ctx.WithBlockHeight(suite.ctx.BlockHeight() + 360)
+	suite.keeper.UpdateExpiredAtomicSwaps(suite.ctx)
+	swapID = types.CalculateSwapID(suite.randomNumberHashes[1], user, TestSenderOtherChain, types.DefaultChainID)
+	suite.Require().NoError(suite.keeper.RefundAtomicSwap(suite.ctx, user, swapID))
+	suite.Equal(int64(20000), ak.GetAccount(suite.ctx, user).GetCoins().AmountOf("btc").Int64())
+	requireSupplies(BNB_DENOM, 0, 0, 50000)
+	requireSupplies("btc", 0, 0, 20000)
+}
+
 func (suite *AtomicSwapTestSuite) TestClaimAtomicSwap() {
 	suite.SetupTest()
 	invalidRandomNumber, _ := types.GenerateSecureRandomNumber()
@@ -469,7 +644,7 @@ func (suite *AtomicSwapTestSuite) TestClaimAtomicSwap() {
 			"wrong swap ID",
 			suite.ctx,
 			args{
-				swapID:       types.CalculateSwapID(suite.randomNumberHashes[3], suite.addrs[6], TestRecipientOtherChain),
+				swapID:       types.CalculateSwapID(suite.randomNumberHashes[3], suite.addrs[6], TestRecipientOtherChain, types.DefaultChainID),
 				randomNumber: []byte{},
 				direction:    types.Outgoing,
 			},
@@ -494,9 +669,10 @@ func (suite *AtomicSwapTestSuite) TestClaimAtomicSwap() {
 			expectedClaimAmount := cs(c(BNB_DENOM, 50000))
 			sender := suite.deputy
 
-			// Set sender to other and increment current asset supply for outgoing swap
+			// Set sender to other, recipient to deputy, and increment current asset supply for outgoing swap
 			if tc.args.direction == types.Outgoing {
 				sender = suite.addrs[6]
+				expectedRecipient = suite.deputy
 				err := suite.keeper.IncrementCurrentAssetSupply(suite.ctx, expectedClaimAmount[0])
 				suite.Nil(err)
 			}
@@ -507,7 +683,7 @@ func (suite *AtomicSwapTestSuite) TestClaimAtomicSwap() {
 				expectedClaimAmount, true)
 			suite.NoError(err)
 
-			realSwapID := types.CalculateSwapID(suite.randomNumberHashes[i], sender, TestSenderOtherChain)
+			realSwapID := types.CalculateSwapID(suite.randomNumberHashes[i], sender, TestSenderOtherChain, types.DefaultChainID)
 
 			// If args contains an invalid swap ID claim attempt will use it instead of the real swap ID
 			var claimSwapID []byte
@@ -635,7 +811,7 @@ func (suite *AtomicSwapTestSuite) TestRefundAtomicSwap() {
 			"wrong swapID",
 			suite.ctx,
 			args{
-				swapID:    types.CalculateSwapID(suite.randomNumberHashes[6], suite.addrs[1], TestRecipientOtherChain),
+				swapID:    types.CalculateSwapID(suite.randomNumberHashes[6], suite.addrs[1], TestRecipientOtherChain, types.DefaultChainID),
 				direction: types.Incoming,
 			},
 			false,
@@ -648,20 +824,22 @@ func (suite *AtomicSwapTestSuite) TestRefundAtomicSwap() {
 			// Create atomic swap
 			expectedRefundAmount := cs(c(BNB_DENOM, 50000))
 			sender := suite.deputy
+			recipient := suite.addrs[9]
 
-			// Set sender to other and increment current asset supply for outgoing swap
+			// Set sender to other, recipient to deputy, and increment current asset supply for outgoing swap
 			if tc.args.direction == types.Outgoing {
 				sender = suite.addrs[6]
+				recipient = suite.deputy
 				err := suite.keeper.IncrementCurrentAssetSupply(suite.ctx, expectedRefundAmount[0])
 				suite.Nil(err)
 			}
 
 			err := suite.keeper.CreateAtomicSwap(suite.ctx, suite.randomNumberHashes[i], suite.timestamps[i],
-				uint64(360), sender, suite.addrs[9], TestSenderOtherChain, TestRecipientOtherChain,
+				uint64(360), sender, recipient, TestSenderOtherChain, TestRecipientOtherChain,
 				expectedRefundAmount, true)
 			suite.NoError(err)
 
-			realSwapID := types.CalculateSwapID(suite.randomNumberHashes[i], sender, TestSenderOtherChain)
+			realSwapID := types.CalculateSwapID(suite.randomNumberHashes[i], sender, TestSenderOtherChain, types.DefaultChainID)
 
 			// If args contains an invalid swap ID refund attempt will use it instead of the real swap ID
 			var refundSwapID []byte
@@ -698,8 +876,9 @@ func (suite *AtomicSwapTestSuite) TestRefundAtomicSwap() {
 				// Check asset supply changes
 				switch tc.args.direction {
 				case types.Incoming:
-					// Check incoming supply decreased
-					suite.True(assetSupplyPre.IncomingSupply.Sub(expectedRefundAmount[0]).IsEqual(assetSupplyPost.IncomingSupply))
+					// Check incoming supply was decreased on expiry and not changed by the refund
+					suite.True(assetSupplyPre.IncomingSupply.IsZero())
+					suite.Equal(assetSupplyPre.IncomingSupply, assetSupplyPost.IncomingSupply)
 					// Check current, outgoing supply not changed
 					suite.Equal(assetSupplyPre.CurrentSupply, assetSupplyPost.CurrentSupply)
 					suite.Equal(assetSupplyPre.OutgoingSupply, assetSupplyPost.OutgoingSupply)
@@ -28,7 +28,11 @@ func DecodeStore(cdc *codec.Codec, kvA, kvB kv.Pair) string {
 		return fmt.Sprintf("%s\n%s", supplyA, supplyB)
 
 	case bytes.Equal(kvA.Key[:1], types.AtomicSwapByBlockPrefix),
-		bytes.Equal(kvA.Key[:1], types.AtomicSwapLongtermStoragePrefix):
+		bytes.Equal(kvA.Key[:1], types.AtomicSwapLongtermStoragePrefix),
+		bytes.Equal(kvA.Key[:1], types.AtomicSwapExpiredPrefix),
+		bytes.Equal(kvA.Key[:1], types.AtomicSwapByRandomNumberHashPrefix),
+		bytes.Equal(kvA.Key[:1], types.AtomicSwapBySenderOtherChainPrefix),
+		bytes.Equal(kvA.Key[:1], types.AtomicSwapByRecipientOtherChainPrefix):
 		var bytesA tmbytes.HexBytes = kvA.Value
 		var bytesB tmbytes.HexBytes = kvA.Value
 		return fmt.Sprintf("%s\n%s", bytesA.String(), bytesB.String())
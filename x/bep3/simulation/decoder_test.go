@@ -26,7 +26,7 @@ func TestDecodeDistributionStore(t *testing.T) {
 	cdc := makeTestCodec()
 
 	oneCoin := sdk.NewCoin("coin", sdk.OneInt())
-	swap := types.NewAtomicSwap(sdk.Coins{oneCoin}, nil, 10, 100, nil, nil, "otherChainSender", "otherChainRec", 200, types.Completed, true, types.Outgoing)
+	swap := types.NewAtomicSwap(sdk.Coins{oneCoin}, nil, 10, 100, nil, nil, "otherChainSender", "otherChainRec", types.DefaultChainID, 200, types.Completed, true, types.Outgoing)
 	supply := types.AssetSupply{Denom: "coin", IncomingSupply: oneCoin, OutgoingSupply: oneCoin, CurrentSupply: oneCoin, SupplyLimit: oneCoin}
 	bz := tmbytes.HexBytes([]byte{1, 2})
 
@@ -34,7 +34,11 @@ func TestDecodeDistributionStore(t *testing.T) {
 		kv.Pair{Key: types.AtomicSwapKeyPrefix, Value: cdc.MustMarshalBinaryLengthPrefixed(swap)},
 		kv.Pair{Key: types.AssetSupplyKeyPrefix, Value: cdc.MustMarshalBinaryLengthPrefixed(supply)},
 		kv.Pair{Key: types.AtomicSwapByBlockPrefix, Value: bz},
-		kv.Pair{Key: types.AtomicSwapByBlockPrefix, Value: bz},
+		kv.Pair{Key: types.AtomicSwapLongtermStoragePrefix, Value: bz},
+		kv.Pair{Key: types.AtomicSwapExpiredPrefix, Value: bz},
+		kv.Pair{Key: types.AtomicSwapByRandomNumberHashPrefix, Value: bz},
+		kv.Pair{Key: types.AtomicSwapBySenderOtherChainPrefix, Value: bz},
+		kv.Pair{Key: types.AtomicSwapByRecipientOtherChainPrefix, Value: bz},
 		kv.Pair{Key: []byte{0x99}, Value: []byte{0x99}},
 	}
 
@@ -46,6 +50,10 @@ func TestDecodeDistributionStore(t *testing.T) {
 		{"AssetSupply", fmt.Sprintf("%v\n%v", supply, supply)},
 		{"AtomicSwapByBlock", fmt.Sprintf("%s\n%s", bz, bz)},
 		{"AtomicSwapLongtermStorage", fmt.Sprintf("%s\n%s", bz, bz)},
+		{"AtomicSwapExpired", fmt.Sprintf("%s\n%s", bz, bz)},
+		{"AtomicSwapByRandomNumberHash", fmt.Sprintf("%s\n%s", bz, bz)},
+		{"AtomicSwapBySenderOtherChain", fmt.Sprintf("%s\n%s", bz, bz)},
+		{"AtomicSwapByRecipientOtherChain", fmt.Sprintf("%s\n%s", bz, bz)},
 		{"other", ""},
 	}
 	for i, tt := range tests {
@@ -1,9 +1,13 @@
 package simulation
 
 import (
+	"encoding/hex"
 	"fmt"
 	"math/rand"
 	"strings"
+	"time"
+
+	"github.com/tendermint/tendermint/libs/bech32"
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -18,29 +22,59 @@ import (
 
 // Simulation parameter constants
 const (
-	BnbDeputyAddress = "bnb_deputy_address"
-	MinBlockLock     = "min_block_lock"
-	MaxBlockLock     = "max_block_lock"
-	SupportedAssets  = "supported_assets"
+	Deputies        = "deputies"
+	MinBlockLock    = "min_block_lock"
+	MaxBlockLock    = "max_block_lock"
+	SupportedAssets = "supported_assets"
+	AutoRefund      = "auto_refund"
+	MaxAutoRefunds  = "max_auto_refunds"
 )
 
 var (
 	MaxSupplyLimit   = sdk.NewInt(1000000000000)
 	accs             []simulation.Account
 	ConsistentDenoms = [3]string{"bnb", "xrp", "btc"}
+	// ConsistentChainIDs counterparty chains that supported assets are bridged from
+	ConsistentChainIDs = [2]string{types.DefaultChainID, "ethereum-1"}
 )
 
-// GenRandBnbDeputy randomized BnbDeputyAddress
-func GenRandBnbDeputy(r *rand.Rand) simulation.Account {
-	acc, _ := simulation.RandomAcc(r, accs)
-	return acc
+// GenRandDeputies randomized Deputies, each relaying all of the supported assets and some with their own fixed fees
+func GenRandDeputies(r *rand.Rand, supportedAssets types.AssetParams) types.DeputyParams {
+	var denoms []string
+	for _, asset := range supportedAssets {
+		denoms = append(denoms, asset.Denom)
+	}
+
+	numDeputies := r.Intn(3) + 1
+	var deputies types.DeputyParams
+	for len(deputies) < numDeputies {
+		acc, _ := simulation.RandomAcc(r, accs)
+		if _, found := deputies.Get(acc.Address); found {
+			continue
+		}
+		// Half of the deputies charge their own fixed fees
+		var fixedFees sdk.Coins
+		if r.Intn(2) == 0 {
+			for _, denom := range denoms {
+				fixedFees = fixedFees.Add(sdk.NewCoin(denom, GenRandAssetFee(r).FixedFee))
+			}
+		}
+		minSwapAmounts, maxSwapAmounts := sdk.NewCoins(), sdk.NewCoins()
+		for _, denom := range denoms {
+			minSwapAmounts = minSwapAmounts.Add(sdk.NewCoin(denom, sdk.OneInt()))
+			maxSwapAmounts = maxSwapAmounts.Add(sdk.NewCoin(denom, MaxSupplyLimit))
+		}
+		deputies = append(deputies, types.NewDeputyParam(acc.Address, fixedFees, denoms, minSwapAmounts, maxSwapAmounts))
+	}
+	return deputies
 }
 
-// GenRandBnbDeputyFixedFee randomized BnbDeputyFixedFee in range [2, 10000]
-func GenRandBnbDeputyFixedFee(r *rand.Rand) uint64 {
-	min := int(2)
-	max := int(10000)
-	return uint64(r.Intn(max-min) + min)
+// GenRandAssetFee randomized asset Fee, a fixed fee in range [2, 10000] plus up to 100 basis points, with a minimum in range [0, 20000]
+func GenRandAssetFee(r *rand.Rand) types.AssetFee {
+	fixedFee := sdk.NewInt(int64(simulation.RandIntBetween(r, 2, 10000)))
+	feeRate := uint64(r.Intn(101))
+	minFee := sdk.NewInt(int64(r.Intn(20001)))
+	return types.NewAssetFee(fixedFee, feeRate, minFee)
 }
 
 // GenMinBlockLock randomized MinBlockLock
@@ -76,12 +110,43 @@ func GenSupportedAssets(r *rand.Rand) types.AssetParams {
 func genSupportedAsset(r *rand.Rand, denom string) types.AssetParam {
 	coinID, _ := simulation.RandPositiveInt(r, sdk.NewInt(100000))
 	limit, _ := simulation.RandPositiveInt(r, MaxSupplyLimit)
-	return types.AssetParam{
-		Denom:  denom,
-		CoinID: int(coinID.Int64()),
-		Limit:  limit,
-		Active: true,
+	chainID := ConsistentChainIDs[r.Intn(len(ConsistentChainIDs))]
+	addressFormat := types.AddressFormatBech32
+	addressPrefix := strings.ToLower(simulation.RandStringOfLength(r, (r.Intn(3) + 2)))
+	if r.Intn(2) == 0 {
+		addressFormat = types.AddressFormatHex
+		addressPrefix = "0x"
 	}
+	// Half of the assets limit how much their supply can increase within a rolling time window
+	var timeWindow time.Duration
+	timeWindowLimit := sdk.ZeroInt()
+	if r.Intn(2) == 0 {
+		timeWindow = time.Duration(simulation.RandIntBetween(r, 1, 24)) * time.Hour
+		timeWindowLimit = limit.Quo(sdk.NewInt(int64(simulation.RandIntBetween(r, 1, 10))))
+		if !timeWindowLimit.IsPositive() {
+			timeWindowLimit = limit
+		}
+	}
+	return types.NewAssetParam(denom, int(coinID.Int64()), limit, true, chainID, addressFormat, addressPrefix, timeWindow, timeWindowLimit, GenRandAssetFee(r))
+}
+
+// GenMaxAutoRefunds randomized MaxAutoRefunds
+func GenMaxAutoRefunds(r *rand.Rand) uint64 {
+	return uint64(simulation.RandIntBetween(r, 1, 20))
+}
+
+// GenOtherChainAddress generates a random address in the format of an asset's counterparty chain
+func GenOtherChainAddress(r *rand.Rand, asset types.AssetParam) string {
+	bz := make([]byte, 20)
+	r.Read(bz)
+	if asset.AddressFormat == types.AddressFormatHex {
+		return asset.AddressPrefix + hex.EncodeToString(bz)
+	}
+	address, err := bech32.ConvertAndEncode(asset.AddressPrefix, bz)
+	if err != nil {
+		panic(err)
+	}
+	return address
 }
 
 // RandomizedGenState generates a random GenesisState
@@ -106,9 +171,6 @@ func RandomizedGenState(simState *module.SimulationState) {
 }
 
 func loadRandomBep3GenState(simState *module.SimulationState) types.GenesisState {
-	bnbDeputy := GenRandBnbDeputy(simState.Rand)
-	bnbDeputyFixedFee := GenRandBnbDeputyFixedFee(simState.Rand)
-
 	// min/max block lock are hardcoded to 50/100 for expected -NumBlocks=100
 	minBlockLock := types.AbsoluteMinimumBlockLock
 	maxBlockLock := minBlockLock * 2
@@ -119,13 +181,32 @@ func loadRandomBep3GenState(simState *module.SimulationState) types.GenesisState
 		func(r *rand.Rand) { supportedAssets = GenSupportedAssets(r) },
 	)
 
+	var deputies types.DeputyParams
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, Deputies, &deputies, simState.Rand,
+		func(r *rand.Rand) { deputies = GenRandDeputies(r, supportedAssets) },
+	)
+
+	var autoRefund bool
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, AutoRefund, &autoRefund, simState.Rand,
+		func(r *rand.Rand) { autoRefund = r.Intn(2) == 0 },
+	)
+
+	var maxAutoRefunds uint64
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, MaxAutoRefunds, &maxAutoRefunds, simState.Rand,
+		func(r *rand.Rand) { maxAutoRefunds = GenMaxAutoRefunds(r) },
+	)
+
 	bep3Genesis := types.GenesisState{
 		Params: types.Params{
-			BnbDeputyAddress:  bnbDeputy.Address,
-			BnbDeputyFixedFee: bnbDeputyFixedFee,
-			MinBlockLock:      minBlockLock,
-			MaxBlockLock:      maxBlockLock,
-			SupportedAssets:   supportedAssets,
+			Deputies:        deputies,
+			MinBlockLock:    minBlockLock,
+			MaxBlockLock:    maxBlockLock,
+			SupportedAssets: supportedAssets,
+			AutoRefund:      autoRefund,
+			MaxAutoRefunds:  maxAutoRefunds,
 		},
 	}
 
@@ -136,21 +217,27 @@ func loadAuthGenState(simState *module.SimulationState, bep3Genesis types.Genesi
 	var authGenesis auth.GenesisState
 	simState.Cdc.MustUnmarshalJSON(simState.GenState[auth.ModuleName], &authGenesis)
 
-	deputy, found := getAccount(authGenesis.Accounts, bep3Genesis.Params.BnbDeputyAddress)
-	if !found {
-		panic("deputy address not found in available accounts")
-	}
-
-	// Load total limit of each supported asset to deputy's account
+	// Split the total limit of each supported asset between the deputies' accounts
+	numDeputies := int64(len(bep3Genesis.Params.Deputies))
 	var totalCoins []sdk.Coins
-	for _, asset := range bep3Genesis.Params.SupportedAssets {
-		assetCoin := sdk.NewCoins(sdk.NewCoin(asset.Denom, asset.Limit))
-		if err := deputy.SetCoins(deputy.GetCoins().Add(assetCoin...)); err != nil {
-			panic(err)
+	for i, deputyParam := range bep3Genesis.Params.Deputies {
+		deputy, found := getAccount(authGenesis.Accounts, deputyParam.Address)
+		if !found {
+			panic("deputy address not found in available accounts")
+		}
+		for _, asset := range bep3Genesis.Params.SupportedAssets {
+			amount := asset.Limit.QuoRaw(numDeputies)
+			if i == 0 {
+				amount = amount.Add(asset.Limit.ModRaw(numDeputies))
+			}
+			assetCoin := sdk.NewCoins(sdk.NewCoin(asset.Denom, amount))
+			if err := deputy.SetCoins(deputy.GetCoins().Add(assetCoin...)); err != nil {
+				panic(err)
+			}
+			totalCoins = append(totalCoins, assetCoin)
 		}
-		totalCoins = append(totalCoins, assetCoin)
+		authGenesis.Accounts = replaceOrAppendAccount(authGenesis.Accounts, deputy)
 	}
-	authGenesis.Accounts = replaceOrAppendAccount(authGenesis.Accounts, deputy)
 
 	return authGenesis, totalCoins
 }
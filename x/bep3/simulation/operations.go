@@ -51,9 +51,13 @@ func SimulateMsgCreateAtomicSwap(ak types.AccountKeeper, k keeper.Keeper) simula
 		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simulation.Account, chainID string,
 	) (simulation.OperationMsg, []simulation.FutureOperation, error) {
 
-		// Set up deputy address as it's required for all atomic swaps
-		deputyAddr := k.GetBnbDeputyAddress(ctx)
-		deputyAcc, foundDeputy := simulation.FindAccount(accs, deputyAddr)
+		// Select a random deputy as one is required for all atomic swaps
+		deputies := k.GetDeputies(ctx)
+		if len(deputies) == 0 {
+			return noOpMsg, nil, nil
+		}
+		deputy := deputies[r.Intn(len(deputies))]
+		deputyAcc, foundDeputy := simulation.FindAccount(accs, deputy.Address)
 		if !foundDeputy {
 			return noOpMsg, nil, nil
 		}
@@ -65,11 +69,19 @@ func SimulateMsgCreateAtomicSwap(ak types.AccountKeeper, k keeper.Keeper) simula
 		})
 
 		// Search for an account that holds coins received by an atomic swap
-		bnbDeputyFixedFee := k.GetBnbDeputyFixedFee(ctx)
 		senderOut, asset, found := findValidAccountAssetSupplyPair(accs, supplies, func(acc simulation.Account, asset types.AssetSupply) bool {
-			if asset.CurrentSupply.Amount.IsPositive() {
+			// Swaps sent by a deputy are incoming, so deputies can't create outgoing swaps
+			if _, isDeputy := deputies.Get(acc.Address); isDeputy {
+				return false
+			}
+			if asset.CurrentSupply.Amount.IsPositive() && deputy.SupportsAsset(asset.Denom) {
+				assetParam, found := k.GetAssetByDenom(ctx, asset.Denom)
+				if !found {
+					return false
+				}
 				authAcc := ak.GetAccount(ctx, acc.Address)
-				if authAcc.SpendableCoins(ctx.BlockTime()).AmountOf(asset.Denom).GT(sdk.NewIntFromUint64(bnbDeputyFixedFee)) {
+				spendable := authAcc.SpendableCoins(ctx.BlockTime()).AmountOf(asset.Denom)
+				if spendable.GT(deputy.GetAssetFee(assetParam).Calculate(spendable)) {
 					return true
 				}
 			}
@@ -88,16 +100,20 @@ func SimulateMsgCreateAtomicSwap(ak types.AccountKeeper, k keeper.Keeper) simula
 		} else {
 			sender = deputyAcc
 			recipient, _ = simulation.RandomAcc(r, accs)
-			// Randomly select an asset from supported assets
-			assets, foundAsset := k.GetAssets(ctx)
-			if !foundAsset {
-				return noOpMsg, nil, fmt.Errorf("no supported assets found")
+			// Randomly select an asset relayed by the deputy
+			if len(deputy.SupportedAssets) == 0 {
+				return noOpMsg, nil, fmt.Errorf("no supported assets found for deputy %s", deputy.Address)
 			}
-			denom = assets[r.Intn(len(assets))].Denom
+			denom = deputy.SupportedAssets[r.Intn(len(deputy.SupportedAssets))]
 		}
 
-		recipientOtherChain := simulation.RandStringOfLength(r, 43)
-		senderOtherChain := simulation.RandStringOfLength(r, 43)
+		// Addresses on the other chain must match the format of the asset's counterparty chain
+		assetParam, foundAsset := k.GetAssetByDenom(ctx, denom)
+		if !foundAsset {
+			return noOpMsg, nil, fmt.Errorf("asset %s not found", denom)
+		}
+		recipientOtherChain := GenOtherChainAddress(r, assetParam)
+		senderOtherChain := GenOtherChainAddress(r, assetParam)
 
 		// Generate cryptographically strong pseudo-random number
 		randomNumber, err := simulation.RandPositiveInt(r, sdk.NewInt(math.MaxInt64))
@@ -118,7 +134,7 @@ func SimulateMsgCreateAtomicSwap(ak types.AccountKeeper, k keeper.Keeper) simula
 		// Get maximum valid amount
 		maximumAmount := senderAcc.SpendableCoins(ctx.BlockTime()).Sub(fees).AmountOf(denom)
 		// The maximum amount for outgoing swaps is limited by the asset's current supply
-		if recipient.Equals(deputyAcc) {
+		if !sender.Equals(deputyAcc) {
 			assetSupply, foundAssetSupply := k.GetAssetSupply(ctx, []byte(denom))
 			if !foundAssetSupply {
 				return noOpMsg, nil, fmt.Errorf("no asset supply found")
@@ -127,10 +143,27 @@ func SimulateMsgCreateAtomicSwap(ak types.AccountKeeper, k keeper.Keeper) simula
 				maximumAmount = assetSupply.CurrentSupply.Amount
 			}
 		}
+		// The maximum amount for incoming swaps is limited by the increase left in the asset's time window
+		if sender.Equals(deputyAcc) && assetParam.IsTimeLimited() {
+			assetSupply, foundAssetSupply := k.GetAssetSupply(ctx, []byte(denom))
+			if !foundAssetSupply {
+				return noOpMsg, nil, fmt.Errorf("no asset supply found")
+			}
+			windowIncrease := assetSupply.RecentIncreases.Since(ctx.BlockTime().Add(-assetParam.TimeWindow)).Total()
+			windowRemaining := assetParam.TimeWindowLimit.Sub(windowIncrease)
+			if maximumAmount.GT(windowRemaining) {
+				maximumAmount = windowRemaining
+			}
+		}
+		// The maximum amount is also limited by the deputy's swap limits
+		minSwapAmount, maxSwapAmount := deputy.GetSwapAmountLimits(denom)
+		if maximumAmount.GT(maxSwapAmount) {
+			maximumAmount = maxSwapAmount
+		}
 
 		// Get an amount of coins between 0.1 and 2% of total coins
 		amount := maximumAmount.Quo(sdk.NewInt(int64(simulation.RandIntBetween(r, 50, 1000))))
-		if amount.LT(sdk.NewIntFromUint64(bnbDeputyFixedFee)) {
+		if amount.LTE(deputy.GetAssetFee(assetParam).Calculate(amount)) || amount.LT(minSwapAmount) {
 			return simulation.NewOperationMsgBasic(types.ModuleName, fmt.Sprintf("no-operation (all funds exhausted for asset %s)", denom), "", false, nil), nil, nil
 		}
 		coins := sdk.NewCoins(sdk.NewCoin(denom, amount))
@@ -160,7 +193,7 @@ func SimulateMsgCreateAtomicSwap(ak types.AccountKeeper, k keeper.Keeper) simula
 
 		// Construct a MsgClaimAtomicSwap or MsgRefundAtomicSwap future operation
 		var futureOp simulation.FutureOperation
-		swapID := types.CalculateSwapID(msg.RandomNumberHash, msg.From, msg.SenderOtherChain)
+		swapID := types.CalculateSwapID(msg.RandomNumberHash, msg.From, msg.SenderOtherChain, assetParam.ChainID)
 		if r.Intn(100) < 50 {
 			// Claim future operation
 			executionBlock := uint64(ctx.BlockHeight()) + msg.HeightSpan/2
@@ -218,6 +251,12 @@ func operationRefundAtomicSwap(ak types.AccountKeeper, k keeper.Keeper, swapID [
 	return func(
 		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simulation.Account, chainID string,
 	) (simulation.OperationMsg, []simulation.FutureOperation, error) {
+		// Expired swaps may have already been refunded automatically
+		swap, found := k.GetAtomicSwap(ctx, swapID)
+		if !found || swap.Status != types.Expired {
+			return simulation.NewOperationMsgBasic(types.ModuleName, "no-operation (swap is not refundable)", "", false, nil), nil, nil
+		}
+
 		simAccount, _ := simulation.RandomAcc(r, accs)
 		acc := ak.GetAccount(ctx, simAccount.Address)
 
@@ -10,11 +10,11 @@ import (
 )
 
 const (
-	keyBnbDeputyAddress  = "BnbDeputyAddress"
-	keyBnbDeputyFixedFee = "BnbDeputyFixedFee"
-	keyMinBlockLock      = "MinBlockLock"
-	keyMaxBlockLock      = "MaxBlockLock"
-	keySupportedAssets   = "SupportedAssets"
+	keyMinBlockLock    = "MinBlockLock"
+	keyMaxBlockLock    = "MaxBlockLock"
+	keySupportedAssets = "SupportedAssets"
+	keyAutoRefund      = "AutoRefund"
+	keyMaxAutoRefunds  = "MaxAutoRefunds"
 )
 
 // ParamChanges defines the parameters that can be modified by param change proposals
@@ -23,16 +23,6 @@ func ParamChanges(r *rand.Rand) []simulation.ParamChange {
 	minBlockLockVal := GenMinBlockLock(r)
 
 	return []simulation.ParamChange{
-		simulation.NewSimParamChange(types.ModuleName, keyBnbDeputyAddress,
-			func(r *rand.Rand) string {
-				return fmt.Sprintf("\"%s\"", GenRandBnbDeputy(r).Address)
-			},
-		),
-		simulation.NewSimParamChange(types.ModuleName, keyBnbDeputyFixedFee,
-			func(r *rand.Rand) string {
-				return fmt.Sprintf("\"%d\"", GenRandBnbDeputyFixedFee(r))
-			},
-		),
 		simulation.NewSimParamChange(types.ModuleName, keyMinBlockLock,
 			func(r *rand.Rand) string {
 				return fmt.Sprintf("\"%d\"", minBlockLockVal)
@@ -48,5 +38,15 @@ func ParamChanges(r *rand.Rand) []simulation.ParamChange {
 				return fmt.Sprintf("\"%v\"", GenSupportedAssets(r))
 			},
 		),
+		simulation.NewSimParamChange(types.ModuleName, keyAutoRefund,
+			func(r *rand.Rand) string {
+				return fmt.Sprintf("%t", r.Intn(2) == 0)
+			},
+		),
+		simulation.NewSimParamChange(types.ModuleName, keyMaxAutoRefunds,
+			func(r *rand.Rand) string {
+				return fmt.Sprintf("\"%d\"", GenMaxAutoRefunds(r))
+			},
+		),
 	}
 }
@@ -2,6 +2,7 @@ package types
 
 import (
 	"fmt"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -14,6 +15,8 @@ type AssetSupply struct {
 	OutgoingSupply sdk.Coin `json:"outgoing_supply"  yaml:"outgoing_supply"`
 	CurrentSupply  sdk.Coin `json:"current_supply"  yaml:"current_supply"`
 	SupplyLimit    sdk.Coin `json:"supply_limit"  yaml:"supply_limit"`
+
+	RecentIncreases SupplyIncreases `json:"recent_increases" yaml:"recent_increases"` // incoming supply increases within the asset's time window
 }
 
 // NewAssetSupply initializes a new AssetSupply
@@ -41,6 +44,11 @@ func (a AssetSupply) Validate() error {
 	if !a.SupplyLimit.IsValid() {
 		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "supply limit %s", a.SupplyLimit)
 	}
+	for _, increase := range a.RecentIncreases {
+		if err := increase.Validate(); err != nil {
+			return err
+		}
+	}
 	return sdk.ValidateDenom(a.Denom)
 }
 
@@ -52,9 +60,58 @@ func (a AssetSupply) String() string {
 		Outgoing supply:    %s
 		Current supply:     %s
 		Supply limit:       %s
+		Recent increase:    %s
 		`,
-		a.Denom, a.IncomingSupply, a.OutgoingSupply, a.CurrentSupply, a.SupplyLimit)
+		a.Denom, a.IncomingSupply, a.OutgoingSupply, a.CurrentSupply, a.SupplyLimit, a.RecentIncreases.Total())
 }
 
 // AssetSupplies is a slice of AssetSupply
 type AssetSupplies []AssetSupply
+
+// SupplyIncrease records an increase in an asset's incoming supply at a block time
+type SupplyIncrease struct {
+	Time   time.Time `json:"time" yaml:"time"`
+	Amount sdk.Int   `json:"amount" yaml:"amount"`
+}
+
+// NewSupplyIncrease returns a new SupplyIncrease
+func NewSupplyIncrease(t time.Time, amount sdk.Int) SupplyIncrease {
+	return SupplyIncrease{
+		Time:   t,
+		Amount: amount,
+	}
+}
+
+// Validate performs a basic validation of a supply increase
+func (si SupplyIncrease) Validate() error {
+	if si.Time.IsZero() {
+		return fmt.Errorf("supply increase time cannot be zero")
+	}
+	if si.Amount == emptyInt || !si.Amount.IsPositive() {
+		return fmt.Errorf("supply increase amount must be positive, got %s", si.Amount)
+	}
+	return nil
+}
+
+// SupplyIncreases is a slice of SupplyIncrease, ordered by time
+type SupplyIncreases []SupplyIncrease
+
+// Since returns the increases that happened strictly after the given time
+func (sis SupplyIncreases) Since(t time.Time) SupplyIncreases {
+	var recent SupplyIncreases
+	for _, si := range sis {
+		if si.Time.After(t) {
+			recent = append(recent, si)
+		}
+	}
+	return recent
+}
+
+// Total returns the sum of the increases
+func (sis SupplyIncreases) Total() sdk.Int {
+	total := sdk.ZeroInt()
+	for _, si := range sis {
+		total = total.Add(si.Amount)
+	}
+	return total
+}
@@ -2,6 +2,7 @@ package types
 
 import (
 	"testing"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
@@ -10,6 +11,7 @@ import (
 func TestAssetSupplyValidate(t *testing.T) {
 	coin := sdk.NewCoin("kava", sdk.OneInt())
 	invalidCoin := sdk.Coin{Denom: "Invalid Denom", Amount: sdk.NewInt(-1)}
+	increaseTime := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
 	testCases := []struct {
 		msg     string
 		asset   AssetSupply
@@ -52,6 +54,42 @@ func TestAssetSupplyValidate(t *testing.T) {
 			},
 			false,
 		},
+		{
+			msg: "valid recent increases",
+			asset: AssetSupply{
+				Denom:           "kava",
+				IncomingSupply:  coin,
+				OutgoingSupply:  coin,
+				CurrentSupply:   coin,
+				SupplyLimit:     coin,
+				RecentIncreases: SupplyIncreases{NewSupplyIncrease(increaseTime, sdk.OneInt())},
+			},
+			expPass: true,
+		},
+		{
+			msg: "invalid recent increase amount",
+			asset: AssetSupply{
+				Denom:           "kava",
+				IncomingSupply:  coin,
+				OutgoingSupply:  coin,
+				CurrentSupply:   coin,
+				SupplyLimit:     coin,
+				RecentIncreases: SupplyIncreases{NewSupplyIncrease(increaseTime, sdk.ZeroInt())},
+			},
+			expPass: false,
+		},
+		{
+			msg: "invalid recent increase time",
+			asset: AssetSupply{
+				Denom:           "kava",
+				IncomingSupply:  coin,
+				OutgoingSupply:  coin,
+				CurrentSupply:   coin,
+				SupplyLimit:     coin,
+				RecentIncreases: SupplyIncreases{NewSupplyIncrease(time.Time{}, sdk.OneInt())},
+			},
+			expPass: false,
+		},
 		{
 			msg:     "invalid denom",
 			asset:   NewAssetSupply("Invalid Denom", coin, coin, coin, coin),
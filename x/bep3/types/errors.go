@@ -11,8 +11,8 @@ var (
 	ErrInvalidTimestamp = sdkerrors.Register(ModuleName, 2, "timestamp can neither be 15 minutes ahead of the current time, nor 30 minutes later")
 	// ErrInvalidHeightSpan error for when a proposed height span is outside of lock time range
 	ErrInvalidHeightSpan = sdkerrors.Register(ModuleName, 3, "height span is outside acceptable range")
-	// ErrInsufficientAmount error for when a swap's amount is less than the deputy fixed fee
-	ErrInsufficientAmount = sdkerrors.Register(ModuleName, 4, "amount must be greater than the deputy fixed fee")
+	// ErrInsufficientAmount error for when an outgoing swap's amount is not greater than the asset's fee
+	ErrInsufficientAmount = sdkerrors.Register(ModuleName, 4, "amount must be greater than the asset fee")
 	// ErrAssetNotSupported error for when an asset is not supported
 	ErrAssetNotSupported = sdkerrors.Register(ModuleName, 5, "asset not on the list of supported assets")
 	// ErrAssetNotActive error for when an asset is currently inactive
@@ -39,4 +39,14 @@ var (
 	ErrSwapNotRefundable = sdkerrors.Register(ModuleName, 16, "atomic swap is still active and cannot be refunded")
 	// ErrSwapNotClaimable error for when an atomic swap is not open and cannot be claimed
 	ErrSwapNotClaimable = sdkerrors.Register(ModuleName, 17, "atomic swap is not claimable")
+	// ErrInvalidDeputy error for when neither the sender nor the recipient of a swap is a deputy
+	ErrInvalidDeputy = sdkerrors.Register(ModuleName, 18, "swap must be sent by or to a deputy")
+	// ErrInvalidSwapAmount error for when a swap's amount is outside the deputy's minimum and maximum swap amounts
+	ErrInvalidSwapAmount = sdkerrors.Register(ModuleName, 19, "amount is outside the deputy's swap limits")
+	// ErrInvalidOtherChainAddress error for when an address on the other chain doesn't match the format of the asset's counterparty chain
+	ErrInvalidOtherChainAddress = sdkerrors.Register(ModuleName, 20, "invalid address on other chain")
+	// ErrExceedsTimeWindowLimit error for when the proposed supply increase would surpass the asset's time window limit
+	ErrExceedsTimeWindowLimit = sdkerrors.Register(ModuleName, 21, "asset supply increase over time window limit")
+	// ErrMixedCounterparties error for when the coins in a swap are bridged from different counterparty chains
+	ErrMixedCounterparties = sdkerrors.Register(ModuleName, 22, "swap assets must share a counterparty chain")
 )
@@ -14,9 +14,11 @@ const (
 	AttributeKeyRandomNumberHash = "random_number_hash"
 	AttributeKeyTimestamp        = "timestamp"
 	AttributeKeySenderOtherChain = "sender_other_chain"
+	AttributeKeyOtherChainID     = "other_chain_id"
 	AttributeKeyExpireHeight     = "expire_height"
 	AttributeKeyAmount           = "amount"
 	AttributeKeyDirection        = "direction"
+	AttributeKeyDeputy           = "deputy"
 	AttributeKeyClaimSender      = "claim_sender"
 	AttributeKeyRandomNumber     = "random_number"
 	AttributeKeyRefundSender     = "refund_sender"
@@ -72,6 +72,17 @@ func (gs GenesisState) Validate() error {
 			return err
 		}
 
+		for _, coin := range swap.Amount {
+			asset, found := gs.Params.SupportedAssets.Get(coin.Denom)
+			if !found {
+				return fmt.Errorf("atomic swap %s has unsupported asset %s", hex.EncodeToString(swap.GetSwapID()), coin.Denom)
+			}
+			if asset.ChainID != swap.GetOtherChainID() {
+				return fmt.Errorf("atomic swap %s has chain id %s, expected %s for asset %s",
+					hex.EncodeToString(swap.GetSwapID()), swap.GetOtherChainID(), asset.ChainID, coin.Denom)
+			}
+		}
+
 		ids[hex.EncodeToString(swap.GetSwapID())] = true
 	}
 	return nil
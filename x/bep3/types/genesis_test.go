@@ -28,6 +28,12 @@ func (suite *GenesisTestSuite) SetupTest() {
 	suite.supplies = types.AssetSupplies{supply}
 }
 
+func (suite *GenesisTestSuite) swapWithOtherChain(otherChainID string) types.AtomicSwap {
+	swap := suite.swaps[0]
+	swap.OtherChainID = otherChainID
+	return swap
+}
+
 func (suite *GenesisTestSuite) TestValidate() {
 	type args struct {
 		swaps    types.AtomicSwaps
@@ -70,6 +76,22 @@ func (suite *GenesisTestSuite) TestValidate() {
 			},
 			false,
 		},
+		{
+			"swap without other chain",
+			args{
+				swaps:    types.AtomicSwaps{suite.swapWithOtherChain("")},
+				supplies: types.AssetSupplies{},
+			},
+			true,
+		},
+		{
+			"swap with wrong other chain",
+			args{
+				swaps:    types.AtomicSwaps{suite.swapWithOtherChain("ethereum-1")},
+				supplies: types.AssetSupplies{},
+			},
+			false,
+		},
 		{
 			"invalid swap",
 			args{
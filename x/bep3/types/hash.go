@@ -27,11 +27,16 @@ func CalculateRandomHash(randomNumber []byte, timestamp int64) []byte {
 	return tmhash.Sum(data)
 }
 
-// CalculateSwapID calculates the hash of a RandomNumberHash, sdk.AccAddress, and string
-func CalculateSwapID(randomNumberHash []byte, sender sdk.AccAddress, senderOtherChain string) []byte {
+// CalculateSwapID calculates the hash of a RandomNumberHash, sdk.AccAddress, the sender's address
+// on the other chain, and the other chain's ID. The ID of the default chain is left out of the hash
+// so swaps with Binance Chain keep the IDs they had before other chains were supported.
+func CalculateSwapID(randomNumberHash []byte, sender sdk.AccAddress, senderOtherChain, otherChainID string) []byte {
 	senderOtherChain = strings.ToLower(senderOtherChain)
 	data := randomNumberHash
 	data = append(data, sender.Bytes()...)
 	data = append(data, []byte(senderOtherChain)...)
+	if otherChainID != "" && otherChainID != DefaultChainID {
+		data = append(data, []byte(otherChainID)...)
+	}
 	return tmhash.Sum(data)
 }
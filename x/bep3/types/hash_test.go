@@ -1,11 +1,13 @@
 package types_test
 
 import (
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/suite"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/tendermint/tendermint/crypto/tmhash"
 
 	"github.com/kava-labs/kava/app"
 	"github.com/kava-labs/kava/x/bep3/types"
@@ -49,13 +51,32 @@ func (suite *HashTestSuite) TestCalculateRandomHash() {
 func (suite *HashTestSuite) TestCalculateSwapID() {
 	randomNumber, _ := types.GenerateSecureRandomNumber()
 	hash := types.CalculateRandomHash(randomNumber[:], suite.timestamps[3])
-	swapID := types.CalculateSwapID(hash, suite.addrs[3], suite.addrs[5].String())
+	swapID := types.CalculateSwapID(hash, suite.addrs[3], suite.addrs[5].String(), types.DefaultChainID)
 	suite.NotNil(swapID)
 	suite.Equal(32, len(swapID))
 
 	diffHash := types.CalculateRandomHash(randomNumber[:], suite.timestamps[2])
-	diffSwapID := types.CalculateSwapID(diffHash, suite.addrs[3], suite.addrs[5].String())
+	diffSwapID := types.CalculateSwapID(diffHash, suite.addrs[3], suite.addrs[5].String(), types.DefaultChainID)
 	suite.NotEqual(swapID, diffSwapID)
+
+	diffChainSwapID := types.CalculateSwapID(hash, suite.addrs[3], suite.addrs[5].String(), "ethereum-1")
+	suite.NotEqual(swapID, diffChainSwapID)
+}
+
+func (suite *HashTestSuite) TestCalculateSwapIDDefaultChain() {
+	randomNumber, _ := types.GenerateSecureRandomNumber()
+	hash := types.CalculateRandomHash(randomNumber[:], suite.timestamps[3])
+	senderOtherChain := suite.addrs[5].String()
+
+	// swaps with the default chain keep the ID calculated before chain IDs were hashed
+	var legacyData []byte
+	legacyData = append(legacyData, hash...)
+	legacyData = append(legacyData, suite.addrs[3].Bytes()...)
+	legacyData = append(legacyData, []byte(strings.ToLower(senderOtherChain))...)
+	legacySwapID := tmhash.Sum(legacyData)
+
+	suite.Equal(legacySwapID, types.CalculateSwapID(hash, suite.addrs[3], senderOtherChain, types.DefaultChainID))
+	suite.Equal(legacySwapID, types.CalculateSwapID(hash, suite.addrs[3], senderOtherChain, ""))
 }
 
 func TestHashTestSuite(t *testing.T) {
@@ -26,13 +26,28 @@ const DefaultLongtermStorageDuration uint64 = 86400
 
 // Key prefixes
 var (
-	AtomicSwapKeyPrefix             = []byte{0x00} // prefix for keys that store AtomicSwaps
-	AtomicSwapByBlockPrefix         = []byte{0x01} // prefix for keys of the AtomicSwapsByBlock index
-	AssetSupplyKeyPrefix            = []byte{0x02} // prefix for keys that store global asset supply counts
-	AtomicSwapLongtermStoragePrefix = []byte{0x03} // prefix for keys of the AtomicSwapLongtermStorage index
+	AtomicSwapKeyPrefix                   = []byte{0x00} // prefix for keys that store AtomicSwaps
+	AtomicSwapByBlockPrefix               = []byte{0x01} // prefix for keys of the AtomicSwapsByBlock index
+	AssetSupplyKeyPrefix                  = []byte{0x02} // prefix for keys that store global asset supply counts
+	AtomicSwapLongtermStoragePrefix       = []byte{0x03} // prefix for keys of the AtomicSwapLongtermStorage index
+	AtomicSwapExpiredPrefix               = []byte{0x04} // prefix for keys of the AtomicSwapExpired index
+	AtomicSwapByRandomNumberHashPrefix    = []byte{0x05} // prefix for keys of the AtomicSwapByRandomNumberHash index
+	AtomicSwapBySenderOtherChainPrefix    = []byte{0x06} // prefix for keys of the AtomicSwapBySenderOtherChain index
+	AtomicSwapByRecipientOtherChainPrefix = []byte{0x07} // prefix for keys of the AtomicSwapByRecipientOtherChain index
 )
 
-// GetAtomicSwapByHeightKey is used by the AtomicSwapByBlock index and AtomicSwapLongtermStorage index
+// GetAtomicSwapByHeightKey is used by the AtomicSwapByBlock, AtomicSwapLongtermStorage and AtomicSwapExpired indexes
 func GetAtomicSwapByHeightKey(height uint64, swapID []byte) []byte {
 	return append(sdk.Uint64ToBigEndian(height), swapID...)
 }
+
+// GetLookupPrefix length prefixes a random number hash or other chain address so that
+// one value is never a prefix of another when iterating over a lookup index
+func GetLookupPrefix(value []byte) []byte {
+	return append([]byte{byte(len(value))}, value...)
+}
+
+// GetAtomicSwapByLookupKey is used by the AtomicSwapByRandomNumberHash, AtomicSwapBySenderOtherChain and AtomicSwapByRecipientOtherChain indexes
+func GetAtomicSwapByLookupKey(value []byte, swapID []byte) []byte {
+	return append(GetLookupPrefix(value), swapID...)
+}
@@ -67,7 +67,7 @@ func TestMsgCreateAtomicSwap(t *testing.T) {
 }
 
 func TestMsgClaimAtomicSwap(t *testing.T) {
-	swapID := types.CalculateSwapID(randomNumberHash, binanceAddrs[0], "")
+	swapID := types.CalculateSwapID(randomNumberHash, binanceAddrs[0], "", types.DefaultChainID)
 
 	tests := []struct {
 		description  string
@@ -94,7 +94,7 @@ func TestMsgClaimAtomicSwap(t *testing.T) {
 }
 
 func TestMsgRefundAtomicSwap(t *testing.T) {
-	swapID := types.CalculateSwapID(randomNumberHash, binanceAddrs[0], "")
+	swapID := types.CalculateSwapID(randomNumberHash, binanceAddrs[0], "", types.DefaultChainID)
 
 	tests := []struct {
 		description string
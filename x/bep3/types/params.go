@@ -1,9 +1,13 @@
 package types
 
 import (
+	"encoding/hex"
 	"errors"
 	"fmt"
 	"strings"
+	"time"
+
+	"github.com/tendermint/tendermint/libs/bech32"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/params"
@@ -13,78 +17,302 @@ const (
 	bech32MainPrefix = "kava"
 )
 
+var emptyInt = sdk.Int{}
+
+// Address formats of counterparty chains
+const (
+	AddressFormatBech32 = "bech32"
+	AddressFormatHex    = "hex"
+)
+
 // Parameter keys
 var (
-	KeyBnbDeputyAddress  = []byte("BnbDeputyAddress")
-	KeyBnbDeputyFixedFee = []byte("BnbDeputyFixedFee")
-	KeyMinBlockLock      = []byte("MinBlockLock")
-	KeyMaxBlockLock      = []byte("MaxBlockLock")
-	KeySupportedAssets   = []byte("SupportedAssets")
-
-	DefaultBnbDeputyFixedFee uint64 = 1000
+	KeyDeputies        = []byte("Deputies")
+	KeyMinBlockLock    = []byte("MinBlockLock")
+	KeyMaxBlockLock    = []byte("MaxBlockLock")
+	KeySupportedAssets = []byte("SupportedAssets")
+	KeyAutoRefund      = []byte("AutoRefund")
+	KeyMaxAutoRefunds  = []byte("MaxAutoRefunds")
+
+	DefaultChainID                  = "Binance-Chain-Tigris"
+	DefaultFee                      = NewAssetFee(sdk.NewInt(1000), 0, sdk.ZeroInt())
+	MaxFeeRate               uint64 = 10000
+	DefaultMinSwapAmount            = sdk.OneInt()
+	DefaultMaxSwapAmount            = sdk.NewInt(10000000000)
 	AbsoluteMaximumBlockLock uint64 = 10000
 	AbsoluteMinimumBlockLock uint64 = 50
 	DefaultMinBlockLock      uint64 = 80
 	DefaultMaxBlockLock      uint64 = 600
+	DefaultAutoRefund               = false
+	DefaultMaxAutoRefunds    uint64 = 100
 	DefaultSupportedAssets          = AssetParams{
 		AssetParam{
-			Denom:  "bnb",
-			CoinID: 714,
-			Limit:  sdk.NewInt(100000000000),
-			Active: true,
+			Denom:           "bnb",
+			CoinID:          714,
+			Limit:           sdk.NewInt(100000000000),
+			Active:          true,
+			ChainID:         DefaultChainID,
+			AddressFormat:   AddressFormatBech32,
+			AddressPrefix:   "bnb",
+			TimeWindowLimit: sdk.ZeroInt(),
+			Fee:             DefaultFee,
 		},
 	}
 )
 
 // Params governance parameters for bep3 module
 type Params struct {
-	BnbDeputyAddress  sdk.AccAddress `json:"bnb_deputy_address" yaml:"bnb_deputy_address"`     // Bnbchain deputy address
-	BnbDeputyFixedFee uint64         `json:"bnb_deputy_fixed_fee" yaml:"bnb_deputy_fixed_fee"` // Deputy fixed fee in BNB
-	MinBlockLock      uint64         `json:"min_block_lock" yaml:"min_block_lock"`             // AtomicSwap minimum block lock
-	MaxBlockLock      uint64         `json:"max_block_lock" yaml:"max_block_lock"`             // AtomicSwap maximum block lock
-	SupportedAssets   AssetParams    `json:"supported_assets" yaml:"supported_assets"`         // Supported assets
+	Deputies        DeputyParams `json:"deputies" yaml:"deputies"`                 // Deputies relaying swaps to and from other chains
+	MinBlockLock    uint64       `json:"min_block_lock" yaml:"min_block_lock"`     // AtomicSwap minimum block lock
+	MaxBlockLock    uint64       `json:"max_block_lock" yaml:"max_block_lock"`     // AtomicSwap maximum block lock
+	SupportedAssets AssetParams  `json:"supported_assets" yaml:"supported_assets"` // Supported assets
+	AutoRefund      bool         `json:"auto_refund" yaml:"auto_refund"`           // Refund expired swaps automatically in the begin blocker
+	MaxAutoRefunds  uint64       `json:"max_auto_refunds" yaml:"max_auto_refunds"` // Maximum number of expired swaps refunded automatically per block
 }
 
 // String implements fmt.Stringer
 func (p Params) String() string {
 	return fmt.Sprintf(`Params:
-	Bnbchain deputy address: %s,
-	Deputy fixed fee (BNB): %d,
+	Deputies: %s,
 	Min block lock: %d,
 	Max block lock: %d,
-	Supported assets: %s`,
-		p.BnbDeputyAddress.String(), p.BnbDeputyFixedFee, p.MinBlockLock, p.MaxBlockLock, p.SupportedAssets)
+	Supported assets: %s
+	Auto refund: %t,
+	Max auto refunds: %d`,
+		p.Deputies, p.MinBlockLock, p.MaxBlockLock, p.SupportedAssets, p.AutoRefund, p.MaxAutoRefunds)
 }
 
 // NewParams returns a new params object
-func NewParams(bnbDeputyAddress sdk.AccAddress, bnbDeputyFixedFee, minBlockLock, maxBlockLock uint64, supportedAssets AssetParams,
-) Params {
+func NewParams(deputies DeputyParams, minBlockLock, maxBlockLock uint64, supportedAssets AssetParams,
+	autoRefund bool, maxAutoRefunds uint64) Params {
 	return Params{
-		BnbDeputyAddress:  bnbDeputyAddress,
-		BnbDeputyFixedFee: bnbDeputyFixedFee,
-		MinBlockLock:      minBlockLock,
-		MaxBlockLock:      maxBlockLock,
-		SupportedAssets:   supportedAssets,
+		Deputies:        deputies,
+		MinBlockLock:    minBlockLock,
+		MaxBlockLock:    maxBlockLock,
+		SupportedAssets: supportedAssets,
+		AutoRefund:      autoRefund,
+		MaxAutoRefunds:  maxAutoRefunds,
 	}
 }
 
 // DefaultParams returns default params for bep3 module
 func DefaultParams() Params {
-	defaultBnbDeputyAddress, err := sdk.AccAddressFromBech32("kava1xy7hrjy9r0algz9w3gzm8u6mrpq97kwta747gj")
+	defaultDeputyAddress, err := sdk.AccAddressFromBech32("kava1xy7hrjy9r0algz9w3gzm8u6mrpq97kwta747gj")
 	if err != nil {
 		panic(err)
 	}
+	defaultDeputies := DeputyParams{
+		NewDeputyParam(defaultDeputyAddress, nil, []string{"bnb"},
+			sdk.NewCoins(sdk.NewCoin("bnb", DefaultMinSwapAmount)), sdk.NewCoins(sdk.NewCoin("bnb", DefaultMaxSwapAmount))),
+	}
+
+	return NewParams(defaultDeputies, DefaultMinBlockLock, DefaultMaxBlockLock, DefaultSupportedAssets, DefaultAutoRefund, DefaultMaxAutoRefunds)
+}
+
+// DeputyParam governance parameters for a deputy that relays swaps between kava and another chain
+type DeputyParam struct {
+	Address         sdk.AccAddress `json:"address" yaml:"address"`                   // deputy's address on kava
+	FixedFees       sdk.Coins      `json:"fixed_fees" yaml:"fixed_fees"`             // fixed fees charged by the deputy on outgoing swaps, overriding the fixed part of the asset's fee for each listed denom
+	SupportedAssets []string       `json:"supported_assets" yaml:"supported_assets"` // denoms of the assets relayed by the deputy
+	MinSwapAmounts  sdk.Coins      `json:"min_swap_amounts" yaml:"min_swap_amounts"` // minimum amount of each asset in a swap relayed by the deputy, unlisted assets have no minimum
+	MaxSwapAmounts  sdk.Coins      `json:"max_swap_amounts" yaml:"max_swap_amounts"` // maximum amount of each asset in a swap relayed by the deputy, required for every supported asset
+}
+
+// NewDeputyParam returns a new DeputyParam
+func NewDeputyParam(address sdk.AccAddress, fixedFees sdk.Coins, supportedAssets []string, minSwapAmounts, maxSwapAmounts sdk.Coins) DeputyParam {
+	return DeputyParam{
+		Address:         address,
+		FixedFees:       fixedFees,
+		SupportedAssets: supportedAssets,
+		MinSwapAmounts:  minSwapAmounts,
+		MaxSwapAmounts:  maxSwapAmounts,
+	}
+}
+
+// SupportsAsset returns true if the deputy relays swaps of the denom
+func (dp DeputyParam) SupportsAsset(denom string) bool {
+	for _, d := range dp.SupportedAssets {
+		if d == denom {
+			return true
+		}
+	}
+	return false
+}
+
+// GetAssetFee returns the fee schedule the deputy charges on outgoing swaps of an asset,
+// which is the asset's fee with the fixed part replaced by the deputy's fixed fee for the asset if it has one
+func (dp DeputyParam) GetAssetFee(asset AssetParam) AssetFee {
+	fee := asset.Fee
+	for _, fixedFee := range dp.FixedFees {
+		if fixedFee.Denom == asset.Denom {
+			fee.FixedFee = fixedFee.Amount
+		}
+	}
+	return fee
+}
 
-	return NewParams(defaultBnbDeputyAddress, DefaultBnbDeputyFixedFee,
-		DefaultMinBlockLock, DefaultMaxBlockLock, DefaultSupportedAssets)
+// GetSwapAmountLimits returns the minimum and maximum amount of an asset in a swap relayed by the deputy
+func (dp DeputyParam) GetSwapAmountLimits(denom string) (sdk.Int, sdk.Int) {
+	return dp.MinSwapAmounts.AmountOf(denom), dp.MaxSwapAmounts.AmountOf(denom)
+}
+
+// Validate checks that the deputy's parameters are valid
+func (dp DeputyParam) Validate() error {
+	if dp.Address.Empty() {
+		return errors.New("deputy address cannot be empty")
+	}
+	if len(dp.Address.Bytes()) != sdk.AddrLen {
+		return fmt.Errorf("deputy address invalid bytes length got %d, want %d", len(dp.Address.Bytes()), sdk.AddrLen)
+	}
+	if len(dp.SupportedAssets) == 0 {
+		return fmt.Errorf("deputy %s must support at least one asset", dp.Address)
+	}
+	denoms := make(map[string]bool)
+	for _, denom := range dp.SupportedAssets {
+		if strings.TrimSpace(denom) == "" {
+			return fmt.Errorf("deputy %s asset denom cannot be empty", dp.Address)
+		}
+		if denoms[denom] {
+			return fmt.Errorf("deputy %s cannot have duplicate asset %s", dp.Address, denom)
+		}
+		denoms[denom] = true
+	}
+	if !dp.FixedFees.IsValid() {
+		return fmt.Errorf("deputy %s has invalid fixed fees %s", dp.Address, dp.FixedFees)
+	}
+	for _, fixedFee := range dp.FixedFees {
+		if !denoms[fixedFee.Denom] {
+			return fmt.Errorf("deputy %s has a fixed fee for unsupported asset %s", dp.Address, fixedFee.Denom)
+		}
+	}
+	if !dp.MinSwapAmounts.IsValid() {
+		return fmt.Errorf("deputy %s has invalid minimum swap amounts %s", dp.Address, dp.MinSwapAmounts)
+	}
+	if !dp.MaxSwapAmounts.IsValid() {
+		return fmt.Errorf("deputy %s has invalid maximum swap amounts %s", dp.Address, dp.MaxSwapAmounts)
+	}
+	for _, minAmount := range dp.MinSwapAmounts {
+		if !denoms[minAmount.Denom] {
+			return fmt.Errorf("deputy %s has a minimum swap amount for unsupported asset %s", dp.Address, minAmount.Denom)
+		}
+	}
+	for _, maxAmount := range dp.MaxSwapAmounts {
+		if !denoms[maxAmount.Denom] {
+			return fmt.Errorf("deputy %s has a maximum swap amount for unsupported asset %s", dp.Address, maxAmount.Denom)
+		}
+	}
+	for _, denom := range dp.SupportedAssets {
+		minAmount, maxAmount := dp.GetSwapAmountLimits(denom)
+		if !maxAmount.IsPositive() {
+			return fmt.Errorf("deputy %s must have a positive maximum swap amount for %s", dp.Address, denom)
+		}
+		if minAmount.GT(maxAmount) {
+			return fmt.Errorf("deputy %s minimum swap amount cannot be > maximum swap amount for %s, got %s > %s", dp.Address, denom, minAmount, maxAmount)
+		}
+	}
+	return nil
+}
+
+// String implements fmt.Stringer
+func (dp DeputyParam) String() string {
+	return fmt.Sprintf(`Deputy:
+	Address: %s
+	Fixed fees: %s
+	Supported assets: %s
+	Min swap amounts: %s
+	Max swap amounts: %s`,
+		dp.Address, dp.FixedFees, dp.SupportedAssets, dp.MinSwapAmounts, dp.MaxSwapAmounts)
+}
+
+// DeputyParams array of DeputyParam
+type DeputyParams []DeputyParam
+
+// Get returns the deputy with the given address
+func (dps DeputyParams) Get(addr sdk.AccAddress) (DeputyParam, bool) {
+	for _, dp := range dps {
+		if dp.Address.Equals(addr) {
+			return dp, true
+		}
+	}
+	return DeputyParam{}, false
+}
+
+// String implements fmt.Stringer
+func (dps DeputyParams) String() string {
+	out := "Deputy Params\n"
+	for _, dp := range dps {
+		out += fmt.Sprintf("%s\n", dp)
+	}
+	return out
 }
 
 // AssetParam governance parameters for each asset within a supported chain
 type AssetParam struct {
-	Denom  string  `json:"denom" yaml:"denom"`     // name of the asset
-	CoinID int     `json:"coin_id" yaml:"coin_id"` // internationally recognized coin ID
-	Limit  sdk.Int `json:"limit" yaml:"limit"`     // asset supply limit
-	Active bool    `json:"active" yaml:"active"`   // denotes if asset is available or paused
+	Denom         string  `json:"denom" yaml:"denom"`                   // name of the asset
+	CoinID        int     `json:"coin_id" yaml:"coin_id"`               // internationally recognized coin ID
+	Limit         sdk.Int `json:"limit" yaml:"limit"`                   // asset supply limit
+	Active        bool    `json:"active" yaml:"active"`                 // denotes if asset is available or paused
+	ChainID       string  `json:"chain_id" yaml:"chain_id"`             // ID of the counterparty chain the asset is bridged from
+	AddressFormat string  `json:"address_format" yaml:"address_format"` // encoding of addresses on the counterparty chain, bech32 or hex
+	AddressPrefix string  `json:"address_prefix" yaml:"address_prefix"` // bech32 human readable part or hex prefix of addresses on the counterparty chain
+
+	TimeWindow      time.Duration `json:"time_window" yaml:"time_window"`             // length of the rolling window limiting supply increases, zero if the asset is not time limited
+	TimeWindowLimit sdk.Int       `json:"time_window_limit" yaml:"time_window_limit"` // maximum increase in incoming plus current supply within the time window
+
+	Fee AssetFee `json:"fee" yaml:"fee"` // fee charged by deputies on outgoing swaps of the asset
+}
+
+// NewAssetParam returns a new AssetParam
+func NewAssetParam(denom string, coinID int, limit sdk.Int, active bool, chainID, addressFormat, addressPrefix string,
+	timeWindow time.Duration, timeWindowLimit sdk.Int, fee AssetFee) AssetParam {
+	return AssetParam{
+		Denom:           denom,
+		CoinID:          coinID,
+		Limit:           limit,
+		Active:          active,
+		ChainID:         chainID,
+		AddressFormat:   addressFormat,
+		AddressPrefix:   addressPrefix,
+		TimeWindow:      timeWindow,
+		TimeWindowLimit: timeWindowLimit,
+		Fee:             fee,
+	}
+}
+
+// IsTimeLimited returns true if increases in the asset's supply are limited within a rolling time window
+func (ap AssetParam) IsTimeLimited() bool {
+	return ap.TimeWindow > 0
+}
+
+// ValidateOtherChainAddress checks that an address is valid on the asset's counterparty chain
+func (ap AssetParam) ValidateOtherChainAddress(address string) error {
+	switch ap.AddressFormat {
+	case AddressFormatBech32:
+		hrp, bz, err := bech32.DecodeAndConvert(address)
+		if err != nil {
+			return fmt.Errorf("invalid %s address %s: %w", ap.ChainID, address, err)
+		}
+		if hrp != ap.AddressPrefix {
+			return fmt.Errorf("invalid %s address %s: expected prefix %s, got %s", ap.ChainID, address, ap.AddressPrefix, hrp)
+		}
+		if len(bz) == 0 {
+			return fmt.Errorf("invalid %s address %s: empty address", ap.ChainID, address)
+		}
+	case AddressFormatHex:
+		if !strings.HasPrefix(address, ap.AddressPrefix) {
+			return fmt.Errorf("invalid %s address %s: expected prefix %s", ap.ChainID, address, ap.AddressPrefix)
+		}
+		bz, err := hex.DecodeString(strings.TrimPrefix(address, ap.AddressPrefix))
+		if err != nil {
+			return fmt.Errorf("invalid %s address %s: %w", ap.ChainID, address, err)
+		}
+		if len(bz) == 0 {
+			return fmt.Errorf("invalid %s address %s: empty address", ap.ChainID, address)
+		}
+	default:
+		return fmt.Errorf("invalid address format %s for chain %s", ap.AddressFormat, ap.ChainID)
+	}
+	return nil
 }
 
 // String implements fmt.Stringer
@@ -93,13 +321,77 @@ func (ap AssetParam) String() string {
 	Denom: %s
 	Coin ID: %d
 	Limit: %s
-	Active: %t`,
-		ap.Denom, ap.CoinID, ap.Limit.String(), ap.Active)
+	Active: %t
+	Chain ID: %s
+	Address format: %s
+	Address prefix: %s
+	Time window: %s
+	Time window limit: %s
+	Fee: %s`,
+		ap.Denom, ap.CoinID, ap.Limit.String(), ap.Active, ap.ChainID, ap.AddressFormat, ap.AddressPrefix,
+		ap.TimeWindow, ap.TimeWindowLimit, ap.Fee)
+}
+
+// AssetFee fee schedule of an asset, a fixed fee plus a rate in basis points of the swap amount, with a minimum
+type AssetFee struct {
+	FixedFee sdk.Int `json:"fixed_fee" yaml:"fixed_fee"` // fixed part of the fee
+	FeeRate  uint64  `json:"fee_rate" yaml:"fee_rate"`   // basis points of the swap amount added to the fixed fee
+	MinFee   sdk.Int `json:"min_fee" yaml:"min_fee"`     // minimum fee
+}
+
+// NewAssetFee returns a new AssetFee
+func NewAssetFee(fixedFee sdk.Int, feeRate uint64, minFee sdk.Int) AssetFee {
+	return AssetFee{
+		FixedFee: fixedFee,
+		FeeRate:  feeRate,
+		MinFee:   minFee,
+	}
+}
+
+// Calculate returns the fee on a swap amount, rounding the basis point part down
+func (af AssetFee) Calculate(amount sdk.Int) sdk.Int {
+	fee := af.FixedFee.Add(amount.Mul(sdk.NewIntFromUint64(af.FeeRate)).Quo(sdk.NewIntFromUint64(MaxFeeRate)))
+	return sdk.MaxInt(fee, af.MinFee)
+}
+
+// Validate checks that the fee schedule is valid
+func (af AssetFee) Validate() error {
+	if af.FixedFee == emptyInt || af.FixedFee.IsNegative() {
+		return errors.New("fixed fee must be non-negative")
+	}
+	if af.FeeRate > MaxFeeRate {
+		return fmt.Errorf("fee rate cannot be greater than %d basis points, got %d", MaxFeeRate, af.FeeRate)
+	}
+	if af.MinFee == emptyInt || af.MinFee.IsNegative() {
+		return errors.New("minimum fee must be non-negative")
+	}
+	return nil
+}
+
+// String implements fmt.Stringer
+func (af AssetFee) String() string {
+	return fmt.Sprintf("fixed %s + %d bps, min %s", af.FixedFee, af.FeeRate, af.MinFee)
 }
 
 // AssetParams array of AssetParam
 type AssetParams []AssetParam
 
+// Contains returns true if the denom is a supported asset
+func (aps AssetParams) Contains(denom string) bool {
+	_, found := aps.Get(denom)
+	return found
+}
+
+// Get returns the supported asset with the given denom
+func (aps AssetParams) Get(denom string) (AssetParam, bool) {
+	for _, ap := range aps {
+		if ap.Denom == denom {
+			return ap, true
+		}
+	}
+	return AssetParam{}, false
+}
+
 // String implements fmt.Stringer
 func (aps AssetParams) String() string {
 	out := "Asset Params\n"
@@ -119,21 +411,18 @@ func ParamKeyTable() params.KeyTable {
 // nolint
 func (p *Params) ParamSetPairs() params.ParamSetPairs {
 	return params.ParamSetPairs{
-		params.NewParamSetPair(KeyBnbDeputyAddress, &p.BnbDeputyAddress, validateBnbDeputyAddressParam),
-		params.NewParamSetPair(KeyBnbDeputyFixedFee, &p.BnbDeputyFixedFee, validateBnbDeputyFixedFeeParam),
+		params.NewParamSetPair(KeyDeputies, &p.Deputies, validateDeputiesParam),
 		params.NewParamSetPair(KeyMinBlockLock, &p.MinBlockLock, validateMinBlockLockParam),
 		params.NewParamSetPair(KeyMaxBlockLock, &p.MaxBlockLock, validateMaxBlockLockParam),
 		params.NewParamSetPair(KeySupportedAssets, &p.SupportedAssets, validateSupportedAssetsParams),
+		params.NewParamSetPair(KeyAutoRefund, &p.AutoRefund, validateAutoRefundParam),
+		params.NewParamSetPair(KeyMaxAutoRefunds, &p.MaxAutoRefunds, validateMaxAutoRefundsParam),
 	}
 }
 
 // Validate ensure that params have valid values
 func (p Params) Validate() error {
-	if err := validateBnbDeputyAddressParam(p.BnbDeputyAddress); err != nil {
-		return err
-	}
-
-	if err := validateBnbDeputyFixedFeeParam(p.BnbDeputyFixedFee); err != nil {
+	if err := validateDeputiesParam(p.Deputies); err != nil {
 		return err
 	}
 
@@ -149,32 +438,42 @@ func (p Params) Validate() error {
 		return fmt.Errorf("minimum block lock cannot be ≥ maximum block lock, got %d ≥ %d", p.MinBlockLock, p.MaxBlockLock)
 	}
 
-	return validateSupportedAssetsParams(p.SupportedAssets)
-}
-
-func validateBnbDeputyAddressParam(i interface{}) error {
-	addr, ok := i.(sdk.AccAddress)
-	if !ok {
-		return fmt.Errorf("invalid parameter type: %T", i)
+	if err := validateSupportedAssetsParams(p.SupportedAssets); err != nil {
+		return err
 	}
 
-	if addr.Empty() {
-		return errors.New("bnb deputy address cannot be empty")
+	for _, deputy := range p.Deputies {
+		for _, denom := range deputy.SupportedAssets {
+			if !p.SupportedAssets.Contains(denom) {
+				return fmt.Errorf("deputy %s asset %s is not a supported asset", deputy.Address, denom)
+			}
+		}
 	}
 
-	if len(addr.Bytes()) != sdk.AddrLen {
-		return fmt.Errorf("bnb deputy address invalid bytes length got %d, want %d", len(addr.Bytes()), sdk.AddrLen)
+	if err := validateAutoRefundParam(p.AutoRefund); err != nil {
+		return err
 	}
 
-	return nil
+	return validateMaxAutoRefundsParam(p.MaxAutoRefunds)
 }
 
-func validateBnbDeputyFixedFeeParam(i interface{}) error {
-	_, ok := i.(uint64)
+func validateDeputiesParam(i interface{}) error {
+	deputies, ok := i.(DeputyParams)
 	if !ok {
 		return fmt.Errorf("invalid parameter type: %T", i)
 	}
 
+	addresses := make(map[string]bool)
+	for _, deputy := range deputies {
+		if err := deputy.Validate(); err != nil {
+			return err
+		}
+		if addresses[deputy.Address.String()] {
+			return fmt.Errorf("duplicate deputy %s", deputy.Address)
+		}
+		addresses[deputy.Address.String()] = true
+	}
+
 	return nil
 }
 
@@ -210,7 +509,7 @@ func validateSupportedAssetsParams(i interface{}) error {
 		return fmt.Errorf("invalid parameter type: %T", i)
 	}
 
-	coinIDs := make(map[int]bool)
+	coinIDs := make(map[string]bool)
 	coinDenoms := make(map[string]bool)
 	for _, asset := range assetParams {
 		if strings.TrimSpace(asset.Denom) == "" {
@@ -232,12 +531,66 @@ func validateSupportedAssetsParams(i interface{}) error {
 
 		coinDenoms[asset.Denom] = true
 
-		_, found = coinIDs[asset.CoinID]
+		if strings.TrimSpace(asset.ChainID) == "" {
+			return fmt.Errorf("asset %s chain id cannot be empty", asset.Denom)
+		}
+
+		switch asset.AddressFormat {
+		case AddressFormatBech32:
+			if strings.TrimSpace(asset.AddressPrefix) == "" {
+				return fmt.Errorf("asset %s bech32 address prefix cannot be empty", asset.Denom)
+			}
+		case AddressFormatHex:
+		default:
+			return fmt.Errorf("asset %s has invalid address format %s, must be %s or %s", asset.Denom, asset.AddressFormat, AddressFormatBech32, AddressFormatHex)
+		}
+
+		if asset.TimeWindow < 0 {
+			return fmt.Errorf("asset %s time window cannot be negative", asset.Denom)
+		}
+		if asset.IsTimeLimited() {
+			if !asset.TimeWindowLimit.IsPositive() {
+				return fmt.Errorf("asset %s must have a positive time window limit", asset.Denom)
+			}
+			if asset.TimeWindowLimit.GT(asset.Limit) {
+				return fmt.Errorf("asset %s time window limit cannot be > supply limit, got %s > %s", asset.Denom, asset.TimeWindowLimit, asset.Limit)
+			}
+		}
+
+		if err := asset.Fee.Validate(); err != nil {
+			return fmt.Errorf("asset %s %w", asset.Denom, err)
+		}
+
+		// coin IDs only need to be unique among the assets of a counterparty chain
+		coinID := fmt.Sprintf("%s/%d", asset.ChainID, asset.CoinID)
+		_, found = coinIDs[coinID]
 		if found {
-			return fmt.Errorf(fmt.Sprintf("asset %s cannot have duplicate coin id %d", asset.Denom, asset.CoinID))
+			return fmt.Errorf(fmt.Sprintf("asset %s cannot have duplicate coin id %d on chain %s", asset.Denom, asset.CoinID, asset.ChainID))
 		}
 
-		coinIDs[asset.CoinID] = true
+		coinIDs[coinID] = true
+	}
+
+	return nil
+}
+
+func validateAutoRefundParam(i interface{}) error {
+	_, ok := i.(bool)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
+
+func validateMaxAutoRefundsParam(i interface{}) error {
+	maxAutoRefunds, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if maxAutoRefunds == 0 {
+		return errors.New("max auto refunds must be positive")
 	}
 
 	return nil
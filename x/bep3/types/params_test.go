@@ -2,6 +2,7 @@ package types_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/suite"
 
@@ -24,15 +25,28 @@ func (suite *ParamsTestSuite) SetupTest() {
 	return
 }
 
+func swapAmounts(amount sdk.Int, denoms ...string) sdk.Coins {
+	coins := sdk.NewCoins()
+	for _, denom := range denoms {
+		coins = coins.Add(sdk.NewCoin(denom, amount))
+	}
+	return coins
+}
+
+func (suite *ParamsTestSuite) deputies() types.DeputyParams {
+	return types.DeputyParams{
+		types.NewDeputyParam(suite.addr, nil, []string{"bnb"}, swapAmounts(types.DefaultMinSwapAmount, "bnb"), swapAmounts(types.DefaultMaxSwapAmount, "bnb")),
+	}
+}
+
 func (suite *ParamsTestSuite) TestParamValidation() {
 	type LoadParams func() types.Params
 
 	type args struct {
-		bnbDeputyAddress  sdk.AccAddress
-		bnbDeputyFixedFee uint64
-		minBlockLock      uint64
-		maxBlockLock      uint64
-		supportedAssets   types.AssetParams
+		deputies        types.DeputyParams
+		minBlockLock    uint64
+		maxBlockLock    uint64
+		supportedAssets types.AssetParams
 	}
 
 	testCases := []struct {
@@ -44,11 +58,10 @@ func (suite *ParamsTestSuite) TestParamValidation() {
 		{
 			name: "default",
 			args: args{
-				bnbDeputyAddress:  suite.addr,
-				bnbDeputyFixedFee: types.DefaultBnbDeputyFixedFee,
-				minBlockLock:      types.DefaultMinBlockLock,
-				maxBlockLock:      types.DefaultMaxBlockLock,
-				supportedAssets:   types.DefaultSupportedAssets,
+				deputies:        suite.deputies(),
+				minBlockLock:    types.DefaultMinBlockLock,
+				maxBlockLock:    types.DefaultMaxBlockLock,
+				supportedAssets: types.DefaultSupportedAssets,
 			},
 			expectPass:  true,
 			expectedErr: "",
@@ -56,11 +69,10 @@ func (suite *ParamsTestSuite) TestParamValidation() {
 		{
 			name: "minimum block lock below limit",
 			args: args{
-				bnbDeputyAddress:  suite.addr,
-				bnbDeputyFixedFee: types.DefaultBnbDeputyFixedFee,
-				minBlockLock:      1,
-				maxBlockLock:      types.DefaultMaxBlockLock,
-				supportedAssets:   types.DefaultSupportedAssets,
+				deputies:        suite.deputies(),
+				minBlockLock:    1,
+				maxBlockLock:    types.DefaultMaxBlockLock,
+				supportedAssets: types.DefaultSupportedAssets,
 			},
 			expectPass:  false,
 			expectedErr: "minimum block lock cannot be less than",
@@ -68,11 +80,10 @@ func (suite *ParamsTestSuite) TestParamValidation() {
 		{
 			name: "minimum block lock above limit",
 			args: args{
-				bnbDeputyAddress:  suite.addr,
-				bnbDeputyFixedFee: types.DefaultBnbDeputyFixedFee,
-				minBlockLock:      500000,
-				maxBlockLock:      types.DefaultMaxBlockLock,
-				supportedAssets:   types.DefaultSupportedAssets,
+				deputies:        suite.deputies(),
+				minBlockLock:    500000,
+				maxBlockLock:    types.DefaultMaxBlockLock,
+				supportedAssets: types.DefaultSupportedAssets,
 			},
 			expectPass:  false,
 			expectedErr: "maximum block lock must be greater than minimum block lock",
@@ -80,11 +91,10 @@ func (suite *ParamsTestSuite) TestParamValidation() {
 		{
 			name: "maximum block lock below limit",
 			args: args{
-				bnbDeputyAddress:  suite.addr,
-				bnbDeputyFixedFee: types.DefaultBnbDeputyFixedFee,
-				minBlockLock:      types.DefaultMinBlockLock,
-				maxBlockLock:      1,
-				supportedAssets:   types.DefaultSupportedAssets,
+				deputies:        suite.deputies(),
+				minBlockLock:    types.DefaultMinBlockLock,
+				maxBlockLock:    1,
+				supportedAssets: types.DefaultSupportedAssets,
 			},
 			expectPass:  false,
 			expectedErr: "maximum block lock must be greater than minimum block lock",
@@ -92,11 +102,10 @@ func (suite *ParamsTestSuite) TestParamValidation() {
 		{
 			name: "maximum block lock above limit",
 			args: args{
-				bnbDeputyAddress:  suite.addr,
-				bnbDeputyFixedFee: types.DefaultBnbDeputyFixedFee,
-				minBlockLock:      types.DefaultMinBlockLock,
-				maxBlockLock:      100000000,
-				supportedAssets:   types.DefaultSupportedAssets,
+				deputies:        suite.deputies(),
+				minBlockLock:    types.DefaultMinBlockLock,
+				maxBlockLock:    100000000,
+				supportedAssets: types.DefaultSupportedAssets,
 			},
 			expectPass:  false,
 			expectedErr: "maximum block lock cannot be greater than",
@@ -104,16 +113,19 @@ func (suite *ParamsTestSuite) TestParamValidation() {
 		{
 			name: "empty asset denom",
 			args: args{
-				bnbDeputyAddress:  suite.addr,
-				bnbDeputyFixedFee: types.DefaultBnbDeputyFixedFee,
-				minBlockLock:      types.DefaultMinBlockLock,
-				maxBlockLock:      types.DefaultMaxBlockLock,
+				deputies:     suite.deputies(),
+				minBlockLock: types.DefaultMinBlockLock,
+				maxBlockLock: types.DefaultMaxBlockLock,
 				supportedAssets: types.AssetParams{
 					types.AssetParam{
-						Denom:  "",
-						CoinID: 714,
-						Limit:  sdk.NewInt(100000000000),
-						Active: true,
+						Denom:         "",
+						CoinID:        714,
+						Limit:         sdk.NewInt(100000000000),
+						Active:        true,
+						ChainID:       types.DefaultChainID,
+						AddressFormat: types.AddressFormatBech32,
+						AddressPrefix: "bnb",
+						Fee:           types.DefaultFee,
 					},
 				},
 			},
@@ -123,16 +135,19 @@ func (suite *ParamsTestSuite) TestParamValidation() {
 		{
 			name: "negative asset coin ID",
 			args: args{
-				bnbDeputyAddress:  suite.addr,
-				bnbDeputyFixedFee: types.DefaultBnbDeputyFixedFee,
-				minBlockLock:      types.DefaultMinBlockLock,
-				maxBlockLock:      types.DefaultMaxBlockLock,
+				deputies:     suite.deputies(),
+				minBlockLock: types.DefaultMinBlockLock,
+				maxBlockLock: types.DefaultMaxBlockLock,
 				supportedAssets: types.AssetParams{
 					types.AssetParam{
-						Denom:  "bnb",
-						CoinID: -1,
-						Limit:  sdk.NewInt(100000000000),
-						Active: true,
+						Denom:         "bnb",
+						CoinID:        -1,
+						Limit:         sdk.NewInt(100000000000),
+						Active:        true,
+						ChainID:       types.DefaultChainID,
+						AddressFormat: types.AddressFormatBech32,
+						AddressPrefix: "bnb",
+						Fee:           types.DefaultFee,
 					},
 				},
 			},
@@ -142,16 +157,19 @@ func (suite *ParamsTestSuite) TestParamValidation() {
 		{
 			name: "negative asset limit",
 			args: args{
-				bnbDeputyAddress:  suite.addr,
-				bnbDeputyFixedFee: types.DefaultBnbDeputyFixedFee,
-				minBlockLock:      types.DefaultMinBlockLock,
-				maxBlockLock:      types.DefaultMaxBlockLock,
+				deputies:     suite.deputies(),
+				minBlockLock: types.DefaultMinBlockLock,
+				maxBlockLock: types.DefaultMaxBlockLock,
 				supportedAssets: types.AssetParams{
 					types.AssetParam{
-						Denom:  "bnb",
-						CoinID: 714,
-						Limit:  sdk.NewInt(-10000),
-						Active: true,
+						Denom:         "bnb",
+						CoinID:        714,
+						Limit:         sdk.NewInt(-10000),
+						Active:        true,
+						ChainID:       types.DefaultChainID,
+						AddressFormat: types.AddressFormatBech32,
+						AddressPrefix: "bnb",
+						Fee:           types.DefaultFee,
 					},
 				},
 			},
@@ -161,22 +179,29 @@ func (suite *ParamsTestSuite) TestParamValidation() {
 		{
 			name: "duplicate asset denom",
 			args: args{
-				bnbDeputyAddress:  suite.addr,
-				bnbDeputyFixedFee: types.DefaultBnbDeputyFixedFee,
-				minBlockLock:      types.DefaultMinBlockLock,
-				maxBlockLock:      types.DefaultMaxBlockLock,
+				deputies:     suite.deputies(),
+				minBlockLock: types.DefaultMinBlockLock,
+				maxBlockLock: types.DefaultMaxBlockLock,
 				supportedAssets: types.AssetParams{
 					types.AssetParam{
-						Denom:  "bnb",
-						CoinID: 714,
-						Limit:  sdk.NewInt(100000000000),
-						Active: true,
+						Denom:         "bnb",
+						CoinID:        714,
+						Limit:         sdk.NewInt(100000000000),
+						Active:        true,
+						ChainID:       types.DefaultChainID,
+						AddressFormat: types.AddressFormatBech32,
+						AddressPrefix: "bnb",
+						Fee:           types.DefaultFee,
 					},
 					types.AssetParam{
-						Denom:  "bnb",
-						CoinID: 114,
-						Limit:  sdk.NewInt(500000000),
-						Active: false,
+						Denom:         "bnb",
+						CoinID:        114,
+						Limit:         sdk.NewInt(500000000),
+						Active:        false,
+						ChainID:       types.DefaultChainID,
+						AddressFormat: types.AddressFormatBech32,
+						AddressPrefix: "bnb",
+						Fee:           types.DefaultFee,
 					},
 				},
 			},
@@ -186,33 +211,285 @@ func (suite *ParamsTestSuite) TestParamValidation() {
 		{
 			name: "duplicate asset coin ID",
 			args: args{
-				bnbDeputyAddress:  suite.addr,
-				bnbDeputyFixedFee: types.DefaultBnbDeputyFixedFee,
-				minBlockLock:      types.DefaultMinBlockLock,
-				maxBlockLock:      types.DefaultMaxBlockLock,
+				deputies:     suite.deputies(),
+				minBlockLock: types.DefaultMinBlockLock,
+				maxBlockLock: types.DefaultMaxBlockLock,
 				supportedAssets: types.AssetParams{
 					types.AssetParam{
-						Denom:  "bnb",
-						CoinID: 714,
-						Limit:  sdk.NewInt(100000000000),
-						Active: true,
+						Denom:         "bnb",
+						CoinID:        714,
+						Limit:         sdk.NewInt(100000000000),
+						Active:        true,
+						ChainID:       types.DefaultChainID,
+						AddressFormat: types.AddressFormatBech32,
+						AddressPrefix: "bnb",
+						Fee:           types.DefaultFee,
 					},
 					types.AssetParam{
-						Denom:  "fake",
-						CoinID: 714,
-						Limit:  sdk.NewInt(500000000),
-						Active: false,
+						Denom:         "fake",
+						CoinID:        714,
+						Limit:         sdk.NewInt(500000000),
+						Active:        false,
+						ChainID:       types.DefaultChainID,
+						AddressFormat: types.AddressFormatBech32,
+						AddressPrefix: "bnb",
+						Fee:           types.DefaultFee,
 					},
 				},
 			},
 			expectPass:  false,
 			expectedErr: "cannot have duplicate coin id",
 		},
+		{
+			name: "empty asset chain id",
+			args: args{
+				deputies:     suite.deputies(),
+				minBlockLock: types.DefaultMinBlockLock,
+				maxBlockLock: types.DefaultMaxBlockLock,
+				supportedAssets: types.AssetParams{
+					types.NewAssetParam("bnb", 714, sdk.NewInt(100000000000), true, "", types.AddressFormatBech32, "bnb", 0, sdk.ZeroInt(), types.DefaultFee),
+				},
+			},
+			expectPass:  false,
+			expectedErr: "chain id cannot be empty",
+		},
+		{
+			name: "invalid asset address format",
+			args: args{
+				deputies:     suite.deputies(),
+				minBlockLock: types.DefaultMinBlockLock,
+				maxBlockLock: types.DefaultMaxBlockLock,
+				supportedAssets: types.AssetParams{
+					types.NewAssetParam("bnb", 714, sdk.NewInt(100000000000), true, types.DefaultChainID, "base58", "", 0, sdk.ZeroInt(), types.DefaultFee),
+				},
+			},
+			expectPass:  false,
+			expectedErr: "invalid address format",
+		},
+		{
+			name: "empty bech32 address prefix",
+			args: args{
+				deputies:     suite.deputies(),
+				minBlockLock: types.DefaultMinBlockLock,
+				maxBlockLock: types.DefaultMaxBlockLock,
+				supportedAssets: types.AssetParams{
+					types.NewAssetParam("bnb", 714, sdk.NewInt(100000000000), true, types.DefaultChainID, types.AddressFormatBech32, "", 0, sdk.ZeroInt(), types.DefaultFee),
+				},
+			},
+			expectPass:  false,
+			expectedErr: "bech32 address prefix cannot be empty",
+		},
+		{
+			name: "duplicate asset coin ID on different chains",
+			args: args{
+				deputies:     suite.deputies(),
+				minBlockLock: types.DefaultMinBlockLock,
+				maxBlockLock: types.DefaultMaxBlockLock,
+				supportedAssets: types.AssetParams{
+					types.NewAssetParam("bnb", 714, sdk.NewInt(100000000000), true, types.DefaultChainID, types.AddressFormatBech32, "bnb", 0, sdk.ZeroInt(), types.DefaultFee),
+					types.NewAssetParam("wbnb", 714, sdk.NewInt(100000000000), true, "ethereum-1", types.AddressFormatHex, "0x", 0, sdk.ZeroInt(), types.DefaultFee),
+				},
+			},
+			expectPass:  true,
+			expectedErr: "",
+		},
+		{
+			name: "time limited asset",
+			args: args{
+				deputies:     suite.deputies(),
+				minBlockLock: types.DefaultMinBlockLock,
+				maxBlockLock: types.DefaultMaxBlockLock,
+				supportedAssets: types.AssetParams{
+					types.NewAssetParam("bnb", 714, sdk.NewInt(100000000000), true, types.DefaultChainID, types.AddressFormatBech32, "bnb", 24*time.Hour, sdk.NewInt(1000000000), types.DefaultFee),
+				},
+			},
+			expectPass:  true,
+			expectedErr: "",
+		},
+		{
+			name: "negative time window",
+			args: args{
+				deputies:     suite.deputies(),
+				minBlockLock: types.DefaultMinBlockLock,
+				maxBlockLock: types.DefaultMaxBlockLock,
+				supportedAssets: types.AssetParams{
+					types.NewAssetParam("bnb", 714, sdk.NewInt(100000000000), true, types.DefaultChainID, types.AddressFormatBech32, "bnb", -time.Hour, sdk.NewInt(1000000000), types.DefaultFee),
+				},
+			},
+			expectPass:  false,
+			expectedErr: "time window cannot be negative",
+		},
+		{
+			name: "zero time window limit",
+			args: args{
+				deputies:     suite.deputies(),
+				minBlockLock: types.DefaultMinBlockLock,
+				maxBlockLock: types.DefaultMaxBlockLock,
+				supportedAssets: types.AssetParams{
+					types.NewAssetParam("bnb", 714, sdk.NewInt(100000000000), true, types.DefaultChainID, types.AddressFormatBech32, "bnb", 24*time.Hour, sdk.ZeroInt(), types.DefaultFee),
+				},
+			},
+			expectPass:  false,
+			expectedErr: "must have a positive time window limit",
+		},
+		{
+			name: "time window limit above supply limit",
+			args: args{
+				deputies:     suite.deputies(),
+				minBlockLock: types.DefaultMinBlockLock,
+				maxBlockLock: types.DefaultMaxBlockLock,
+				supportedAssets: types.AssetParams{
+					types.NewAssetParam("bnb", 714, sdk.NewInt(100000000000), true, types.DefaultChainID, types.AddressFormatBech32, "bnb", 24*time.Hour, sdk.NewInt(100000000001), types.DefaultFee),
+				},
+			},
+			expectPass:  false,
+			expectedErr: "time window limit cannot be > supply limit",
+		},
+		{
+			name: "no deputies",
+			args: args{
+				deputies:        types.DeputyParams{},
+				minBlockLock:    types.DefaultMinBlockLock,
+				maxBlockLock:    types.DefaultMaxBlockLock,
+				supportedAssets: types.DefaultSupportedAssets,
+			},
+			expectPass:  true,
+			expectedErr: "",
+		},
+		{
+			name: "empty deputy address",
+			args: args{
+				deputies: types.DeputyParams{
+					types.NewDeputyParam(sdk.AccAddress{}, nil, []string{"bnb"}, swapAmounts(types.DefaultMinSwapAmount, "bnb"), swapAmounts(types.DefaultMaxSwapAmount, "bnb")),
+				},
+				minBlockLock:    types.DefaultMinBlockLock,
+				maxBlockLock:    types.DefaultMaxBlockLock,
+				supportedAssets: types.DefaultSupportedAssets,
+			},
+			expectPass:  false,
+			expectedErr: "deputy address cannot be empty",
+		},
+		{
+			name: "duplicate deputy",
+			args: args{
+				deputies: append(suite.deputies(),
+					types.NewDeputyParam(suite.addr, nil, []string{"bnb"}, swapAmounts(types.DefaultMinSwapAmount, "bnb"), swapAmounts(types.DefaultMaxSwapAmount, "bnb")),
+				),
+				minBlockLock:    types.DefaultMinBlockLock,
+				maxBlockLock:    types.DefaultMaxBlockLock,
+				supportedAssets: types.DefaultSupportedAssets,
+			},
+			expectPass:  false,
+			expectedErr: "duplicate deputy",
+		},
+		{
+			name: "deputy without assets",
+			args: args{
+				deputies: types.DeputyParams{
+					types.NewDeputyParam(suite.addr, nil, []string{}, nil, nil),
+				},
+				minBlockLock:    types.DefaultMinBlockLock,
+				maxBlockLock:    types.DefaultMaxBlockLock,
+				supportedAssets: types.DefaultSupportedAssets,
+			},
+			expectPass:  false,
+			expectedErr: "must support at least one asset",
+		},
+		{
+			name: "deputy asset not supported",
+			args: args{
+				deputies: types.DeputyParams{
+					types.NewDeputyParam(suite.addr, nil, []string{"bnb", "btc"}, swapAmounts(types.DefaultMinSwapAmount, "bnb", "btc"), swapAmounts(types.DefaultMaxSwapAmount, "bnb", "btc")),
+				},
+				minBlockLock:    types.DefaultMinBlockLock,
+				maxBlockLock:    types.DefaultMaxBlockLock,
+				supportedAssets: types.DefaultSupportedAssets,
+			},
+			expectPass:  false,
+			expectedErr: "is not a supported asset",
+		},
+		{
+			name: "deputy with fixed fees",
+			args: args{
+				deputies: types.DeputyParams{
+					types.NewDeputyParam(suite.addr, sdk.NewCoins(sdk.NewInt64Coin("bnb", 500)), []string{"bnb"}, swapAmounts(types.DefaultMinSwapAmount, "bnb"), swapAmounts(types.DefaultMaxSwapAmount, "bnb")),
+				},
+				minBlockLock:    types.DefaultMinBlockLock,
+				maxBlockLock:    types.DefaultMaxBlockLock,
+				supportedAssets: types.DefaultSupportedAssets,
+			},
+			expectPass:  true,
+			expectedErr: "",
+		},
+		{
+			name: "deputy fixed fee for asset not relayed",
+			args: args{
+				deputies: types.DeputyParams{
+					types.NewDeputyParam(suite.addr, sdk.NewCoins(sdk.NewInt64Coin("btc", 500)), []string{"bnb"}, swapAmounts(types.DefaultMinSwapAmount, "bnb"), swapAmounts(types.DefaultMaxSwapAmount, "bnb")),
+				},
+				minBlockLock:    types.DefaultMinBlockLock,
+				maxBlockLock:    types.DefaultMaxBlockLock,
+				supportedAssets: types.DefaultSupportedAssets,
+			},
+			expectPass:  false,
+			expectedErr: "fixed fee for unsupported asset",
+		},
+		{
+			name: "deputy min swap amount above max",
+			args: args{
+				deputies: types.DeputyParams{
+					types.NewDeputyParam(suite.addr, nil, []string{"bnb"}, swapAmounts(sdk.NewInt(1000), "bnb"), swapAmounts(sdk.NewInt(999), "bnb")),
+				},
+				minBlockLock:    types.DefaultMinBlockLock,
+				maxBlockLock:    types.DefaultMaxBlockLock,
+				supportedAssets: types.DefaultSupportedAssets,
+			},
+			expectPass:  false,
+			expectedErr: "minimum swap amount cannot be > maximum swap amount",
+		},
+		{
+			name: "deputy without minimum swap amount",
+			args: args{
+				deputies: types.DeputyParams{
+					types.NewDeputyParam(suite.addr, nil, []string{"bnb"}, nil, swapAmounts(types.DefaultMaxSwapAmount, "bnb")),
+				},
+				minBlockLock:    types.DefaultMinBlockLock,
+				maxBlockLock:    types.DefaultMaxBlockLock,
+				supportedAssets: types.DefaultSupportedAssets,
+			},
+			expectPass:  true,
+			expectedErr: "",
+		},
+		{
+			name: "deputy without maximum swap amount",
+			args: args{
+				deputies: types.DeputyParams{
+					types.NewDeputyParam(suite.addr, nil, []string{"bnb"}, swapAmounts(types.DefaultMinSwapAmount, "bnb"), nil),
+				},
+				minBlockLock:    types.DefaultMinBlockLock,
+				maxBlockLock:    types.DefaultMaxBlockLock,
+				supportedAssets: types.DefaultSupportedAssets,
+			},
+			expectPass:  false,
+			expectedErr: "must have a positive maximum swap amount for bnb",
+		},
+		{
+			name: "deputy swap amount for asset not relayed",
+			args: args{
+				deputies: types.DeputyParams{
+					types.NewDeputyParam(suite.addr, nil, []string{"bnb"}, swapAmounts(types.DefaultMinSwapAmount, "bnb"), swapAmounts(types.DefaultMaxSwapAmount, "bnb", "btc")),
+				},
+				minBlockLock:    types.DefaultMinBlockLock,
+				maxBlockLock:    types.DefaultMaxBlockLock,
+				supportedAssets: types.DefaultSupportedAssets,
+			},
+			expectPass:  false,
+			expectedErr: "maximum swap amount for unsupported asset btc",
+		},
 	}
 
 	for _, tc := range testCases {
-		params := types.NewParams(tc.args.bnbDeputyAddress, tc.args.bnbDeputyFixedFee,
-			tc.args.minBlockLock, tc.args.maxBlockLock, tc.args.supportedAssets)
+		params := types.NewParams(tc.args.deputies, tc.args.minBlockLock, tc.args.maxBlockLock, tc.args.supportedAssets, types.DefaultAutoRefund, types.DefaultMaxAutoRefunds)
 
 		err := params.Validate()
 		if tc.expectPass {
@@ -223,6 +500,74 @@ func (suite *ParamsTestSuite) TestParamValidation() {
 	}
 }
 
+func (suite *ParamsTestSuite) TestAutoRefundValidation() {
+	params := types.NewParams(suite.deputies(), types.DefaultMinBlockLock, types.DefaultMaxBlockLock, types.DefaultSupportedAssets, true, 10)
+	suite.NoError(params.Validate())
+
+	params.MaxAutoRefunds = 0
+	suite.Error(params.Validate())
+}
+
+func (suite *ParamsTestSuite) TestValidateOtherChainAddress() {
+	bech32Asset := types.NewAssetParam("bnb", 714, sdk.NewInt(100000000000), true, types.DefaultChainID, types.AddressFormatBech32, "bnb", 0, sdk.ZeroInt(), types.DefaultFee)
+	hexAsset := types.NewAssetParam("weth", 60, sdk.NewInt(100000000000), true, "ethereum-1", types.AddressFormatHex, "0x", 0, sdk.ZeroInt(), types.DefaultFee)
+
+	testCases := []struct {
+		name       string
+		asset      types.AssetParam
+		address    string
+		expectPass bool
+	}{
+		{"valid bech32", bech32Asset, "bnb1urfermcg92dwq36572cx4xg84wpk3lfpksr5g7", true},
+		{"bech32 wrong prefix", bech32Asset, suite.addr.String(), false},
+		{"bech32 invalid checksum", bech32Asset, "bnb1urfermcg92dwq36572cx4xg84wpk3lfpksr5g8", false},
+		{"valid hex", hexAsset, "0x52908400098527886E0F7030069857D2E4169EE7", true},
+		{"hex missing prefix", hexAsset, "52908400098527886E0F7030069857D2E4169EE7", false},
+		{"hex invalid characters", hexAsset, "0xz2908400098527886E0F7030069857D2E4169EE7", false},
+		{"empty hex", hexAsset, "0x", false},
+	}
+
+	for _, tc := range testCases {
+		err := tc.asset.ValidateOtherChainAddress(tc.address)
+		if tc.expectPass {
+			suite.Require().NoError(err, tc.name)
+		} else {
+			suite.Require().Error(err, tc.name)
+		}
+	}
+}
+
+func (suite *ParamsTestSuite) TestAssetFee() {
+	testCases := []struct {
+		name        string
+		fee         types.AssetFee
+		amount      sdk.Int
+		expectedFee sdk.Int
+	}{
+		{"fixed only", types.NewAssetFee(sdk.NewInt(1000), 0, sdk.ZeroInt()), sdk.NewInt(50000), sdk.NewInt(1000)},
+		{"rate only", types.NewAssetFee(sdk.ZeroInt(), 25, sdk.ZeroInt()), sdk.NewInt(50000), sdk.NewInt(125)},
+		{"fixed plus rate", types.NewAssetFee(sdk.NewInt(1000), 25, sdk.ZeroInt()), sdk.NewInt(50000), sdk.NewInt(1125)},
+		{"rate rounds down", types.NewAssetFee(sdk.ZeroInt(), 25, sdk.ZeroInt()), sdk.NewInt(399), sdk.ZeroInt()},
+		{"minimum", types.NewAssetFee(sdk.NewInt(10), 25, sdk.NewInt(500)), sdk.NewInt(50000), sdk.NewInt(500)},
+		{"above minimum", types.NewAssetFee(sdk.NewInt(10), 25, sdk.NewInt(500)), sdk.NewInt(500000), sdk.NewInt(1260)},
+		{"no fee", types.NewAssetFee(sdk.ZeroInt(), 0, sdk.ZeroInt()), sdk.NewInt(50000), sdk.ZeroInt()},
+	}
+	for _, tc := range testCases {
+		suite.Require().NoError(tc.fee.Validate(), tc.name)
+		suite.Require().Equal(tc.expectedFee, tc.fee.Calculate(tc.amount), tc.name)
+	}
+
+	suite.Error(types.NewAssetFee(sdk.NewInt(-1), 0, sdk.ZeroInt()).Validate(), "negative fixed fee")
+	suite.Error(types.NewAssetFee(sdk.ZeroInt(), types.MaxFeeRate+1, sdk.ZeroInt()).Validate(), "rate above 100%")
+	suite.Error(types.NewAssetFee(sdk.ZeroInt(), 0, sdk.NewInt(-1)).Validate(), "negative minimum fee")
+	suite.Error(types.AssetFee{}.Validate(), "empty fee")
+
+	asset := types.DefaultSupportedAssets[0]
+	asset.Fee = types.NewAssetFee(sdk.ZeroInt(), types.MaxFeeRate+1, sdk.ZeroInt())
+	params := types.NewParams(suite.deputies(), types.DefaultMinBlockLock, types.DefaultMaxBlockLock, types.AssetParams{asset}, types.DefaultAutoRefund, types.DefaultMaxAutoRefunds)
+	suite.Error(params.Validate())
+}
+
 func TestParamsTestSuite(t *testing.T) {
 	suite.Run(t, new(ParamsTestSuite))
 }
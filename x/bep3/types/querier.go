@@ -14,6 +14,12 @@ const (
 	QueryGetAtomicSwap = "swap"
 	// QueryGetAtomicSwaps command for getting a list of atomic swaps
 	QueryGetAtomicSwaps = "swaps"
+	// QueryGetAtomicSwapsByRandomNumberHash command for getting the atomic swaps with a random number hash
+	QueryGetAtomicSwapsByRandomNumberHash = "swaps-by-random-number-hash"
+	// QueryGetAtomicSwapsBySenderOtherChain command for getting the atomic swaps sent from an address on the other chain
+	QueryGetAtomicSwapsBySenderOtherChain = "swaps-by-sender-other-chain"
+	// QueryGetAtomicSwapsByRecipientOtherChain command for getting the atomic swaps sent to an address on the other chain
+	QueryGetAtomicSwapsByRecipientOtherChain = "swaps-by-recipient-other-chain"
 	// QueryGetParams command for getting module params
 	QueryGetParams = "parameters"
 )
@@ -58,23 +64,58 @@ func NewQueryAtomicSwapByID(swapBytes tmbytes.HexBytes) QueryAtomicSwapByID {
 
 // QueryAtomicSwaps contains the params for an AtomicSwaps query
 type QueryAtomicSwaps struct {
-	Page       int            `json:"page" yaml:"page"`
-	Limit      int            `json:"limit" yaml:"limit"`
-	Involve    sdk.AccAddress `json:"involve" yaml:"involve"`
-	Expiration uint64         `json:"expiration" yaml:"expiration"`
-	Status     SwapStatus     `json:"status" yaml:"status"`
-	Direction  SwapDirection  `json:"direction" yaml:"direction"`
+	Page         int            `json:"page" yaml:"page"`
+	Limit        int            `json:"limit" yaml:"limit"`
+	Involve      sdk.AccAddress `json:"involve" yaml:"involve"`
+	Expiration   uint64         `json:"expiration" yaml:"expiration"`
+	Status       SwapStatus     `json:"status" yaml:"status"`
+	Direction    SwapDirection  `json:"direction" yaml:"direction"`
+	OtherChainID string         `json:"other_chain_id" yaml:"other_chain_id"`
 }
 
 // NewQueryAtomicSwaps creates a new instance of QueryAtomicSwaps
 func NewQueryAtomicSwaps(page, limit int, involve sdk.AccAddress, expiration uint64,
-	status SwapStatus, direction SwapDirection) QueryAtomicSwaps {
+	status SwapStatus, direction SwapDirection, otherChainID string) QueryAtomicSwaps {
 	return QueryAtomicSwaps{
-		Page:       page,
-		Limit:      limit,
-		Involve:    involve,
-		Expiration: expiration,
-		Status:     status,
-		Direction:  direction,
+		Page:         page,
+		Limit:        limit,
+		Involve:      involve,
+		Expiration:   expiration,
+		Status:       status,
+		Direction:    direction,
+		OtherChainID: otherChainID,
+	}
+}
+
+// QueryAtomicSwapsByRandomNumberHash contains the params for query 'custom/bep3/swaps-by-random-number-hash'
+type QueryAtomicSwapsByRandomNumberHash struct {
+	Page             int              `json:"page" yaml:"page"`
+	Limit            int              `json:"limit" yaml:"limit"`
+	RandomNumberHash tmbytes.HexBytes `json:"random_number_hash" yaml:"random_number_hash"`
+}
+
+// NewQueryAtomicSwapsByRandomNumberHash creates a new QueryAtomicSwapsByRandomNumberHash
+func NewQueryAtomicSwapsByRandomNumberHash(page, limit int, randomNumberHash tmbytes.HexBytes) QueryAtomicSwapsByRandomNumberHash {
+	return QueryAtomicSwapsByRandomNumberHash{
+		Page:             page,
+		Limit:            limit,
+		RandomNumberHash: randomNumberHash,
+	}
+}
+
+// QueryAtomicSwapsByOtherChainAddress contains the params for queries 'custom/bep3/swaps-by-sender-other-chain'
+// and 'custom/bep3/swaps-by-recipient-other-chain'
+type QueryAtomicSwapsByOtherChainAddress struct {
+	Page    int    `json:"page" yaml:"page"`
+	Limit   int    `json:"limit" yaml:"limit"`
+	Address string `json:"address" yaml:"address"`
+}
+
+// NewQueryAtomicSwapsByOtherChainAddress creates a new QueryAtomicSwapsByOtherChainAddress
+func NewQueryAtomicSwapsByOtherChainAddress(page, limit int, address string) QueryAtomicSwapsByOtherChainAddress {
+	return QueryAtomicSwapsByOtherChainAddress{
+		Page:    page,
+		Limit:   limit,
+		Address: address,
 	}
 }
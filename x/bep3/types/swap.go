@@ -23,6 +23,7 @@ type AtomicSwap struct {
 	Recipient           sdk.AccAddress   `json:"recipient"  yaml:"recipient"`
 	SenderOtherChain    string           `json:"sender_other_chain"  yaml:"sender_other_chain"`
 	RecipientOtherChain string           `json:"recipient_other_chain"  yaml:"recipient_other_chain"`
+	OtherChainID        string           `json:"other_chain_id"  yaml:"other_chain_id"`
 	ClosedBlock         int64            `json:"closed_block"  yaml:"closed_block"`
 	Status              SwapStatus       `json:"status"  yaml:"status"`
 	CrossChain          bool             `json:"cross_chain"  yaml:"cross_chain"`
@@ -31,8 +32,8 @@ type AtomicSwap struct {
 
 // NewAtomicSwap returns a new AtomicSwap
 func NewAtomicSwap(amount sdk.Coins, randomNumberHash tmbytes.HexBytes, expireHeight uint64, timestamp int64,
-	sender, recipient sdk.AccAddress, senderOtherChain string, recipientOtherChain string, closedBlock int64,
-	status SwapStatus, crossChain bool, direction SwapDirection) AtomicSwap {
+	sender, recipient sdk.AccAddress, senderOtherChain string, recipientOtherChain string, otherChainID string,
+	closedBlock int64, status SwapStatus, crossChain bool, direction SwapDirection) AtomicSwap {
 	return AtomicSwap{
 		Amount:              amount,
 		RandomNumberHash:    randomNumberHash,
@@ -42,6 +43,7 @@ func NewAtomicSwap(amount sdk.Coins, randomNumberHash tmbytes.HexBytes, expireHe
 		Recipient:           recipient,
 		SenderOtherChain:    senderOtherChain,
 		RecipientOtherChain: recipientOtherChain,
+		OtherChainID:        otherChainID,
 		ClosedBlock:         closedBlock,
 		Status:              status,
 		CrossChain:          crossChain,
@@ -51,7 +53,16 @@ func NewAtomicSwap(amount sdk.Coins, randomNumberHash tmbytes.HexBytes, expireHe
 
 // GetSwapID calculates the ID of an atomic swap
 func (a AtomicSwap) GetSwapID() tmbytes.HexBytes {
-	return CalculateSwapID(a.RandomNumberHash, a.Sender, a.SenderOtherChain)
+	return CalculateSwapID(a.RandomNumberHash, a.Sender, a.SenderOtherChain, a.OtherChainID)
+}
+
+// GetOtherChainID returns the ID of the swap's other chain. Swaps created before other chains were
+// supported have no chain ID and are on the default chain.
+func (a AtomicSwap) GetOtherChainID() string {
+	if strings.TrimSpace(a.OtherChainID) == "" {
+		return DefaultChainID
+	}
+	return a.OtherChainID
 }
 
 // GetCoins returns the swap's amount as sdk.Coins
@@ -120,13 +131,14 @@ func (a AtomicSwap) String() string {
 		"\n    Recipient:                %s"+
 		"\n    Sender other chain:       %s"+
 		"\n    Recipient other chain:    %s"+
+		"\n    Other chain ID:           %s"+
 		"\n    Closed block:             %d"+
 		"\n    Cross chain:              %t"+
 		"\n    Direction:                %s",
 		a.GetSwapID(), a.Status.String(), a.Amount.String(),
 		hex.EncodeToString(a.RandomNumberHash), a.ExpireHeight,
 		a.Timestamp, a.Sender.String(), a.Recipient.String(),
-		a.SenderOtherChain, a.RecipientOtherChain, a.ClosedBlock,
+		a.SenderOtherChain, a.RecipientOtherChain, a.OtherChainID, a.ClosedBlock,
 		a.CrossChain, a.Direction)
 }
 
@@ -60,6 +60,7 @@ func (suite *AtomicSwapTestSuite) TestNewAtomicSwap() {
 				Recipient:           suite.addrs[5],
 				RecipientOtherChain: "bnb1urfermcg92dwq36572cx4xg84wpk3lfpksr5g7",
 				SenderOtherChain:    "bnb1uky3me9ggqypmrsvxk7ur6hqkzq7zmv4ed4ng7",
+				OtherChainID:        types.DefaultChainID,
 				ClosedBlock:         1,
 				Status:              types.Open,
 				CrossChain:          true,
@@ -193,11 +194,29 @@ func (suite *AtomicSwapTestSuite) TestNewAtomicSwap() {
 				Recipient:           suite.addrs[5],
 				SenderOtherChain:    "bnb1uky3me9ggqypmrsvxk7ur6hqkzq7zmv4ed4ng7",
 				RecipientOtherChain: "bnb1urfermcg92dwq36572cx4xg84wpk3lfpksr5g7",
+				OtherChainID:        types.DefaultChainID,
 				ClosedBlock:         0,
 				Status:              types.Completed,
 			},
 			false,
 		},
+		{
+			"blank other chain id defaults to the default chain",
+			types.AtomicSwap{
+				Amount:              cs(c("bnb", 50000)),
+				RandomNumberHash:    suite.randomNumberHashes[0],
+				ExpireHeight:        10,
+				Timestamp:           10,
+				Sender:              suite.addrs[0],
+				Recipient:           suite.addrs[5],
+				SenderOtherChain:    "bnb1uky3me9ggqypmrsvxk7ur6hqkzq7zmv4ed4ng7",
+				RecipientOtherChain: "bnb1urfermcg92dwq36572cx4xg84wpk3lfpksr5g7",
+				ClosedBlock:         1,
+				Status:              types.Open,
+				Direction:           types.Incoming,
+			},
+			true,
+		},
 		{
 			"invalid status 0",
 			types.AtomicSwap{
@@ -209,6 +228,7 @@ func (suite *AtomicSwapTestSuite) TestNewAtomicSwap() {
 				Recipient:           suite.addrs[5],
 				SenderOtherChain:    "bnb1uky3me9ggqypmrsvxk7ur6hqkzq7zmv4ed4ng7",
 				RecipientOtherChain: "bnb1urfermcg92dwq36572cx4xg84wpk3lfpksr5g7",
+				OtherChainID:        types.DefaultChainID,
 				ClosedBlock:         1,
 				Status:              types.NULL,
 			},
@@ -225,6 +245,7 @@ func (suite *AtomicSwapTestSuite) TestNewAtomicSwap() {
 				Recipient:           suite.addrs[5],
 				SenderOtherChain:    "bnb1uky3me9ggqypmrsvxk7ur6hqkzq7zmv4ed4ng7",
 				RecipientOtherChain: "bnb1urfermcg92dwq36572cx4xg84wpk3lfpksr5g7",
+				OtherChainID:        types.DefaultChainID,
 				ClosedBlock:         1,
 				Status:              types.Open,
 				Direction:           types.INVALID,
@@ -240,7 +261,7 @@ func (suite *AtomicSwapTestSuite) TestNewAtomicSwap() {
 			suite.Require().NoError(err, tc.msg)
 			suite.Require().Equal(tc.swap.Amount, tc.swap.GetCoins())
 
-			expectedSwapID := types.CalculateSwapID(tc.swap.RandomNumberHash, tc.swap.Sender, tc.swap.SenderOtherChain)
+			expectedSwapID := types.CalculateSwapID(tc.swap.RandomNumberHash, tc.swap.Sender, tc.swap.SenderOtherChain, tc.swap.OtherChainID)
 			suite.Require().Equal(tmbytes.HexBytes(expectedSwapID), tc.swap.GetSwapID())
 		} else {
 			suite.Require().Error(err)
@@ -500,10 +500,11 @@ func (k Keeper) GetMarketStatus(ctx sdk.Context, marketID string) (up bool) {
 	return up
 }
 
-// UpdatePricefeedStatus determines if the price of an asset is available and updates the global status of the market
+// UpdatePricefeedStatus determines if the price of an asset is available and updates the global status of the market.
+// A market is considered down while the pricefeed holds its price after a breach of the market's deviation limits.
 func (k Keeper) UpdatePricefeedStatus(ctx sdk.Context, marketID string) (ok bool) {
 	_, err := k.pricefeedKeeper.GetCurrentPrice(ctx, marketID)
-	if err != nil {
+	if err != nil || k.pricefeedKeeper.IsMarketUnstable(ctx, marketID) {
 		k.SetMarketStatus(ctx, marketID, false)
 		return false
 	}
@@ -263,6 +263,32 @@ func (suite *CdpTestSuite) TestValidateCollateral() {
 	suite.Require().True(errors.Is(err, types.ErrCollateralNotSupported))
 }
 
+func (suite *CdpTestSuite) TestUpdatePricefeedStatusUnstableMarket() {
+	pk := suite.app.GetPriceFeedKeeper()
+	pfParams := pk.GetParams(suite.ctx)
+	for i := range pfParams.Markets {
+		if pfParams.Markets[i].MarketID == "xrp:usd" {
+			pfParams.Markets[i].MaxBlockDeviation = d("0.1")
+		}
+	}
+	pk.SetParams(suite.ctx, pfParams)
+	suite.True(suite.keeper.UpdatePricefeedStatus(suite.ctx, "xrp:usd"))
+
+	// doubling the price breaches the deviation limit, so the market is down
+	_, err := pk.SetPrice(suite.ctx, pricefeedOracle, "xrp:usd", d("0.5"), suite.ctx.BlockTime().Add(time.Hour))
+	suite.NoError(err)
+	suite.NoError(pk.SetCurrentPrices(suite.ctx, "xrp:usd"))
+	suite.False(suite.keeper.UpdatePricefeedStatus(suite.ctx, "xrp:usd"))
+	suite.False(suite.keeper.GetMarketStatus(suite.ctx, "xrp:usd"))
+
+	// once the move is confirmed the market is up again
+	suite.NoError(pk.ConfirmPrice(suite.ctx, "xrp:usd"))
+	suite.True(suite.keeper.UpdatePricefeedStatus(suite.ctx, "xrp:usd"))
+	price, err := pk.GetCurrentPrice(suite.ctx, "xrp:usd")
+	suite.NoError(err)
+	suite.Equal(d("0.5"), price.Price)
+}
+
 func (suite *CdpTestSuite) TestValidatePrincipal() {
 	d := sdk.NewCoin("usdx", sdk.NewInt(10000000))
 	err := suite.keeper.ValidatePrincipalAdd(suite.ctx, d)
@@ -68,21 +68,21 @@ func (suite *QuerierTestSuite) SetupTest() {
 	oracle := addrs[9]
 	marketParams := pftypes.Params{
 		Markets: pftypes.Markets{
-			pftypes.Market{MarketID: "xrp-usd", BaseAsset: "xrp", QuoteAsset: "usd", Oracles: []sdk.AccAddress{oracle}, Active: true},
-			pftypes.Market{MarketID: "btc-usd", BaseAsset: "btc", QuoteAsset: "usd", Oracles: []sdk.AccAddress{oracle}, Active: true},
+			pftypes.Market{MarketID: "xrp:usd", BaseAsset: "xrp", QuoteAsset: "usd", Oracles: []sdk.AccAddress{oracle}, Active: true},
+			pftypes.Market{MarketID: "btc:usd", BaseAsset: "btc", QuoteAsset: "usd", Oracles: []sdk.AccAddress{oracle}, Active: true},
 		},
 	}
 	suite.pricefeedKeeper.SetParams(ctx, marketParams)
 
 	// Set collateral prices for use in collateralization calculations
 	_, err := suite.pricefeedKeeper.SetPrice(
-		ctx, oracle, "xrp-usd",
+		ctx, oracle, "xrp:usd",
 		sdk.MustNewDecFromStr("0.75"),
 		time.Now().Add(1*time.Hour))
 	suite.Nil(err)
 
 	_, err = suite.pricefeedKeeper.SetPrice(
-		ctx, oracle, "btc-usd",
+		ctx, oracle, "btc:usd",
 		sdk.MustNewDecFromStr("5000"),
 		time.Now().Add(1*time.Hour))
 	suite.Nil(err)
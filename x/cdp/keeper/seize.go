@@ -1,11 +1,13 @@
 package keeper
 
 import (
+	"errors"
 	"fmt"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/kava-labs/kava/x/cdp/types"
+	pftypes "github.com/kava-labs/kava/x/pricefeed/types"
 )
 
 // SeizeCollateral liquidates the collateral in the input cdp.
@@ -65,8 +67,13 @@ func (k Keeper) SeizeCollateral(ctx sdk.Context, cdp types.CDP) error {
 }
 
 // LiquidateCdps seizes collateral from all CDPs below the input liquidation ratio
+// CDPs using the TWAP are not liquidated while the price history doesn't cover the TWAP window.
 func (k Keeper) LiquidateCdps(ctx sdk.Context, marketID string, denom string, liquidationRatio sdk.Dec) error {
-	price, err := k.pricefeedKeeper.GetCurrentPrice(ctx, marketID)
+	price, err := k.getLiquidationPrice(ctx, marketID, denom)
+	if errors.Is(err, pftypes.ErrInsufficientPriceHistory) {
+		k.Logger(ctx).Info(fmt.Sprintf("skipping liquidation of %s cdps: %s", denom, err))
+		return nil
+	}
 	if err != nil {
 		return err
 	}
@@ -88,6 +95,16 @@ func (k Keeper) LiquidateCdps(ctx sdk.Context, marketID string, denom string, li
 	return nil
 }
 
+// getLiquidationPrice returns the price used to find cdps to liquidate, which is the
+// time-weighted average price if the collateral param uses TWAP, otherwise the spot price
+func (k Keeper) getLiquidationPrice(ctx sdk.Context, marketID string, denom string) (pftypes.CurrentPrice, error) {
+	cp, found := k.GetCollateral(ctx, denom)
+	if found && cp.UseTWAP {
+		return k.pricefeedKeeper.GetTWAPPrice(ctx, marketID)
+	}
+	return k.pricefeedKeeper.GetCurrentPrice(ctx, marketID)
+}
+
 // ApplyLiquidationPenalty multiplies the input debt amount by the liquidation penalty and mints the debt coins in the cdp module account
 func (k Keeper) ApplyLiquidationPenalty(ctx sdk.Context, denom string, debt sdk.Int) sdk.Int {
 	penalty := k.getLiquidationPenalty(ctx, denom)
@@ -18,6 +18,7 @@ import (
 	"github.com/kava-labs/kava/x/auction"
 	"github.com/kava-labs/kava/x/cdp/keeper"
 	"github.com/kava-labs/kava/x/cdp/types"
+	pftypes "github.com/kava-labs/kava/x/pricefeed/types"
 )
 
 type SeizeTestSuite struct {
@@ -120,7 +121,7 @@ func (suite *SeizeTestSuite) createCdps() {
 func (suite *SeizeTestSuite) setPrice(price sdk.Dec, market string) {
 	pfKeeper := suite.app.GetPriceFeedKeeper()
 
-	pfKeeper.SetPrice(suite.ctx, sdk.AccAddress{}, market, price, suite.ctx.BlockTime().Add(time.Hour*3))
+	pfKeeper.SetPrice(suite.ctx, pricefeedOracle, market, price, suite.ctx.BlockTime().Add(time.Hour*3))
 	err := pfKeeper.SetCurrentPrices(suite.ctx, market)
 	suite.NoError(err)
 	pp, err := pfKeeper.GetCurrentPrice(suite.ctx, market)
@@ -195,6 +196,89 @@ func (suite *SeizeTestSuite) TestLiquidateCdps() {
 	suite.Equal(len(suite.liquidations.xrp), xrpLiquidations)
 }
 
+func (suite *SeizeTestSuite) TestLiquidateCdpsTWAP() {
+	suite.createCdps()
+	sk := suite.app.GetSupplyKeeper()
+	pfKeeper := suite.app.GetPriceFeedKeeper()
+	acc := sk.GetModuleAccount(suite.ctx, types.ModuleName)
+	originalXrpCollateral := acc.GetCoins().AmountOf("xrp")
+
+	// keep an hour of price history, sampled at most every 10 minutes
+	pfParams := pfKeeper.GetParams(suite.ctx)
+	pfParams.TWAPWindow = time.Hour
+	pfParams.PriceHistoryLength = 6
+	pfKeeper.SetParams(suite.ctx, pfParams)
+	suite.setPrice(d("0.25"), "xrp:usd")
+
+	cdpParams := suite.keeper.GetParams(suite.ctx)
+	for i := range cdpParams.CollateralParams {
+		if cdpParams.CollateralParams[i].Denom == "xrp" {
+			cdpParams.CollateralParams[i].UseTWAP = true
+		}
+	}
+	suite.keeper.SetParams(suite.ctx, cdpParams)
+
+	// fill the price history so that it covers the twap window
+	for i := 1; i <= 6; i++ {
+		suite.ctx = suite.ctx.WithBlockTime(suite.ctx.BlockTime().Add(10 * time.Minute))
+		suite.setPrice(d("0.25"), "xrp:usd")
+	}
+	_, err := pfKeeper.GetTWAPPrice(suite.ctx, "xrp:usd")
+	suite.NoError(err)
+
+	// a short lived price drop does not move the twap enough to liquidate cdps
+	suite.ctx = suite.ctx.WithBlockTime(suite.ctx.BlockTime().Add(time.Minute))
+	suite.setPrice(d("0.2"), "xrp:usd")
+	p, found := suite.keeper.GetCollateral(suite.ctx, "xrp")
+	suite.True(found)
+	suite.NoError(suite.keeper.LiquidateCdps(suite.ctx, "xrp:usd", "xrp", p.LiquidationRatio))
+	acc = sk.GetModuleAccount(suite.ctx, types.ModuleName)
+	suite.Equal(originalXrpCollateral, acc.GetCoins().AmountOf("xrp"))
+
+	// once the price drop is sustained over the twap window cdps are liquidated
+	for i := 1; i <= 6; i++ {
+		suite.ctx = suite.ctx.WithBlockTime(suite.ctx.BlockTime().Add(10 * time.Minute))
+		suite.setPrice(d("0.2"), "xrp:usd")
+	}
+	suite.NoError(suite.keeper.LiquidateCdps(suite.ctx, "xrp:usd", "xrp", p.LiquidationRatio))
+	acc = sk.GetModuleAccount(suite.ctx, types.ModuleName)
+	finalXrpCollateral := acc.GetCoins().AmountOf("xrp")
+	seizedXrpCollateral := originalXrpCollateral.Sub(finalXrpCollateral)
+	xrpLiquidations := int(seizedXrpCollateral.Quo(i(10000000000)).Int64())
+	suite.Equal(len(suite.liquidations.xrp), xrpLiquidations)
+}
+
+func (suite *SeizeTestSuite) TestLiquidateCdpsTWAPInsufficientHistory() {
+	suite.createCdps()
+	sk := suite.app.GetSupplyKeeper()
+	pfKeeper := suite.app.GetPriceFeedKeeper()
+	acc := sk.GetModuleAccount(suite.ctx, types.ModuleName)
+	originalXrpCollateral := acc.GetCoins().AmountOf("xrp")
+
+	pfParams := pfKeeper.GetParams(suite.ctx)
+	pfParams.TWAPWindow = time.Hour
+	pfParams.PriceHistoryLength = 6
+	pfKeeper.SetParams(suite.ctx, pfParams)
+
+	cdpParams := suite.keeper.GetParams(suite.ctx)
+	for i := range cdpParams.CollateralParams {
+		if cdpParams.CollateralParams[i].Denom == "xrp" {
+			cdpParams.CollateralParams[i].UseTWAP = true
+		}
+	}
+	suite.keeper.SetParams(suite.ctx, cdpParams)
+
+	// cdps are not liquidated at the spot price while the history doesn't cover the twap window
+	suite.setPrice(d("0.2"), "xrp:usd")
+	_, err := pfKeeper.GetTWAPPrice(suite.ctx, "xrp:usd")
+	suite.True(errors.Is(err, pftypes.ErrInsufficientPriceHistory))
+	p, found := suite.keeper.GetCollateral(suite.ctx, "xrp")
+	suite.True(found)
+	suite.NoError(suite.keeper.LiquidateCdps(suite.ctx, "xrp:usd", "xrp", p.LiquidationRatio))
+	acc = sk.GetModuleAccount(suite.ctx, types.ModuleName)
+	suite.Equal(originalXrpCollateral, acc.GetCoins().AmountOf("xrp"))
+}
+
 func (suite *SeizeTestSuite) TestApplyLiquidationPenalty() {
 	penalty := suite.keeper.ApplyLiquidationPenalty(suite.ctx, "xrp", i(1000))
 	suite.Equal(i(50), penalty)
@@ -29,6 +29,8 @@ type SupplyKeeper interface {
 // PricefeedKeeper defines the expected interface for the pricefeed  (noalias)
 type PricefeedKeeper interface {
 	GetCurrentPrice(sdk.Context, string) (pftypes.CurrentPrice, error)
+	GetTWAPPrice(sdk.Context, string) (pftypes.CurrentPrice, error)
+	IsMarketUnstable(sdk.Context, string) bool
 	GetParams(sdk.Context) pftypes.Params
 	// These are used for testing TODO replace mockApp with keeper in tests to remove these
 	SetParams(sdk.Context, pftypes.Params)
@@ -97,6 +97,7 @@ type CollateralParam struct {
 	LiquidationPenalty sdk.Dec  `json:"liquidation_penalty" yaml:"liquidation_penalty"` // percentage penalty (between [0, 1]) applied to a cdp if it is liquidated
 	Prefix             byte     `json:"prefix" yaml:"prefix"`
 	MarketID           string   `json:"market_id" yaml:"market_id"`                 // marketID for fetching price of the asset from the pricefeed
+	UseTWAP            bool     `json:"use_twap" yaml:"use_twap"`                   // use the pricefeed time-weighted average price instead of the spot price for liquidations
 	ConversionFactor   sdk.Int  `json:"conversion_factor" yaml:"conversion_factor"` // factor for converting internal units to one base unit of collateral
 }
 
@@ -111,8 +112,9 @@ func (cp CollateralParam) String() string {
 	Auction Size: %s
 	Prefix: %b
 	Market ID: %s
+	Use TWAP: %t
 	Conversion Factor: %s`,
-		cp.Denom, cp.LiquidationRatio, cp.StabilityFee, cp.LiquidationPenalty, cp.DebtLimit, cp.AuctionSize, cp.Prefix, cp.MarketID, cp.ConversionFactor)
+		cp.Denom, cp.LiquidationRatio, cp.StabilityFee, cp.LiquidationPenalty, cp.DebtLimit, cp.AuctionSize, cp.Prefix, cp.MarketID, cp.UseTWAP, cp.ConversionFactor)
 }
 
 // CollateralParams array of CollateralParam
@@ -12,5 +12,7 @@ func BeginBlocker(ctx sdk.Context, _ abci.RequestBeginBlock, k Keeper) {
 	// enact proposals ignoring their expiry time - they could have received enough votes last block before expiring this block
 	k.EnactPassedProposals(ctx)
 
+	k.CloseRejectedProposals(ctx)
+
 	k.CloseExpiredProposals(ctx)
 }
@@ -109,9 +109,9 @@ func (suite *ModuleTestSuite) TestBeginBlock_EnactsPassed() {
 	suite.NoError(err)
 
 	// add enough votes to make the first proposal pass, but not the second
-	suite.NoError(suite.keeper.AddVote(suite.ctx, id1, suite.addresses[0]))
-	suite.NoError(suite.keeper.AddVote(suite.ctx, id1, suite.addresses[1]))
-	suite.NoError(suite.keeper.AddVote(suite.ctx, id2, suite.addresses[0]))
+	suite.NoError(suite.keeper.AddVote(suite.ctx, id1, suite.addresses[0], committee.OptionYes))
+	suite.NoError(suite.keeper.AddVote(suite.ctx, id1, suite.addresses[1], committee.OptionYes))
+	suite.NoError(suite.keeper.AddVote(suite.ctx, id2, suite.addresses[0], committee.OptionYes))
 
 	// Run BeginBlocker
 	suite.NotPanics(func() {
@@ -127,6 +127,157 @@ func (suite *ModuleTestSuite) TestBeginBlock_EnactsPassed() {
 	suite.True(found, "expected non passed proposal to be not closed")
 }
 
+func (suite *ModuleTestSuite) TestBeginBlock_QueuesDelayed() {
+	suite.app.InitializeFromGenesisStates()
+
+	delayedCom := committee.Committee{
+		ID:               12,
+		Members:          suite.addresses[:2],
+		Permissions:      []committee.Permission{committee.GodPermission{}},
+		VoteThreshold:    d("0.8"),
+		ProposalDuration: time.Hour * 24,
+		EnactmentDelay:   time.Hour * 24 * 7,
+	}
+	suite.keeper.SetCommittee(suite.ctx, delayedCom)
+
+	previousCDPDebtThreshold := suite.app.GetCDPKeeper().GetParams(suite.ctx).DebtAuctionThreshold
+	newDebtThreshold := previousCDPDebtThreshold.Add(i(1000000))
+	pprop := params.NewParameterChangeProposal("Title 1", "A description of this proposal.",
+		[]params.ParamChange{{
+			Subspace: cdptypes.ModuleName,
+			Key:      string(cdp.KeyDebtThreshold),
+			Value:    string(cdp.ModuleCdc.MustMarshalJSON(newDebtThreshold)),
+		}},
+	)
+	id, err := suite.keeper.SubmitProposal(suite.ctx, delayedCom.Members[0], delayedCom.ID, pprop)
+	suite.NoError(err)
+	suite.NoError(suite.keeper.AddVote(suite.ctx, id, suite.addresses[0], committee.OptionYes))
+	suite.NoError(suite.keeper.AddVote(suite.ctx, id, suite.addresses[1], committee.OptionYes))
+
+	// Run BeginBlocker
+	suite.NotPanics(func() {
+		committee.BeginBlocker(suite.ctx, abci.RequestBeginBlock{}, suite.keeper)
+	})
+
+	// Check the passed proposal is queued rather than enacted
+	suite.Equal(previousCDPDebtThreshold, suite.app.GetCDPKeeper().GetParams(suite.ctx).DebtAuctionThreshold)
+	pr, found := suite.keeper.GetProposal(suite.ctx, id)
+	suite.True(found, "expected passed proposal to be queued")
+	suite.Equal(suite.ctx.BlockTime().Add(delayedCom.EnactmentDelay), pr.EnactmentTime)
+	suite.Error(suite.keeper.AddVote(suite.ctx, id, suite.addresses[1], committee.OptionNo))
+
+	// Run BeginBlocker after the proposal deadline, but before the enactment time
+	deadlineCtx := suite.ctx.WithBlockTime(pr.Deadline)
+	suite.NotPanics(func() {
+		committee.BeginBlocker(deadlineCtx, abci.RequestBeginBlock{}, suite.keeper)
+	})
+	_, found = suite.keeper.GetProposal(deadlineCtx, id)
+	suite.True(found, "expected queued proposal to not expire")
+
+	// Run BeginBlocker once the enactment delay has elapsed
+	enactmentCtx := suite.ctx.WithBlockTime(pr.EnactmentTime)
+	suite.NotPanics(func() {
+		committee.BeginBlocker(enactmentCtx, abci.RequestBeginBlock{}, suite.keeper)
+	})
+
+	// Check the param has been updated and the proposal has gone
+	suite.Equal(newDebtThreshold, suite.app.GetCDPKeeper().GetParams(enactmentCtx).DebtAuctionThreshold)
+	_, found = suite.keeper.GetProposal(enactmentCtx, id)
+	suite.False(found, "expected queued proposal to be enacted and closed")
+}
+
+func (suite *ModuleTestSuite) TestBeginBlock_GuardianCancelsQueued() {
+	suite.app.InitializeFromGenesisStates()
+
+	delayedCom := committee.Committee{
+		ID:               12,
+		Members:          suite.addresses[:2],
+		Permissions:      []committee.Permission{committee.TextPermission{}},
+		VoteThreshold:    d("0.8"),
+		ProposalDuration: time.Hour * 24 * 7,
+		EnactmentDelay:   time.Hour * 24,
+	}
+	guardianCom := committee.Committee{
+		ID:               13,
+		Members:          suite.addresses[2:],
+		Permissions:      []committee.Permission{committee.CancelPermission{}},
+		VoteThreshold:    d("0.5"),
+		ProposalDuration: time.Hour * 24,
+	}
+	suite.keeper.SetCommittee(suite.ctx, delayedCom)
+	suite.keeper.SetCommittee(suite.ctx, guardianCom)
+
+	// queue a proposal
+	pprop := gov.NewTextProposal("Title 1", "A description of this proposal.")
+	id, err := suite.keeper.SubmitProposal(suite.ctx, delayedCom.Members[0], delayedCom.ID, pprop)
+	suite.NoError(err)
+	suite.NoError(suite.keeper.AddVote(suite.ctx, id, suite.addresses[0], committee.OptionYes))
+	suite.NoError(suite.keeper.AddVote(suite.ctx, id, suite.addresses[1], committee.OptionYes))
+	committee.BeginBlocker(suite.ctx, abci.RequestBeginBlock{}, suite.keeper)
+
+	// the guardian can only submit proposals to cancel queued proposals
+	_, err = suite.keeper.SubmitProposal(suite.ctx, guardianCom.Members[0], guardianCom.ID, pprop)
+	suite.Error(err)
+
+	// pass a proposal in the guardian committee to cancel the queued proposal
+	cancelProp := committee.NewCommitteeCancelProposal("Title 2", "A description of this proposal.", id)
+	cancelID, err := suite.keeper.SubmitProposal(suite.ctx, guardianCom.Members[0], guardianCom.ID, cancelProp)
+	suite.NoError(err)
+	suite.NoError(suite.keeper.AddVote(suite.ctx, cancelID, suite.addresses[2], committee.OptionYes))
+	suite.NoError(suite.keeper.AddVote(suite.ctx, cancelID, suite.addresses[3], committee.OptionYes))
+
+	// Run BeginBlocker
+	suite.NotPanics(func() {
+		committee.BeginBlocker(suite.ctx, abci.RequestBeginBlock{}, suite.keeper)
+	})
+
+	// Check both proposals have gone
+	_, found := suite.keeper.GetProposal(suite.ctx, id)
+	suite.False(found, "expected queued proposal to be cancelled")
+	_, found = suite.keeper.GetProposal(suite.ctx, cancelID)
+	suite.False(found, "expected cancel proposal to be enacted and closed")
+}
+
+func (suite *ModuleTestSuite) TestBeginBlock_ClosesRejected() {
+	suite.app.InitializeFromGenesisStates()
+
+	normalCom := committee.Committee{
+		ID:               12,
+		Members:          suite.addresses[:5],
+		Permissions:      []committee.Permission{committee.GodPermission{}},
+		VoteThreshold:    d("0.6"),
+		ProposalDuration: time.Hour * 24 * 7,
+	}
+	suite.keeper.SetCommittee(suite.ctx, normalCom)
+
+	pprop1 := gov.NewTextProposal("Title 1", "A description of this proposal.")
+	id1, err := suite.keeper.SubmitProposal(suite.ctx, normalCom.Members[0], normalCom.ID, pprop1)
+	suite.NoError(err)
+	pprop2 := gov.NewTextProposal("Title 2", "A description of this proposal.")
+	id2, err := suite.keeper.SubmitProposal(suite.ctx, normalCom.Members[0], normalCom.ID, pprop2)
+	suite.NoError(err)
+
+	// 3 of 5 votes are needed, so a no and an abstain vote leave the first proposal just able to pass
+	suite.NoError(suite.keeper.AddVote(suite.ctx, id1, suite.addresses[0], committee.OptionNo))
+	suite.NoError(suite.keeper.AddVote(suite.ctx, id1, suite.addresses[1], committee.OptionAbstain))
+	// a third vote against makes the second proposal impossible to pass
+	suite.NoError(suite.keeper.AddVote(suite.ctx, id2, suite.addresses[0], committee.OptionNo))
+	suite.NoError(suite.keeper.AddVote(suite.ctx, id2, suite.addresses[1], committee.OptionAbstain))
+	suite.NoError(suite.keeper.AddVote(suite.ctx, id2, suite.addresses[2], committee.OptionNo))
+
+	// Run BeginBlocker
+	suite.NotPanics(func() {
+		committee.BeginBlocker(suite.ctx, abci.RequestBeginBlock{}, suite.keeper)
+	})
+
+	// Check the rejected proposal and its votes are gone
+	_, found := suite.keeper.GetProposal(suite.ctx, id1)
+	suite.True(found, "expected proposal that can still pass to be not closed")
+	_, found = suite.keeper.GetProposal(suite.ctx, id2)
+	suite.False(found, "expected rejected proposal to be closed")
+	suite.Empty(suite.keeper.GetVotesByProposal(suite.ctx, id2))
+}
+
 func (suite *ModuleTestSuite) TestBeginBlock_DoesntEnactFailed() {
 	suite.app.InitializeFromGenesisStates()
 
@@ -154,7 +305,7 @@ func (suite *ModuleTestSuite) TestBeginBlock_DoesntEnactFailed() {
 	suite.NoError(err)
 
 	// add enough votes to make the proposal pass
-	suite.NoError(suite.keeper.AddVote(ctx, id1, suite.addresses[0]))
+	suite.NoError(suite.keeper.AddVote(ctx, id1, suite.addresses[0], committee.OptionYes))
 
 	// Run BeginBlocker 10 seconds later (5 seconds after upgrade expires)
 	tenSecLaterCtx := ctx.WithBlockTime(ctx.BlockTime().Add(time.Second * 10))
@@ -203,7 +354,7 @@ func (suite *ModuleTestSuite) TestBeginBlock_EnactsPassedUpgrade() {
 	suite.NoError(err)
 
 	// add enough votes to make the proposal pass
-	suite.NoError(suite.keeper.AddVote(ctx, id1, suite.addresses[0]))
+	suite.NoError(suite.keeper.AddVote(ctx, id1, suite.addresses[0], committee.OptionYes))
 
 	// Run BeginBlocker
 	fiveSecLaterCtx := ctx.WithBlockTime(ctx.BlockTime().Add(time.Second * 5))
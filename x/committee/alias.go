@@ -10,20 +10,30 @@ import (
 
 const (
 	AttributeKeyCommitteeID         = types.AttributeKeyCommitteeID
+	AttributeKeyEnactmentTime       = types.AttributeKeyEnactmentTime
 	AttributeKeyProposalCloseStatus = types.AttributeKeyProposalCloseStatus
 	AttributeKeyProposalID          = types.AttributeKeyProposalID
+	AttributeKeyVoteOption          = types.AttributeKeyVoteOption
 	AttributeKeyVoter               = types.AttributeKeyVoter
 	AttributeValueCategory          = types.AttributeValueCategory
+	AttributeValueProposalCancelled = types.AttributeValueProposalCancelled
 	AttributeValueProposalFailed    = types.AttributeValueProposalFailed
 	AttributeValueProposalPassed    = types.AttributeValueProposalPassed
+	AttributeValueProposalRejected  = types.AttributeValueProposalRejected
 	AttributeValueProposalTimeout   = types.AttributeValueProposalTimeout
 	DefaultNextProposalID           = types.DefaultNextProposalID
 	DefaultParamspace               = types.DefaultParamspace
 	EventTypeProposalClose          = types.EventTypeProposalClose
+	EventTypeProposalQueue          = types.EventTypeProposalQueue
 	EventTypeProposalSubmit         = types.EventTypeProposalSubmit
 	EventTypeProposalVote           = types.EventTypeProposalVote
 	MaxCommitteeDescriptionLength   = types.MaxCommitteeDescriptionLength
 	ModuleName                      = types.ModuleName
+	OptionAbstain                   = types.OptionAbstain
+	OptionEmpty                     = types.OptionEmpty
+	OptionNo                        = types.OptionNo
+	OptionYes                       = types.OptionYes
+	ProposalTypeCommitteeCancel     = types.ProposalTypeCommitteeCancel
 	ProposalTypeCommitteeChange     = types.ProposalTypeCommitteeChange
 	ProposalTypeCommitteeDelete     = types.ProposalTypeCommitteeDelete
 	QuerierRoute                    = types.QuerierRoute
@@ -35,6 +45,9 @@ const (
 	QueryVote                       = types.QueryVote
 	QueryVotes                      = types.QueryVotes
 	RouterKey                       = types.RouterKey
+	SourceBalance                   = types.SourceBalance
+	SourceEmpty                     = types.SourceEmpty
+	SourceStaked                    = types.SourceStaked
 	StoreKey                        = types.StoreKey
 	TypeMsgSubmitProposal           = types.TypeMsgSubmitProposal
 	TypeMsgVote                     = types.TypeMsgVote
@@ -42,6 +55,7 @@ const (
 
 var (
 	// function aliases
+	EscrowedTokensInvariant     = keeper.EscrowedTokensInvariant
 	NewKeeper                   = keeper.NewKeeper
 	NewQuerier                  = keeper.NewQuerier
 	RegisterInvariants          = keeper.RegisterInvariants
@@ -50,18 +64,28 @@ var (
 	ValidVotesInvariant         = keeper.ValidVotesInvariant
 	DefaultGenesisState         = types.DefaultGenesisState
 	GetKeyFromID                = types.GetKeyFromID
+	GetParamChangeRecordKey     = types.GetParamChangeRecordKey
 	GetVoteKey                  = types.GetVoteKey
+	GetVotingPowerKey           = types.GetVotingPowerKey
 	NewCommittee                = types.NewCommittee
+	NewCommitteeCancelProposal  = types.NewCommitteeCancelProposal
 	NewCommitteeChangeProposal  = types.NewCommitteeChangeProposal
 	NewCommitteeDeleteProposal  = types.NewCommitteeDeleteProposal
 	NewGenesisState             = types.NewGenesisState
 	NewMsgSubmitProposal        = types.NewMsgSubmitProposal
 	NewMsgVote                  = types.NewMsgVote
+	NewParamChangeRecord        = types.NewParamChangeRecord
 	NewProposal                 = types.NewProposal
 	NewQueryCommitteeParams     = types.NewQueryCommitteeParams
 	NewQueryProposalParams      = types.NewQueryProposalParams
 	NewQueryVoteParams          = types.NewQueryVoteParams
+	NewTallyResult              = types.NewTallyResult
+	NewTallySourceFromString    = types.NewTallySourceFromString
+	NewTokenCommittee           = types.NewTokenCommittee
+	NewTokenTally               = types.NewTokenTally
 	NewVote                     = types.NewVote
+	NewVoteOptionFromString     = types.NewVoteOptionFromString
+	NewVotingPower              = types.NewVotingPower
 	RegisterCodec               = types.RegisterCodec
 	RegisterPermissionTypeCodec = types.RegisterPermissionTypeCodec
 	RegisterProposalTypeCodec   = types.RegisterProposalTypeCodec
@@ -73,45 +97,70 @@ var (
 	ErrInvalidCommittee        = types.ErrInvalidCommittee
 	ErrInvalidGenesis          = types.ErrInvalidGenesis
 	ErrInvalidPubProposal      = types.ErrInvalidPubProposal
+	ErrInvalidVoteOption       = types.ErrInvalidVoteOption
 	ErrNoProposalHandlerExists = types.ErrNoProposalHandlerExists
+	ErrNoVotingPower           = types.ErrNoVotingPower
 	ErrProposalExpired         = types.ErrProposalExpired
+	ErrProposalNotQueued       = types.ErrProposalNotQueued
+	ErrProposalQueued          = types.ErrProposalQueued
 	ErrUnknownCommittee        = types.ErrUnknownCommittee
 	ErrUnknownProposal         = types.ErrUnknownProposal
 	ErrUnknownVote             = types.ErrUnknownVote
 	ModuleCdc                  = types.ModuleCdc
 	NextProposalIDKey          = types.NextProposalIDKey
+	ParamChangeRecordKeyPrefix = types.ParamChangeRecordKeyPrefix
 	ProposalKeyPrefix          = types.ProposalKeyPrefix
 	VoteKeyPrefix              = types.VoteKeyPrefix
+	VotingPowerKeyPrefix       = types.VotingPowerKeyPrefix
 )
 
 type (
-	Keeper                      = keeper.Keeper
-	AllowedAssetParam           = types.AllowedAssetParam
-	AllowedAssetParams          = types.AllowedAssetParams
-	AllowedCollateralParam      = types.AllowedCollateralParam
-	AllowedCollateralParams     = types.AllowedCollateralParams
-	AllowedDebtParam            = types.AllowedDebtParam
-	AllowedMarket               = types.AllowedMarket
-	AllowedMarkets              = types.AllowedMarkets
-	AllowedParam                = types.AllowedParam
-	AllowedParams               = types.AllowedParams
-	Committee                   = types.Committee
-	CommitteeChangeProposal     = types.CommitteeChangeProposal
-	CommitteeDeleteProposal     = types.CommitteeDeleteProposal
-	GenesisState                = types.GenesisState
-	GodPermission               = types.GodPermission
-	MsgSubmitProposal           = types.MsgSubmitProposal
-	MsgVote                     = types.MsgVote
-	ParamKeeper                 = types.ParamKeeper
-	Permission                  = types.Permission
-	Proposal                    = types.Proposal
-	PubProposal                 = types.PubProposal
-	QueryCommitteeParams        = types.QueryCommitteeParams
-	QueryProposalParams         = types.QueryProposalParams
-	QueryVoteParams             = types.QueryVoteParams
-	SimpleParamChangePermission = types.SimpleParamChangePermission
-	SoftwareUpgradePermission   = types.SoftwareUpgradePermission
-	SubParamChangePermission    = types.SubParamChangePermission
-	TextPermission              = types.TextPermission
-	Vote                        = types.Vote
+	Keeper                         = keeper.Keeper
+	AllowedAssetParam              = types.AllowedAssetParam
+	AllowedAssetParams             = types.AllowedAssetParams
+	AllowedAuctionParams           = types.AllowedAuctionParams
+	AllowedCollateralAuctionParam  = types.AllowedCollateralAuctionParam
+	AllowedCollateralAuctionParams = types.AllowedCollateralAuctionParams
+	AllowedCollateralParam         = types.AllowedCollateralParam
+	AllowedCollateralParams        = types.AllowedCollateralParams
+	AllowedDebtParam               = types.AllowedDebtParam
+	AllowedKavadistPeriod          = types.AllowedKavadistPeriod
+	AllowedKavadistPeriods         = types.AllowedKavadistPeriods
+	AllowedMarket                  = types.AllowedMarket
+	AllowedMarkets                 = types.AllowedMarkets
+	AllowedParam                   = types.AllowedParam
+	AllowedParams                  = types.AllowedParams
+	AllowedReward                  = types.AllowedReward
+	AllowedRewards                 = types.AllowedRewards
+	CancelPermission               = types.CancelPermission
+	Committee                      = types.Committee
+	CommitteeCancelProposal        = types.CommitteeCancelProposal
+	CommitteeChangeProposal        = types.CommitteeChangeProposal
+	CommitteeDeleteProposal        = types.CommitteeDeleteProposal
+	ConfirmPricePermission         = types.ConfirmPricePermission
+	DecBounds                      = types.DecBounds
+	GenesisState                   = types.GenesisState
+	GodPermission                  = types.GodPermission
+	IntBounds                      = types.IntBounds
+	MsgSubmitProposal              = types.MsgSubmitProposal
+	MsgVote                        = types.MsgVote
+	ParamChangeHistory             = types.ParamChangeHistory
+	ParamChangeRecord              = types.ParamChangeRecord
+	ParamKeeper                    = types.ParamKeeper
+	Permission                     = types.Permission
+	Proposal                       = types.Proposal
+	PubProposal                    = types.PubProposal
+	QueryCommitteeParams           = types.QueryCommitteeParams
+	QueryProposalParams            = types.QueryProposalParams
+	QueryVoteParams                = types.QueryVoteParams
+	SimpleParamChangePermission    = types.SimpleParamChangePermission
+	SoftwareUpgradePermission      = types.SoftwareUpgradePermission
+	SubParamChangePermission       = types.SubParamChangePermission
+	TallyResult                    = types.TallyResult
+	TallySource                    = types.TallySource
+	TextPermission                 = types.TextPermission
+	TokenTally                     = types.TokenTally
+	Vote                           = types.Vote
+	VoteOption                     = types.VoteOption
+	VotingPower                    = types.VotingPower
 )
@@ -258,7 +258,7 @@ func GetCmdQueryTally(queryRoute string, cdc *codec.Codec) *cobra.Command {
 			}
 
 			// Decode and print results
-			var tally bool
+			var tally types.TallyResult
 			if err = cdc.UnmarshalJSON(res, &tally); err != nil {
 				return err
 			}
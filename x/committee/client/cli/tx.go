@@ -100,11 +100,11 @@ For example:
 // GetCmdVote returns the command to vote on a proposal.
 func GetCmdVote(cdc *codec.Codec) *cobra.Command {
 	return &cobra.Command{
-		Use:     "vote [proposal-id]",
-		Args:    cobra.ExactArgs(1),
-		Short:   "Vote for an active proposal",
-		Long:    "Submit a yes vote for the proposal with id [proposal-id].",
-		Example: fmt.Sprintf("%s tx %s vote 2", version.ClientName, types.ModuleName),
+		Use:     "vote [proposal-id] [option]",
+		Args:    cobra.ExactArgs(2),
+		Short:   "Vote on an active proposal",
+		Long:    "Submit a vote for the proposal with id [proposal-id]. Valid options are yes, no and abstain.",
+		Example: fmt.Sprintf("%s tx %s vote 2 yes", version.ClientName, types.ModuleName),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			inBuf := bufio.NewReader(cmd.InOrStdin())
 			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
@@ -119,8 +119,13 @@ func GetCmdVote(cdc *codec.Codec) *cobra.Command {
 				return fmt.Errorf("proposal-id %s not a valid int, please input a valid proposal-id", args[0])
 			}
 
+			option := types.NewVoteOptionFromString(args[1])
+			if !option.IsValid() {
+				return fmt.Errorf("option %s not a valid vote option, please input yes, no or abstain", args[1])
+			}
+
 			// Build vote message and run basic validation
-			msg := types.NewMsgVote(from, proposalID)
+			msg := types.NewMsgVote(from, proposalID, option)
 			err = msg.ValidateBasic()
 			if err != nil {
 				return err
@@ -136,15 +141,18 @@ func GetGovCmdSubmitProposal(cdc *codec.Codec) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "committee [proposal-file] [deposit]",
 		Short: "Submit a governance proposal to change a committee.",
-		Long: fmt.Sprintf(`Submit a governance proposal to create, alter, or delete a committee.
+		Long: fmt.Sprintf(`Submit a governance proposal to create, alter, or delete a committee, or to cancel a queued committee proposal.
 
 The proposal file must be the json encoded form of the proposal type you want to submit.
 For example, to create or update a committee:
 %s
 
-and to delete a committee:
+to delete a committee:
 %s
-`, MustGetExampleCommitteeChangeProposal(cdc), MustGetExampleCommitteeDeleteProposal(cdc)),
+
+and to cancel a committee proposal that is queued for enactment:
+%s
+`, MustGetExampleCommitteeChangeProposal(cdc), MustGetExampleCommitteeDeleteProposal(cdc), MustGetExampleCommitteeCancelProposal(cdc)),
 		Args: cobra.ExactArgs(2),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			inBuf := bufio.NewReader(cmd.InOrStdin())
@@ -203,6 +211,7 @@ func MustGetExampleCommitteeChangeProposal(cdc *codec.Codec) string {
 			},
 			sdk.MustNewDecFromStr("0.8"),
 			time.Hour*24*7,
+			0,
 		),
 	)
 	exampleChangeProposalBz, err := cdc.MarshalJSONIndent(exampleChangeProposal, "", "  ")
@@ -226,6 +235,20 @@ func MustGetExampleCommitteeDeleteProposal(cdc *codec.Codec) string {
 	return string(exampleDeleteProposalBz)
 }
 
+// MustGetExampleCommitteeCancelProposal is a helper function to return an example json proposal
+func MustGetExampleCommitteeCancelProposal(cdc *codec.Codec) string {
+	exampleCancelProposal := types.NewCommitteeCancelProposal(
+		"A Title",
+		"A description of this proposal.",
+		1,
+	)
+	exampleCancelProposalBz, err := cdc.MarshalJSONIndent(exampleCancelProposal, "", "  ")
+	if err != nil {
+		panic(err)
+	}
+	return string(exampleCancelProposalBz)
+}
+
 // MustGetExampleParameterChangeProposal is a helper function to return an example json proposal
 func MustGetExampleParameterChangeProposal(cdc *codec.Codec) string {
 	exampleParameterChangeProposal := params.NewParameterChangeProposal(
@@ -23,8 +23,16 @@ func InitGenesis(ctx sdk.Context, keeper Keeper, gs GenesisState) {
 		keeper.SetProposal(ctx, p)
 	}
 	for _, v := range gs.Votes {
+		// votes exported before options were added are yes votes
+		v.Option = v.GetOption()
 		keeper.SetVote(ctx, v)
 	}
+	for _, vp := range gs.VotingPowers {
+		keeper.SetVotingPower(ctx, vp)
+	}
+	for _, pcr := range gs.ParamChanges {
+		keeper.SetParamChangeRecord(ctx, pcr)
+	}
 }
 
 // ExportGenesis returns a GenesisState for a given context and keeper.
@@ -37,11 +45,15 @@ func ExportGenesis(ctx sdk.Context, keeper Keeper) GenesisState {
 	committees := keeper.GetCommittees(ctx)
 	proposals := keeper.GetProposals(ctx)
 	votes := keeper.GetVotes(ctx)
+	votingPowers := keeper.GetVotingPowers(ctx)
+	paramChanges := keeper.GetParamChangeRecords(ctx)
 
 	return types.NewGenesisState(
 		nextID,
 		committees,
 		proposals,
 		votes,
+		votingPowers,
+		paramChanges,
 	)
 }
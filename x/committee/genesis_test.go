@@ -1,13 +1,16 @@
 package committee_test
 
 import (
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/suite"
 
 	abci "github.com/tendermint/tendermint/abci/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 
 	"github.com/kava-labs/kava/app"
 	"github.com/kava-labs/kava/x/committee"
@@ -40,6 +43,8 @@ func (suite *GenesisTestSuite) TestGenesis() {
 				[]types.Committee{},
 				[]types.Proposal{{ID: 1, CommitteeID: 57}},
 				[]types.Vote{},
+				[]types.VotingPower{},
+				[]types.ParamChangeRecord{},
 			),
 			expectPass: false,
 		},
@@ -71,6 +76,36 @@ func (suite *GenesisTestSuite) TestGenesis() {
 	}
 }
 
+func (suite *GenesisTestSuite) TestImportVotesWithoutOption() {
+	suite.app = app.NewTestApp()
+	suite.keeper = suite.app.GetCommitteeKeeper()
+	suite.ctx = suite.app.NewContext(true, abci.Header{})
+	_, addresses := app.GeneratePrivKeyAddressPairs(2)
+
+	com := types.NewCommittee(1, "This committee is for testing.", addresses, []types.Permission{types.TextPermission{}}, d("0.5"), time.Hour*24*7, 0)
+	genState := types.NewGenesisState(
+		2,
+		[]types.Committee{com},
+		[]types.Proposal{types.NewProposal(govtypes.NewTextProposal("A Title", "A description of this proposal."), 1, 1, time.Date(1998, time.January, 1, 0, 0, 0, 0, time.UTC))},
+		[]types.Vote{types.NewVote(1, addresses[0], types.OptionYes)},
+		[]types.VotingPower{},
+		[]types.ParamChangeRecord{},
+	)
+
+	// votes exported before options were added have no option field
+	bz := strings.Replace(string(types.ModuleCdc.MustMarshalJSON(genState)), `,"option":"Yes"`, "", 1)
+	suite.Require().NotContains(bz, `"option"`)
+	var importedGenState types.GenesisState
+	types.ModuleCdc.MustUnmarshalJSON([]byte(bz), &importedGenState)
+	suite.Require().Equal(types.OptionEmpty, importedGenState.Votes[0].Option)
+
+	suite.NotPanics(func() { committee.InitGenesis(suite.ctx, suite.keeper, importedGenState) })
+
+	vote, found := suite.keeper.GetVote(suite.ctx, 1, addresses[0])
+	suite.Require().True(found)
+	suite.Equal(types.OptionYes, vote.Option)
+}
+
 func TestGenesisTestSuite(t *testing.T) {
 	suite.Run(t, new(GenesisTestSuite))
 }
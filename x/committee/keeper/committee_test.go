@@ -174,6 +174,7 @@ func (suite *TypesTestSuite) TestCommittee_HasPermissionsFor() {
 				tc.permissions,
 				d("0.5"),
 				24*time.Hour,
+				0,
 			)
 			suite.Equal(
 				tc.expectHasPermissions,
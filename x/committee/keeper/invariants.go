@@ -5,6 +5,7 @@ import (
 	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/supply"
 
 	"github.com/kava-labs/kava/x/committee/types"
 )
@@ -18,6 +19,8 @@ func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
 		ValidProposalsInvariant(k))
 	ir.RegisterRoute(types.ModuleName, "valid-votes",
 		ValidVotesInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "escrowed-tokens",
+		EscrowedTokensInvariant(k))
 }
 
 // ValidCommitteesInvariant verifies that all committees in the store are independently valid
@@ -65,7 +68,13 @@ func ValidProposalsInvariant(k Keeper) sdk.Invariant {
 
 			currentTime := ctx.BlockTime()
 			if !currentTime.Equal(time.Time{}) { // this avoids a simulator bug where app.InitGenesis is called with blockTime=0 instead of the correct time
-				if proposal.Deadline.Before(currentTime) {
+				// queued proposals can outlive their deadline, but must be enacted once their enactment time is reached
+				if proposal.IsQueued() {
+					if proposal.EnactmentTime.Before(currentTime) {
+						validationErr = fmt.Errorf("enactment time before current block time %s", currentTime)
+						return true
+					}
+				} else if proposal.Deadline.Before(currentTime) {
 					validationErr = fmt.Errorf("deadline after current block time %s", currentTime)
 					return true
 				}
@@ -118,7 +127,8 @@ func ValidVotesInvariant(k Keeper) sdk.Invariant {
 				validationErr = fmt.Errorf("vote's proposal has no committee %d", proposal.CommitteeID)
 				return true
 			}
-			if !com.HasMember(vote.Voter) {
+			// token committee voters can lose their voting power after voting, so only members are checked
+			if !com.IsTokenCommittee() && !com.HasMember(vote.Voter) {
 				validationErr = fmt.Errorf("voter is not a member of committee %+v", com)
 				return true
 			}
@@ -138,3 +148,32 @@ func ValidVotesInvariant(k Keeper) sdk.Invariant {
 		return invariantMessage, broken
 	}
 }
+
+// EscrowedTokensInvariant verifies that the module account holds the tokens escrowed by token committee voters
+func EscrowedTokensInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+
+		escrowed := sdk.NewCoins()
+		k.IterateVotingPowers(ctx, func(votingPower types.VotingPower) bool {
+			escrowed = escrowed.Add(votingPower.Amount)
+			return false
+		})
+
+		held := sdk.NewCoins()
+		if acc := k.accountKeeper.GetAccount(ctx, supply.NewModuleAddress(types.ModuleName)); acc != nil {
+			held = acc.GetCoins()
+		}
+
+		broken := !held.IsAllGTE(escrowed)
+		invariantMessage := sdk.FormatInvariant(
+			types.ModuleName,
+			"escrowed tokens",
+			fmt.Sprintf(
+				"\tmodule account holds less than the escrowed tokens\n"+
+					"\theld:\t%s\n"+
+					"\tescrowed:\t%s\n",
+				held, escrowed),
+		)
+		return invariantMessage, broken
+	}
+}
@@ -1,6 +1,7 @@
 package keeper
 
 import (
+	"fmt"
 	"time"
 
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -9,6 +10,8 @@ import (
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 
+	"github.com/tendermint/tendermint/libs/log"
+
 	"github.com/kava-labs/kava/x/committee/types"
 )
 
@@ -16,25 +19,36 @@ type Keeper struct {
 	cdc      *codec.Codec
 	storeKey sdk.StoreKey
 
-	ParamKeeper types.ParamKeeper // TODO ideally don't export, only sims need it exported
+	ParamKeeper   types.ParamKeeper // TODO ideally don't export, only sims need it exported
+	accountKeeper types.AccountKeeper
+	stakingKeeper types.StakingKeeper
+	supplyKeeper  types.SupplyKeeper
 
 	// Proposal router
 	router govtypes.Router
 }
 
-func NewKeeper(cdc *codec.Codec, storeKey sdk.StoreKey, router govtypes.Router, paramKeeper types.ParamKeeper) Keeper {
+func NewKeeper(cdc *codec.Codec, storeKey sdk.StoreKey, router govtypes.Router, paramKeeper types.ParamKeeper, ak types.AccountKeeper, sk types.StakingKeeper, supk types.SupplyKeeper) Keeper {
 	// Logic in the keeper methods assume the set of gov handlers is fixed.
 	// So the gov router must be sealed so no handlers can be added or removed after the keeper is created.
 	router.Seal()
 
 	return Keeper{
-		cdc:         cdc,
-		storeKey:    storeKey,
-		ParamKeeper: paramKeeper,
-		router:      router,
+		cdc:           cdc,
+		storeKey:      storeKey,
+		ParamKeeper:   paramKeeper,
+		accountKeeper: ak,
+		stakingKeeper: sk,
+		supplyKeeper:  supk,
+		router:        router,
 	}
 }
 
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
+}
+
 // ------------------------------------------
 //				Committees
 // ------------------------------------------
@@ -205,15 +219,23 @@ func (k Keeper) GetProposalsByCommittee(ctx sdk.Context, committeeID uint64) []t
 	return results
 }
 
-// DeleteProposalAndVotes removes a proposal and its associated votes.
+// DeleteProposalAndVotes removes a proposal and its associated votes, returning any tokens escrowed to vote on it.
 func (k Keeper) DeleteProposalAndVotes(ctx sdk.Context, proposalID uint64) {
 
 	votes := k.GetVotesByProposal(ctx, proposalID)
+	votingPowers := k.GetVotingPowersByProposal(ctx, proposalID)
 
 	k.DeleteProposal(ctx, proposalID)
 	for _, v := range votes {
 		k.DeleteVote(ctx, v.ProposalID, v.Voter)
 	}
+	for _, vp := range votingPowers {
+		// this runs in the begin blocker, so failed refunds are logged rather than halting the chain
+		if err := k.supplyKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, vp.Holder, sdk.NewCoins(vp.Amount)); err != nil {
+			k.Logger(ctx).Error(fmt.Sprintf("could not return %s escrowed on proposal %d to %s: %s", vp.Amount, vp.ProposalID, vp.Holder, err))
+		}
+		k.DeleteVotingPower(ctx, vp.ProposalID, vp.Holder)
+	}
 }
 
 // ------------------------------------------
@@ -285,3 +307,137 @@ func (k Keeper) GetVotesByProposal(ctx sdk.Context, proposalID uint64) []types.V
 
 	return results
 }
+
+// ------------------------------------------
+//				Voting Powers
+// ------------------------------------------
+
+// GetVotingPower gets the tokens an address has escrowed to vote on a proposal from the store.
+func (k Keeper) GetVotingPower(ctx sdk.Context, proposalID uint64, holder sdk.AccAddress) (types.VotingPower, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.VotingPowerKeyPrefix)
+	bz := store.Get(types.GetVotingPowerKey(proposalID, holder))
+	if bz == nil {
+		return types.VotingPower{}, false
+	}
+	var votingPower types.VotingPower
+	k.cdc.MustUnmarshalBinaryBare(bz, &votingPower)
+	return votingPower, true
+}
+
+// SetVotingPower puts a voting power record into the store.
+func (k Keeper) SetVotingPower(ctx sdk.Context, votingPower types.VotingPower) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.VotingPowerKeyPrefix)
+	bz := k.cdc.MustMarshalBinaryBare(votingPower)
+	store.Set(types.GetVotingPowerKey(votingPower.ProposalID, votingPower.Holder), bz)
+}
+
+// DeleteVotingPower removes a voting power record from the store. It does not return the escrowed tokens.
+func (k Keeper) DeleteVotingPower(ctx sdk.Context, proposalID uint64, holder sdk.AccAddress) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.VotingPowerKeyPrefix)
+	store.Delete(types.GetVotingPowerKey(proposalID, holder))
+}
+
+// IterateVotingPowers provides an iterator over all stored voting power records.
+// For each voting power, cb will be called. If cb returns true, the iterator will close and stop.
+func (k Keeper) IterateVotingPowers(ctx sdk.Context, cb func(votingPower types.VotingPower) (stop bool)) {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.VotingPowerKeyPrefix)
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var votingPower types.VotingPower
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &votingPower)
+
+		if cb(votingPower) {
+			break
+		}
+	}
+}
+
+// GetVotingPowers returns all stored voting power records.
+func (k Keeper) GetVotingPowers(ctx sdk.Context) []types.VotingPower {
+	results := []types.VotingPower{}
+	k.IterateVotingPowers(ctx, func(votingPower types.VotingPower) bool {
+		results = append(results, votingPower)
+		return false
+	})
+	return results
+}
+
+// GetVotingPowersByProposal returns all voting power records for one proposal.
+func (k Keeper) GetVotingPowersByProposal(ctx sdk.Context, proposalID uint64) []types.VotingPower {
+	results := []types.VotingPower{}
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), append(types.VotingPowerKeyPrefix, types.GetKeyFromID(proposalID)...))
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var votingPower types.VotingPower
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &votingPower)
+		results = append(results, votingPower)
+	}
+
+	return results
+}
+
+// ------------------------------------------
+//				Param Changes
+// ------------------------------------------
+
+// GetParamChangeRecord gets the record of when committees last changed a param.
+func (k Keeper) GetParamChangeRecord(ctx sdk.Context, subspace, key string) (types.ParamChangeRecord, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ParamChangeRecordKeyPrefix)
+	bz := store.Get(types.GetParamChangeRecordKey(subspace, key))
+	if bz == nil {
+		return types.ParamChangeRecord{}, false
+	}
+	var record types.ParamChangeRecord
+	k.cdc.MustUnmarshalBinaryBare(bz, &record)
+	return record, true
+}
+
+// SetParamChangeRecord puts a param change record into the store.
+func (k Keeper) SetParamChangeRecord(ctx sdk.Context, record types.ParamChangeRecord) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ParamChangeRecordKeyPrefix)
+	bz := k.cdc.MustMarshalBinaryBare(record)
+	store.Set(types.GetParamChangeRecordKey(record.Subspace, record.Key), bz)
+}
+
+// IterateParamChangeRecords provides an iterator over all stored param change records.
+// For each record, cb will be called. If cb returns true, the iterator will close and stop.
+func (k Keeper) IterateParamChangeRecords(ctx sdk.Context, cb func(record types.ParamChangeRecord) (stop bool)) {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.ParamChangeRecordKeyPrefix)
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var record types.ParamChangeRecord
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &record)
+
+		if cb(record) {
+			break
+		}
+	}
+}
+
+// GetParamChangeRecords returns all stored param change records.
+func (k Keeper) GetParamChangeRecords(ctx sdk.Context) []types.ParamChangeRecord {
+	results := []types.ParamChangeRecord{}
+	k.IterateParamChangeRecords(ctx, func(record types.ParamChangeRecord) bool {
+		results = append(results, record)
+		return false
+	})
+	return results
+}
+
+// paramKeeperWithHistory wraps the param keeper so permissions can look up when params were last changed.
+type paramKeeperWithHistory struct {
+	types.ParamKeeper
+	keeper Keeper
+}
+
+func (pk paramKeeperWithHistory) GetParamChangeRecord(ctx sdk.Context, subspace, key string) (types.ParamChangeRecord, bool) {
+	return pk.keeper.GetParamChangeRecord(ctx, subspace, key)
+}
+
+// permissionParamKeeper returns the param keeper passed to permissions when checking proposals.
+func (k Keeper) permissionParamKeeper() types.ParamKeeper {
+	return paramKeeperWithHistory{ParamKeeper: k.ParamKeeper, keeper: k}
+}
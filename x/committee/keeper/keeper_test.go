@@ -90,6 +90,7 @@ func (suite *KeeperTestSuite) TestGetSetDeleteVote() {
 	vote := types.Vote{
 		ProposalID: 12,
 		Voter:      suite.addresses[0],
+		Option:     types.OptionYes,
 	}
 
 	// write and read from store
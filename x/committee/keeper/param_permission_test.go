@@ -2,6 +2,7 @@ package keeper_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -11,9 +12,12 @@ import (
 	abci "github.com/tendermint/tendermint/abci/types"
 
 	"github.com/kava-labs/kava/app"
+	auctiontypes "github.com/kava-labs/kava/x/auction/types"
 	bep3types "github.com/kava-labs/kava/x/bep3/types"
 	cdptypes "github.com/kava-labs/kava/x/cdp/types"
 	"github.com/kava-labs/kava/x/committee/types"
+	incentivetypes "github.com/kava-labs/kava/x/incentive/types"
+	kavadisttypes "github.com/kava-labs/kava/x/kavadist/types"
 	pricefeedtypes "github.com/kava-labs/kava/x/pricefeed/types"
 )
 
@@ -77,16 +81,24 @@ func (suite *PermissionTestSuite) TestSubParamChangePermission_Allows() {
 	// bep3 Asset Params
 	testAPs := bep3types.AssetParams{
 		{
-			Denom:  "bnb",
-			CoinID: 714,
-			Limit:  i(100000000000),
-			Active: true,
+			Denom:           "bnb",
+			CoinID:          714,
+			Limit:           i(100000000000),
+			Active:          true,
+			ChainID:         bep3types.DefaultChainID,
+			AddressFormat:   bep3types.AddressFormatBech32,
+			AddressPrefix:   "bnb",
+			TimeWindowLimit: i(0),
 		},
 		{
-			Denom:  "inc",
-			CoinID: 9999,
-			Limit:  i(100),
-			Active: false,
+			Denom:           "inc",
+			CoinID:          9999,
+			Limit:           i(100),
+			Active:          false,
+			ChainID:         bep3types.DefaultChainID,
+			AddressFormat:   bep3types.AddressFormatBech32,
+			AddressPrefix:   "bnb",
+			TimeWindowLimit: i(0),
 		},
 	}
 	testAPsUpdatedActive := make(bep3types.AssetParams, len(testAPs))
@@ -103,14 +115,14 @@ func (suite *PermissionTestSuite) TestSubParamChangePermission_Allows() {
 			MarketID:   "bnb:usd",
 			BaseAsset:  "bnb",
 			QuoteAsset: "usd",
-			Oracles:    []sdk.AccAddress{},
+			Oracles:    []sdk.AccAddress{pricefeedOracle},
 			Active:     true,
 		},
 		{
 			MarketID:   "btc:usd",
 			BaseAsset:  "btc",
 			QuoteAsset: "usd",
-			Oracles:    []sdk.AccAddress{},
+			Oracles:    []sdk.AccAddress{pricefeedOracle},
 			Active:     true,
 		},
 	}
@@ -205,6 +217,178 @@ func (suite *PermissionTestSuite) TestSubParamChangePermission_Allows() {
 			),
 			expectAllowed: true,
 		},
+		{
+			name:     "auction params",
+			genState: []app.GenesisState{},
+			permission: types.SubParamChangePermission{
+				AllowedParams: types.AllowedParams{
+					{Subspace: auctiontypes.ModuleName, Key: string(auctiontypes.KeyBidDurationDebt)},
+					{Subspace: auctiontypes.ModuleName, Key: string(auctiontypes.KeyIncrementDebt)},
+				},
+				AllowedAuctionParams: types.AllowedAuctionParams{
+					BidDurationDebt: true,
+				},
+			},
+			pubProposal: paramstypes.NewParameterChangeProposal(
+				"A Title",
+				"A description for this proposal.",
+				[]paramstypes.ParamChange{
+					{
+						Subspace: auctiontypes.ModuleName,
+						Key:      string(auctiontypes.KeyBidDurationDebt),
+						Value:    string(suite.cdc.MustMarshalJSON(30 * time.Minute)),
+					},
+					{ // unchanged value
+						Subspace: auctiontypes.ModuleName,
+						Key:      string(auctiontypes.KeyIncrementDebt),
+						Value:    string(suite.cdc.MustMarshalJSON(auctiontypes.DefaultIncrement)),
+					},
+				},
+			),
+			expectAllowed: true,
+		},
+		{
+			name:     "not allowed (auction param change)",
+			genState: []app.GenesisState{},
+			permission: types.SubParamChangePermission{
+				AllowedParams: types.AllowedParams{
+					{Subspace: auctiontypes.ModuleName, Key: string(auctiontypes.KeyBidDurationDebt)},
+					{Subspace: auctiontypes.ModuleName, Key: string(auctiontypes.KeyIncrementDebt)},
+				},
+				AllowedAuctionParams: types.AllowedAuctionParams{
+					BidDurationDebt: true,
+				},
+			},
+			pubProposal: paramstypes.NewParameterChangeProposal(
+				"A Title",
+				"A description for this proposal.",
+				[]paramstypes.ParamChange{
+					{
+						Subspace: auctiontypes.ModuleName,
+						Key:      string(auctiontypes.KeyIncrementDebt),
+						Value:    string(suite.cdc.MustMarshalJSON(d("0.5"))),
+					},
+				},
+			),
+			expectAllowed: false,
+		},
+		{
+			name:     "not allowed (auction param change without allowed auction params)",
+			genState: []app.GenesisState{},
+			permission: types.SubParamChangePermission{
+				AllowedParams: types.AllowedParams{
+					{Subspace: auctiontypes.ModuleName, Key: string(auctiontypes.KeyIncrementDebt)},
+				},
+			},
+			pubProposal: paramstypes.NewParameterChangeProposal(
+				"A Title",
+				"A description for this proposal.",
+				[]paramstypes.ParamChange{
+					{
+						Subspace: auctiontypes.ModuleName,
+						Key:      string(auctiontypes.KeyIncrementDebt),
+						Value:    string(suite.cdc.MustMarshalJSON(d("0.5"))),
+					},
+				},
+			),
+			expectAllowed: false,
+		},
+		{
+			name:     "not allowed (collateral auction param change without allowed collateral auction params)",
+			genState: []app.GenesisState{},
+			permission: types.SubParamChangePermission{
+				AllowedParams: types.AllowedParams{
+					{Subspace: auctiontypes.ModuleName, Key: string(auctiontypes.KeyCollateralAuctionParams)},
+				},
+			},
+			pubProposal: paramstypes.NewParameterChangeProposal(
+				"A Title",
+				"A description for this proposal.",
+				[]paramstypes.ParamChange{
+					{
+						Subspace: auctiontypes.ModuleName,
+						Key:      string(auctiontypes.KeyCollateralAuctionParams),
+						Value: string(suite.cdc.MustMarshalJSON(auctiontypes.CollateralAuctionParams{
+							auctiontypes.NewCollateralAuctionParam("bnb", 6*time.Hour, 10*time.Minute, d("0.01")),
+						})),
+					},
+				},
+			),
+			expectAllowed: false,
+		},
+		{
+			name:     "not allowed (reward change without allowed rewards)",
+			genState: []app.GenesisState{},
+			permission: types.SubParamChangePermission{
+				AllowedParams: types.AllowedParams{
+					{Subspace: incentivetypes.ModuleName, Key: string(incentivetypes.KeyRewards)},
+				},
+			},
+			pubProposal: paramstypes.NewParameterChangeProposal(
+				"A Title",
+				"A description for this proposal.",
+				[]paramstypes.ParamChange{
+					{
+						Subspace: incentivetypes.ModuleName,
+						Key:      string(incentivetypes.KeyRewards),
+						Value: string(suite.cdc.MustMarshalJSON(incentivetypes.Rewards{
+							incentivetypes.NewReward(true, "bnb", c("ukava", 1000), time.Hour, time.Hour, time.Hour),
+						})),
+					},
+				},
+			),
+			expectAllowed: false,
+		},
+		{
+			name:     "not allowed (period change without allowed periods)",
+			genState: []app.GenesisState{},
+			permission: types.SubParamChangePermission{
+				AllowedParams: types.AllowedParams{
+					{Subspace: kavadisttypes.ModuleName, Key: string(kavadisttypes.KeyPeriods)},
+				},
+			},
+			pubProposal: paramstypes.NewParameterChangeProposal(
+				"A Title",
+				"A description for this proposal.",
+				[]paramstypes.ParamChange{
+					{
+						Subspace: kavadisttypes.ModuleName,
+						Key:      string(kavadisttypes.KeyPeriods),
+						Value: string(suite.cdc.MustMarshalJSON(kavadisttypes.Periods{
+							kavadisttypes.NewPeriod(time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC), time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC), d("1.000000003")),
+						})),
+					},
+				},
+			),
+			expectAllowed: false,
+		},
+		{
+			name:     "unchanged params without allowed lists",
+			genState: []app.GenesisState{},
+			permission: types.SubParamChangePermission{
+				AllowedParams: types.AllowedParams{
+					{Subspace: incentivetypes.ModuleName, Key: string(incentivetypes.KeyRewards)},
+					{Subspace: kavadisttypes.ModuleName, Key: string(kavadisttypes.KeyPeriods)},
+				},
+			},
+			pubProposal: paramstypes.NewParameterChangeProposal(
+				"A Title",
+				"A description for this proposal.",
+				[]paramstypes.ParamChange{
+					{
+						Subspace: incentivetypes.ModuleName,
+						Key:      string(incentivetypes.KeyRewards),
+						Value:    string(suite.cdc.MustMarshalJSON(incentivetypes.Rewards{})),
+					},
+					{
+						Subspace: kavadisttypes.ModuleName,
+						Key:      string(kavadisttypes.KeyPeriods),
+						Value:    string(suite.cdc.MustMarshalJSON(kavadisttypes.Periods{})),
+					},
+				},
+			),
+			expectAllowed: true,
+		},
 		{
 			name:          "not allowed (wrong pubproposal type)",
 			permission:    types.SubParamChangePermission{},
@@ -234,6 +418,109 @@ func (suite *PermissionTestSuite) TestSubParamChangePermission_Allows() {
 	}
 
 }
+
+func (suite *PermissionTestSuite) TestSubParamChangePermission_AllowsUnsetAuctionParams() {
+	// auction params are unset until the auction genesis or a param change sets them, as on upgraded chains
+	tApp := app.NewTestApp()
+	ctx := tApp.NewContext(true, abci.Header{})
+	permission := types.SubParamChangePermission{
+		AllowedParams: types.AllowedParams{
+			{Subspace: auctiontypes.ModuleName, Key: string(auctiontypes.KeyCollateralAuctionParams)},
+			{Subspace: auctiontypes.ModuleName, Key: string(auctiontypes.KeyIncrementDebt)},
+		},
+		AllowedCollateralAuctionParams: types.AllowedCollateralAuctionParams{
+			{Denom: "bnb", Increment: true},
+		},
+		AllowedAuctionParams: types.AllowedAuctionParams{IncrementDebt: true},
+	}
+	newProposal := func(caps auctiontypes.CollateralAuctionParams) paramstypes.ParameterChangeProposal {
+		return paramstypes.NewParameterChangeProposal(
+			"A Title",
+			"A description for this proposal.",
+			[]paramstypes.ParamChange{
+				{
+					Subspace: auctiontypes.ModuleName,
+					Key:      string(auctiontypes.KeyCollateralAuctionParams),
+					Value:    string(suite.cdc.MustMarshalJSON(caps)),
+				},
+				{
+					Subspace: auctiontypes.ModuleName,
+					Key:      string(auctiontypes.KeyIncrementDebt),
+					Value:    string(suite.cdc.MustMarshalJSON(d("0.5"))),
+				},
+			},
+		)
+	}
+
+	suite.NotPanics(func() {
+		// unset collateral auction params are treated as an empty list, which cannot be added to
+		suite.True(permission.Allows(ctx, tApp.Codec(), tApp.GetParamsKeeper(), newProposal(auctiontypes.CollateralAuctionParams{})))
+		suite.False(permission.Allows(ctx, tApp.Codec(), tApp.GetParamsKeeper(), newProposal(auctiontypes.CollateralAuctionParams{
+			auctiontypes.NewCollateralAuctionParam("bnb", 6*time.Hour, 10*time.Minute, d("0.01")),
+		})))
+	})
+}
+
+func (suite *PermissionTestSuite) TestParamChangeRecordKeys() {
+	testCDPParams := cdptypes.DefaultParams()
+	testCDPParams.CollateralParams = cdptypes.CollateralParams{
+		{
+			Denom:              "bnb",
+			LiquidationRatio:   d("2.0"),
+			DebtLimit:          c("usdx", 1000000000000),
+			StabilityFee:       d("1.000000001547125958"),
+			LiquidationPenalty: d("0.05"),
+			AuctionSize:        i(100),
+			Prefix:             0x20,
+			ConversionFactor:   i(6),
+			MarketID:           "bnb:usd",
+		},
+		{
+			Denom:              "btc",
+			LiquidationRatio:   d("1.5"),
+			DebtLimit:          c("usdx", 1000000000),
+			StabilityFee:       d("1.000000001547125958"),
+			LiquidationPenalty: d("0.10"),
+			AuctionSize:        i(1000),
+			Prefix:             0x30,
+			ConversionFactor:   i(8),
+			MarketID:           "btc:usd",
+		},
+	}
+	testCDPParams.GlobalDebtLimit = c("usdx", 1001000000000)
+
+	incomingCPs := make(cdptypes.CollateralParams, len(testCDPParams.CollateralParams))
+	copy(incomingCPs, testCDPParams.CollateralParams)
+	incomingCPs[0].StabilityFee = d("1.000000001")
+	incomingCPs[1].DebtLimit = c("usdx", 2000000000)
+	incomingCPs[1].AuctionSize = i(2000)
+	incomingCPs[1].MarketID = "btc:usd:30"
+
+	tApp := app.NewTestApp()
+	ctx := tApp.NewContext(true, abci.Header{})
+	tApp.InitializeFromGenesisStates(
+		newPricefeedGenState([]string{"bnb", "btc"}, []sdk.Dec{d("15.01"), d("9500")}),
+		newCDPGenesisState(testCDPParams),
+	)
+
+	keys := types.ParamChangeRecordKeys(ctx, tApp.Codec(), tApp.GetParamsKeeper(), paramstypes.ParamChange{
+		Subspace: cdptypes.ModuleName,
+		Key:      string(cdptypes.KeyCollateralParams),
+		Value:    string(suite.cdc.MustMarshalJSON(incomingCPs)),
+	})
+	suite.Equal(
+		[]string{"CollateralParams", "CollateralParams/bnb/StabilityFee", "CollateralParams/btc/DebtLimit", "CollateralParams/btc/AuctionSize"},
+		keys,
+	)
+
+	keys = types.ParamChangeRecordKeys(ctx, tApp.Codec(), tApp.GetParamsKeeper(), paramstypes.ParamChange{
+		Subspace: kavadisttypes.ModuleName,
+		Key:      string(kavadisttypes.KeyActive),
+		Value:    "true",
+	})
+	suite.Equal([]string{string(kavadisttypes.KeyActive)}, keys)
+}
+
 func TestPermissionTestSuite(t *testing.T) {
 	suite.Run(t, new(PermissionTestSuite))
 }
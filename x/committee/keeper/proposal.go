@@ -2,26 +2,33 @@ package keeper
 
 import (
 	"fmt"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	stakingexported "github.com/cosmos/cosmos-sdk/x/staking/exported"
 
 	"github.com/kava-labs/kava/x/committee/types"
 )
 
 // SubmitProposal adds a proposal to a committee so that it can be voted on.
 func (k Keeper) SubmitProposal(ctx sdk.Context, proposer sdk.AccAddress, committeeID uint64, pubProposal types.PubProposal) (uint64, error) {
-	// Limit proposals to only be submitted by committee members
+	// Limit proposals to only be submitted by committee members, or by token holders for token committees
 	com, found := k.GetCommittee(ctx, committeeID)
 	if !found {
 		return 0, sdkerrors.Wrapf(types.ErrUnknownCommittee, "%d", committeeID)
 	}
-	if !com.HasMember(proposer) {
+	if com.IsTokenCommittee() {
+		if !k.getTokenVotingPower(ctx, *com.TokenTally, proposer).IsPositive() {
+			return 0, sdkerrors.Wrapf(types.ErrNoVotingPower, "proposer %s", proposer)
+		}
+	} else if !com.HasMember(proposer) {
 		return 0, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "proposer not member of committee")
 	}
 
 	// Check committee has permissions to enact proposal.
-	if !com.HasPermissionsFor(ctx, k.cdc, k.ParamKeeper, pubProposal) {
+	if !com.HasPermissionsFor(ctx, k.cdc, k.permissionParamKeeper(), pubProposal) {
 		return 0, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "committee does not have permissions to enact proposal")
 	}
 
@@ -48,12 +55,18 @@ func (k Keeper) SubmitProposal(ctx sdk.Context, proposer sdk.AccAddress, committ
 }
 
 // AddVote submits a vote on a proposal.
-func (k Keeper) AddVote(ctx sdk.Context, proposalID uint64, voter sdk.AccAddress) error {
+func (k Keeper) AddVote(ctx sdk.Context, proposalID uint64, voter sdk.AccAddress, option types.VoteOption) error {
 	// Validate
+	if !option.IsValid() {
+		return sdkerrors.Wrapf(types.ErrInvalidVoteOption, "%s", option)
+	}
 	pr, found := k.GetProposal(ctx, proposalID)
 	if !found {
 		return sdkerrors.Wrapf(types.ErrUnknownProposal, "%d", proposalID)
 	}
+	if pr.IsQueued() {
+		return sdkerrors.Wrapf(types.ErrProposalQueued, "%d", proposalID)
+	}
 	if pr.HasExpiredBy(ctx.BlockTime()) {
 		return sdkerrors.Wrapf(types.ErrProposalExpired, "%s ≥ %s", ctx.BlockTime(), pr.Deadline)
 
@@ -62,12 +75,22 @@ func (k Keeper) AddVote(ctx sdk.Context, proposalID uint64, voter sdk.AccAddress
 	if !found {
 		return sdkerrors.Wrapf(types.ErrUnknownCommittee, "%d", pr.CommitteeID)
 	}
-	if !com.HasMember(voter) {
+	if com.IsTokenCommittee() {
+		// Escrow the voter's tokens so they can't be moved between accounts to vote more than once
+		if com.TokenTally.Source == types.SourceBalance {
+			if err := k.escrowVotingPower(ctx, proposalID, voter, com.TokenTally.Denom); err != nil {
+				return err
+			}
+		}
+		if !k.GetVoteWeight(ctx, com, proposalID, voter).IsPositive() {
+			return sdkerrors.Wrapf(types.ErrNoVotingPower, "voter %s", voter)
+		}
+	} else if !com.HasMember(voter) {
 		return sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "voter must be a member of committee")
 	}
 
 	// Store vote, overwriting any prior vote
-	k.SetVote(ctx, types.NewVote(proposalID, voter))
+	k.SetVote(ctx, types.NewVote(proposalID, voter, option))
 
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
@@ -75,6 +98,7 @@ func (k Keeper) AddVote(ctx sdk.Context, proposalID uint64, voter sdk.AccAddress
 			sdk.NewAttribute(types.AttributeKeyCommitteeID, fmt.Sprintf("%d", com.ID)),
 			sdk.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", pr.ID)),
 			sdk.NewAttribute(types.AttributeKeyVoter, voter.String()),
+			sdk.NewAttribute(types.AttributeKeyVoteOption, option.String()),
 		),
 	)
 	return nil
@@ -82,28 +106,121 @@ func (k Keeper) AddVote(ctx sdk.Context, proposalID uint64, voter sdk.AccAddress
 
 // GetProposalResult calculates if a proposal currently has enough votes to pass.
 func (k Keeper) GetProposalResult(ctx sdk.Context, proposalID uint64) (bool, error) {
+	com, tally, err := k.tallyVotes(ctx, proposalID)
+	if err != nil {
+		return false, err
+	}
+	return com.Passes(tally), nil
+}
+
+// TallyVotes counts the votes cast for each option on a proposal, weighted by each voter's voting power.
+func (k Keeper) TallyVotes(ctx sdk.Context, proposalID uint64) (types.TallyResult, error) {
+	_, tally, err := k.tallyVotes(ctx, proposalID)
+	return tally, err
+}
+
+func (k Keeper) tallyVotes(ctx sdk.Context, proposalID uint64) (types.Committee, types.TallyResult, error) {
 	pr, found := k.GetProposal(ctx, proposalID)
 	if !found {
-		return false, sdkerrors.Wrapf(types.ErrUnknownProposal, "%d", proposalID)
+		return types.Committee{}, types.TallyResult{}, sdkerrors.Wrapf(types.ErrUnknownProposal, "%d", proposalID)
 	}
 	com, found := k.GetCommittee(ctx, pr.CommitteeID)
 	if !found {
-		return false, sdkerrors.Wrapf(types.ErrUnknownCommittee, "%d", pr.CommitteeID)
+		return types.Committee{}, types.TallyResult{}, sdkerrors.Wrapf(types.ErrUnknownCommittee, "%d", pr.CommitteeID)
 	}
 
-	numVotes := k.TallyVotes(ctx, proposalID)
+	yes, no, abstain := sdk.ZeroInt(), sdk.ZeroInt(), sdk.ZeroInt()
+	for _, vote := range k.GetVotesByProposal(ctx, proposalID) {
+		weight := k.GetVoteWeight(ctx, com, proposalID, vote.Voter)
+		switch vote.GetOption() {
+		case types.OptionYes:
+			yes = yes.Add(weight)
+		case types.OptionNo:
+			no = no.Add(weight)
+		case types.OptionAbstain:
+			abstain = abstain.Add(weight)
+		}
+	}
 
-	proposalResult := sdk.NewDec(numVotes).GTE(com.VoteThreshold.MulInt64(int64(len(com.Members))))
+	quorum := sdk.ZeroDec()
+	if com.IsTokenCommittee() {
+		quorum = com.TokenTally.Quorum
+	}
+	tally := types.NewTallyResult(proposalID, yes, no, abstain, k.GetPossibleVotes(ctx, com, proposalID), com.VoteThreshold, quorum)
+	return com, tally, nil
+}
 
-	return proposalResult, nil
+// GetVoteWeight returns how many votes an address can cast on a proposal.
+// Committee members have one vote each, while token committee votes are weighted by voting power.
+func (k Keeper) GetVoteWeight(ctx sdk.Context, com types.Committee, proposalID uint64, voter sdk.AccAddress) sdk.Int {
+	if !com.IsTokenCommittee() {
+		if com.HasMember(voter) {
+			return sdk.OneInt()
+		}
+		return sdk.ZeroInt()
+	}
+	if com.TokenTally.Source == types.SourceBalance {
+		votingPower, found := k.GetVotingPower(ctx, proposalID, voter)
+		if !found {
+			return sdk.ZeroInt()
+		}
+		return votingPower.Amount.Amount
+	}
+	return k.getTokenVotingPower(ctx, *com.TokenTally, voter)
 }
 
-// TallyVotes counts all the votes on a proposal
-func (k Keeper) TallyVotes(ctx sdk.Context, proposalID uint64) int64 {
+// GetPossibleVotes returns the total number of votes that could be cast on a proposal.
+func (k Keeper) GetPossibleVotes(ctx sdk.Context, com types.Committee, proposalID uint64) sdk.Int {
+	if !com.IsTokenCommittee() {
+		return sdk.NewInt(int64(len(com.Members)))
+	}
+	if com.TokenTally.Source == types.SourceBalance {
+		return k.supplyKeeper.GetSupply(ctx).GetTotal().AmountOf(com.TokenTally.Denom)
+	}
+	return k.stakingKeeper.TotalBondedTokens(ctx)
+}
 
-	votes := k.GetVotesByProposal(ctx, proposalID)
+// getTokenVotingPower returns an address's current voting power under a token tally.
+func (k Keeper) getTokenVotingPower(ctx sdk.Context, tokenTally types.TokenTally, addr sdk.AccAddress) sdk.Int {
+	switch tokenTally.Source {
+	case types.SourceStaked:
+		// only stake bonded to active validators counts, to match the chain's total bonded tokens
+		stake := sdk.ZeroDec()
+		k.stakingKeeper.IterateDelegations(ctx, addr, func(_ int64, delegation stakingexported.DelegationI) (stop bool) {
+			validator := k.stakingKeeper.Validator(ctx, delegation.GetValidatorAddr())
+			if validator != nil && validator.IsBonded() {
+				stake = stake.Add(validator.TokensFromShares(delegation.GetShares()))
+			}
+			return false
+		})
+		return stake.TruncateInt()
+	case types.SourceBalance:
+		acc := k.accountKeeper.GetAccount(ctx, addr)
+		if acc == nil {
+			return sdk.ZeroInt()
+		}
+		return acc.SpendableCoins(ctx.BlockTime()).AmountOf(tokenTally.Denom)
+	default:
+		return sdk.ZeroInt()
+	}
+}
 
-	return int64(len(votes))
+// escrowVotingPower moves a voter's spendable balance of a token into the module account, adding it to their voting power on a proposal.
+// Votes can be changed, so tokens received after a first vote can also be escrowed.
+func (k Keeper) escrowVotingPower(ctx sdk.Context, proposalID uint64, voter sdk.AccAddress, denom string) error {
+	balance := k.getTokenVotingPower(ctx, types.NewTokenTally(types.SourceBalance, denom, sdk.ZeroDec()), voter)
+	if !balance.IsPositive() {
+		return nil
+	}
+	amount := sdk.NewCoin(denom, balance)
+	if err := k.supplyKeeper.SendCoinsFromAccountToModule(ctx, voter, types.ModuleName, sdk.NewCoins(amount)); err != nil {
+		return err
+	}
+	if previous, found := k.GetVotingPower(ctx, proposalID, voter); found {
+		amount = amount.Add(previous.Amount)
+	}
+	k.SetVotingPower(ctx, types.NewVotingPower(proposalID, voter, amount))
+	return nil
 }
 
 // EnactProposal makes the changes proposed in a proposal.
@@ -114,7 +231,7 @@ func (k Keeper) EnactProposal(ctx sdk.Context, proposal types.Proposal) error {
 	if !found {
 		return sdkerrors.Wrapf(types.ErrUnknownCommittee, "%d", proposal.CommitteeID)
 	}
-	if !com.HasPermissionsFor(ctx, k.cdc, k.ParamKeeper, proposal.PubProposal) {
+	if !com.HasPermissionsFor(ctx, k.cdc, k.permissionParamKeeper(), proposal.PubProposal) {
 		return sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "committee does not have permissions to enact proposal")
 	}
 
@@ -122,30 +239,137 @@ func (k Keeper) EnactProposal(ctx sdk.Context, proposal types.Proposal) error {
 		return err
 	}
 
+	// find the keys to record param changes under before the changes are made, as some keys depend on the changed fields
+	var records []types.ParamChangeRecord
+	if paramProposal, ok := proposal.PubProposal.(paramstypes.ParameterChangeProposal); ok {
+		for _, change := range paramProposal.Changes {
+			for _, key := range types.ParamChangeRecordKeys(ctx, k.cdc, k.ParamKeeper, change) {
+				records = append(records, types.NewParamChangeRecord(change.Subspace, key, ctx.BlockTime()))
+			}
+		}
+	}
+
 	// enact the proposal
 	handler := k.router.GetRoute(proposal.ProposalRoute())
 	if err := handler(ctx, proposal.PubProposal); err != nil {
 		// the handler should not error as it was checked in ValidatePubProposal
 		panic(fmt.Sprintf("unexpected handler error: %s", err))
 	}
+
+	// record param changes so permissions can limit how often params are changed
+	for _, record := range records {
+		k.SetParamChangeRecord(ctx, record)
+	}
 	return nil
 }
 
-// EnactPassedProposals puts in place the changes proposed in any proposal that has enough votes
+// EnactPassedProposals puts in place the changes proposed in any proposal that has enough votes.
+// Proposals from committees with an enactment delay are queued when they pass, and enacted once the delay has elapsed.
 func (k Keeper) EnactPassedProposals(ctx sdk.Context) {
-	k.IterateProposals(ctx, func(proposal types.Proposal) bool {
+	// Proposals are collected before enacting as enacting one proposal can cancel another.
+	for _, proposal := range k.GetProposals(ctx) {
+		proposal, found := k.GetProposal(ctx, proposal.ID)
+		if !found {
+			continue
+		}
+
+		if proposal.IsQueued() {
+			if proposal.IsEnactableBy(ctx.BlockTime()) {
+				k.enactAndCloseProposal(ctx, proposal)
+			}
+			continue
+		}
+
 		passes, err := k.GetProposalResult(ctx, proposal.ID)
 		if err != nil {
 			panic(err)
 		}
 		if !passes {
-			return false
+			continue
 		}
 
-		err = k.EnactProposal(ctx, proposal)
-		outcome := types.AttributeValueProposalPassed
+		com, found := k.GetCommittee(ctx, proposal.CommitteeID)
+		if !found {
+			panic(fmt.Sprintf("committee %d not found for proposal %d", proposal.CommitteeID, proposal.ID))
+		}
+		if com.EnactmentDelay > 0 {
+			k.queueProposal(ctx, proposal, ctx.BlockTime().Add(com.EnactmentDelay))
+			continue
+		}
+		k.enactAndCloseProposal(ctx, proposal)
+	}
+}
+
+// queueProposal marks a passed proposal to be enacted at a later time. Queued proposals can no longer be voted on.
+func (k Keeper) queueProposal(ctx sdk.Context, proposal types.Proposal, enactmentTime time.Time) {
+	proposal.EnactmentTime = enactmentTime
+	k.SetProposal(ctx, proposal)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeProposalQueue,
+			sdk.NewAttribute(types.AttributeKeyCommitteeID, fmt.Sprintf("%d", proposal.CommitteeID)),
+			sdk.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", proposal.ID)),
+			sdk.NewAttribute(types.AttributeKeyEnactmentTime, enactmentTime.String()),
+		),
+	)
+}
+
+// enactAndCloseProposal enacts a proposal then removes it (and associated votes) from state.
+func (k Keeper) enactAndCloseProposal(ctx sdk.Context, proposal types.Proposal) {
+	err := k.EnactProposal(ctx, proposal)
+	outcome := types.AttributeValueProposalPassed
+	if err != nil {
+		outcome = types.AttributeValueProposalFailed
+	}
+
+	k.DeleteProposalAndVotes(ctx, proposal.ID)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeProposalClose,
+			sdk.NewAttribute(types.AttributeKeyCommitteeID, fmt.Sprintf("%d", proposal.CommitteeID)),
+			sdk.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", proposal.ID)),
+			sdk.NewAttribute(types.AttributeKeyProposalCloseStatus, outcome),
+		),
+	)
+}
+
+// CancelProposal removes a proposal (and associated votes) that is queued for enactment, so it is never enacted.
+func (k Keeper) CancelProposal(ctx sdk.Context, proposalID uint64) error {
+	proposal, found := k.GetProposal(ctx, proposalID)
+	if !found {
+		return sdkerrors.Wrapf(types.ErrUnknownProposal, "%d", proposalID)
+	}
+	if !proposal.IsQueued() {
+		return sdkerrors.Wrapf(types.ErrProposalNotQueued, "%d", proposalID)
+	}
+
+	k.DeleteProposalAndVotes(ctx, proposalID)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeProposalClose,
+			sdk.NewAttribute(types.AttributeKeyCommitteeID, fmt.Sprintf("%d", proposal.CommitteeID)),
+			sdk.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", proposal.ID)),
+			sdk.NewAttribute(types.AttributeKeyProposalCloseStatus, types.AttributeValueProposalCancelled),
+		),
+	)
+	return nil
+}
+
+// CloseRejectedProposals removes proposals (and associated votes) that can no longer get enough yes votes to pass.
+func (k Keeper) CloseRejectedProposals(ctx sdk.Context) {
+	k.IterateProposals(ctx, func(proposal types.Proposal) bool {
+		if proposal.IsQueued() {
+			return false
+		}
+		com, tally, err := k.tallyVotes(ctx, proposal.ID)
 		if err != nil {
-			outcome = types.AttributeValueProposalFailed
+			panic(err)
+		}
+		if !com.IsRejected(tally) {
+			return false
 		}
 
 		k.DeleteProposalAndVotes(ctx, proposal.ID)
@@ -155,18 +379,18 @@ func (k Keeper) EnactPassedProposals(ctx sdk.Context) {
 				types.EventTypeProposalClose,
 				sdk.NewAttribute(types.AttributeKeyCommitteeID, fmt.Sprintf("%d", proposal.CommitteeID)),
 				sdk.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", proposal.ID)),
-				sdk.NewAttribute(types.AttributeKeyProposalCloseStatus, outcome),
+				sdk.NewAttribute(types.AttributeKeyProposalCloseStatus, types.AttributeValueProposalRejected),
 			),
 		)
 		return false
 	})
 }
 
-// CloseExpiredProposals removes proposals (and associated votes) that have past their deadline.
+// CloseExpiredProposals removes proposals (and associated votes) that have past their deadline without passing.
 func (k Keeper) CloseExpiredProposals(ctx sdk.Context) {
 
 	k.IterateProposals(ctx, func(proposal types.Proposal) bool {
-		if !proposal.HasExpiredBy(ctx.BlockTime()) {
+		if proposal.IsQueued() || !proposal.HasExpiredBy(ctx.BlockTime()) {
 			return false
 		}
 
@@ -1,6 +1,8 @@
 package keeper_test
 
 import (
+	"errors"
+	"fmt"
 	"reflect"
 	"time"
 
@@ -8,8 +10,11 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/gov"
 	"github.com/cosmos/cosmos-sdk/x/params"
+	"github.com/cosmos/cosmos-sdk/x/staking"
 
 	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto"
+	"github.com/tendermint/tendermint/crypto/ed25519"
 
 	"github.com/kava-labs/kava/app"
 	bep3types "github.com/kava-labs/kava/x/bep3/types"
@@ -19,6 +24,9 @@ import (
 	"github.com/kava-labs/kava/x/pricefeed"
 )
 
+// pricefeedOracle is the oracle listed for the markets in the test pricefeed genesis states
+var pricefeedOracle = sdk.AccAddress(crypto.AddressHash([]byte("PricefeedOracle")))
+
 func newCDPGenesisState(params cdptypes.Params) app.GenesisState {
 	genesis := cdptypes.DefaultGenesisState()
 	genesis.Params = params
@@ -41,13 +49,13 @@ func newPricefeedGenState(assets []string, prices []sdk.Dec) app.GenesisState {
 		pfGenesis.Params.Markets = append(
 			pfGenesis.Params.Markets,
 			pricefeed.Market{
-				MarketID: assets[i] + ":usd", BaseAsset: assets[i], QuoteAsset: "usd", Oracles: []sdk.AccAddress{}, Active: true,
+				MarketID: assets[i] + ":usd", BaseAsset: assets[i], QuoteAsset: "usd", Oracles: []sdk.AccAddress{pricefeedOracle}, Active: true,
 			})
 		pfGenesis.PostedPrices = append(
 			pfGenesis.PostedPrices,
 			pricefeed.PostedPrice{
 				MarketID:      assets[i] + ":usd",
-				OracleAddress: sdk.AccAddress{},
+				OracleAddress: pricefeedOracle,
 				Price:         prices[i],
 				Expiry:        time.Date(1998, 1, 1, 0, 0, 0, 0, time.UTC),
 			})
@@ -251,6 +259,7 @@ func (suite *KeeperTestSuite) TestAddVote() {
 		name       string
 		proposalID uint64
 		voter      sdk.AccAddress
+		option     types.VoteOption
 		voteTime   time.Time
 		expectErr  bool
 	}{
@@ -258,24 +267,49 @@ func (suite *KeeperTestSuite) TestAddVote() {
 			name:       "normal",
 			proposalID: types.DefaultNextProposalID,
 			voter:      normalCom.Members[0],
+			option:     types.OptionYes,
+			expectErr:  false,
+		},
+		{
+			name:       "no vote",
+			proposalID: types.DefaultNextProposalID,
+			voter:      normalCom.Members[0],
+			option:     types.OptionNo,
 			expectErr:  false,
 		},
+		{
+			name:       "abstain vote",
+			proposalID: types.DefaultNextProposalID,
+			voter:      normalCom.Members[0],
+			option:     types.OptionAbstain,
+			expectErr:  false,
+		},
+		{
+			name:       "invalid option",
+			proposalID: types.DefaultNextProposalID,
+			voter:      normalCom.Members[0],
+			option:     types.OptionEmpty,
+			expectErr:  true,
+		},
 		{
 			name:       "nonexistent proposal",
 			proposalID: 9999999,
 			voter:      normalCom.Members[0],
+			option:     types.OptionYes,
 			expectErr:  true,
 		},
 		{
 			name:       "voter not committee member",
 			proposalID: types.DefaultNextProposalID,
 			voter:      suite.addresses[4],
+			option:     types.OptionYes,
 			expectErr:  true,
 		},
 		{
 			name:       "proposal expired",
 			proposalID: types.DefaultNextProposalID,
 			voter:      normalCom.Members[0],
+			option:     types.OptionYes,
 			voteTime:   firstBlockTime.Add(normalCom.ProposalDuration),
 			expectErr:  true,
 		},
@@ -295,14 +329,15 @@ func (suite *KeeperTestSuite) TestAddVote() {
 			suite.NoError(err)
 
 			ctx = ctx.WithBlockTime(tc.voteTime)
-			err = keeper.AddVote(ctx, tc.proposalID, tc.voter)
+			err = keeper.AddVote(ctx, tc.proposalID, tc.voter, tc.option)
 
 			if tc.expectErr {
 				suite.NotNil(err)
 			} else {
 				suite.NoError(err)
-				_, found := keeper.GetVote(ctx, tc.proposalID, tc.voter)
+				vote, found := keeper.GetVote(ctx, tc.proposalID, tc.voter)
 				suite.True(found)
+				suite.Equal(tc.option, vote.Option)
 			}
 		})
 	}
@@ -331,10 +366,10 @@ func (suite *KeeperTestSuite) TestGetProposalResult() {
 			name:      "enough votes",
 			committee: normalCom,
 			votes: []types.Vote{
-				{ProposalID: defaultID, Voter: suite.addresses[0]},
-				{ProposalID: defaultID, Voter: suite.addresses[1]},
-				{ProposalID: defaultID, Voter: suite.addresses[2]},
-				{ProposalID: defaultID, Voter: suite.addresses[3]},
+				{ProposalID: defaultID, Voter: suite.addresses[0], Option: types.OptionYes},
+				{ProposalID: defaultID, Voter: suite.addresses[1], Option: types.OptionYes},
+				{ProposalID: defaultID, Voter: suite.addresses[2], Option: types.OptionYes},
+				{ProposalID: defaultID, Voter: suite.addresses[3], Option: types.OptionYes},
 			},
 			proposalPasses: true,
 			expectErr:      false,
@@ -343,7 +378,20 @@ func (suite *KeeperTestSuite) TestGetProposalResult() {
 			name:      "not enough votes",
 			committee: normalCom,
 			votes: []types.Vote{
-				{ProposalID: defaultID, Voter: suite.addresses[0]},
+				{ProposalID: defaultID, Voter: suite.addresses[0], Option: types.OptionYes},
+			},
+			proposalPasses: false,
+			expectErr:      false,
+		},
+		{
+			name:      "no and abstain votes don't count towards passing",
+			committee: normalCom,
+			votes: []types.Vote{
+				{ProposalID: defaultID, Voter: suite.addresses[0], Option: types.OptionYes},
+				{ProposalID: defaultID, Voter: suite.addresses[1], Option: types.OptionYes},
+				{ProposalID: defaultID, Voter: suite.addresses[2], Option: types.OptionYes},
+				{ProposalID: defaultID, Voter: suite.addresses[3], Option: types.OptionNo},
+				{ProposalID: defaultID, Voter: suite.addresses[4], Option: types.OptionAbstain},
 			},
 			proposalPasses: false,
 			expectErr:      false,
@@ -383,12 +431,204 @@ func (suite *KeeperTestSuite) TestGetProposalResult() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestTallyVotes() {
+	normalCom := types.Committee{
+		ID:               12,
+		Description:      "This committee is for testing.",
+		Members:          suite.addresses[:5],
+		Permissions:      []types.Permission{types.GodPermission{}},
+		VoteThreshold:    d("0.667"),
+		ProposalDuration: time.Hour * 24 * 7,
+	}
+	var defaultID uint64 = 1
+	firstBlockTime := time.Date(1998, time.January, 1, 1, 0, 0, 0, time.UTC)
+
+	testcases := []struct {
+		name          string
+		votes         []types.Vote
+		expectedTally types.TallyResult
+		rejected      bool
+	}{
+		{
+			name:          "no votes",
+			votes:         []types.Vote{},
+			expectedTally: types.NewTallyResult(defaultID, i(0), i(0), i(0), i(5), d("0.667"), sdk.ZeroDec()),
+			rejected:      false,
+		},
+		{
+			name: "mixed votes",
+			votes: []types.Vote{
+				{ProposalID: defaultID, Voter: suite.addresses[0], Option: types.OptionYes},
+				{ProposalID: defaultID, Voter: suite.addresses[1], Option: types.OptionYes},
+				{ProposalID: defaultID, Voter: suite.addresses[2], Option: types.OptionNo},
+			},
+			expectedTally: types.NewTallyResult(defaultID, i(2), i(1), i(0), i(5), d("0.667"), sdk.ZeroDec()),
+			rejected:      false,
+		},
+		{
+			name: "too many votes against",
+			votes: []types.Vote{
+				{ProposalID: defaultID, Voter: suite.addresses[0], Option: types.OptionNo},
+				{ProposalID: defaultID, Voter: suite.addresses[1], Option: types.OptionAbstain},
+			},
+			expectedTally: types.NewTallyResult(defaultID, i(0), i(1), i(1), i(5), d("0.667"), sdk.ZeroDec()),
+			rejected:      true,
+		},
+	}
+
+	for _, tc := range testcases {
+		suite.Run(tc.name, func() {
+			// Create local testApp because suite doesn't run the SetupTest function for subtests
+			tApp := app.NewTestApp()
+			keeper := tApp.GetCommitteeKeeper()
+			ctx := tApp.NewContext(true, abci.Header{Height: 1, Time: firstBlockTime})
+
+			tApp.InitializeFromGenesisStates(
+				committeeGenState(
+					tApp.Codec(),
+					[]types.Committee{normalCom},
+					[]types.Proposal{{
+						PubProposal: gov.NewTextProposal("A Title", "A description of this proposal."),
+						ID:          defaultID,
+						CommitteeID: normalCom.ID,
+						Deadline:    firstBlockTime.Add(time.Hour * 24 * 7),
+					}},
+					tc.votes,
+				),
+			)
+
+			tally, err := keeper.TallyVotes(ctx, defaultID)
+			suite.NoError(err)
+			suite.Equal(tc.expectedTally, tally)
+			suite.Equal(tc.rejected, normalCom.IsRejected(tally))
+
+			_, err = keeper.TallyVotes(ctx, defaultID+1)
+			suite.Error(err)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestTokenCommittee_Balance() {
+	tApp := app.NewTestApp()
+	keeper := tApp.GetCommitteeKeeper()
+	ctx := tApp.NewContext(true, abci.Header{Height: 1, Time: time.Date(1998, time.January, 1, 1, 0, 0, 0, time.UTC)})
+	tApp.InitializeFromGenesisStates(
+		app.NewAuthGenState(
+			suite.addresses[:3],
+			[]sdk.Coins{cs(c("hard", 100)), cs(c("hard", 300)), cs(c("ukava", 100))},
+		),
+	)
+	com := types.NewTokenCommittee(
+		12,
+		"This committee is for testing.",
+		[]types.Permission{types.GodPermission{}},
+		types.NewTokenTally(types.SourceBalance, "hard", d("0.4")),
+		d("0.5"),
+		time.Hour*24*7,
+		0,
+	)
+	keeper.SetCommittee(ctx, com)
+	pubProposal := gov.NewTextProposal("A Title", "A description of this proposal.")
+
+	// only holders of the token can submit proposals
+	_, err := keeper.SubmitProposal(ctx, suite.addresses[2], com.ID, pubProposal)
+	suite.Require().True(errors.Is(err, types.ErrNoVotingPower))
+	proposalID, err := keeper.SubmitProposal(ctx, suite.addresses[0], com.ID, pubProposal)
+	suite.Require().NoError(err)
+	suite.Empty(keeper.GetVotingPowersByProposal(ctx, proposalID))
+
+	// only holders of the token can vote
+	err = keeper.AddVote(ctx, proposalID, suite.addresses[2], types.OptionYes)
+	suite.True(errors.Is(err, types.ErrNoVotingPower))
+
+	// voting escrows the voter's tokens, and a no vote doesn't meet quorum
+	suite.Require().NoError(keeper.AddVote(ctx, proposalID, suite.addresses[0], types.OptionNo))
+	suite.True(tApp.GetAccountKeeper().GetAccount(ctx, suite.addresses[0]).GetCoins().Empty())
+	tally, err := keeper.TallyVotes(ctx, proposalID)
+	suite.Require().NoError(err)
+	suite.Equal(types.NewTallyResult(proposalID, i(0), i(100), i(0), i(400), d("0.5"), d("0.4")), tally)
+	suite.False(com.Passes(tally))
+	suite.False(com.IsRejected(tally))
+
+	// a larger yes vote outweighs the no vote
+	suite.Require().NoError(keeper.AddVote(ctx, proposalID, suite.addresses[1], types.OptionYes))
+	passes, err := keeper.GetProposalResult(ctx, proposalID)
+	suite.Require().NoError(err)
+	suite.True(passes)
+	suite.Len(keeper.GetVotingPowersByProposal(ctx, proposalID), 2)
+
+	// escrowed tokens are returned when the proposal is removed
+	keeper.DeleteProposalAndVotes(ctx, proposalID)
+	suite.Empty(keeper.GetVotingPowersByProposal(ctx, proposalID))
+	suite.Equal(cs(c("hard", 100)), tApp.GetAccountKeeper().GetAccount(ctx, suite.addresses[0]).GetCoins())
+	suite.Equal(cs(c("hard", 300)), tApp.GetAccountKeeper().GetAccount(ctx, suite.addresses[1]).GetCoins())
+}
+
+func (suite *KeeperTestSuite) TestTokenCommittee_Staked() {
+	tApp := app.NewTestApp()
+	keeper := tApp.GetCommitteeKeeper()
+	ctx := tApp.NewContext(true, abci.Header{Height: 1, Time: time.Date(1998, time.January, 1, 1, 0, 0, 0, time.UTC)})
+	tApp.InitializeFromGenesisStates()
+
+	// delegate 60 and 40 tokens to a bonded validator, and 50 tokens to an unbonded one
+	sk := tApp.GetStakingKeeper()
+	bondedValidator := staking.NewValidator(sdk.ValAddress(ed25519.GenPrivKey().PubKey().Address()), ed25519.GenPrivKey().PubKey(), staking.Description{})
+	bondedValidator.Status = sdk.Bonded
+	for idx, amount := range []int64{60, 40} {
+		var shares sdk.Dec
+		bondedValidator, shares = bondedValidator.AddTokensFromDel(i(amount))
+		sk.SetDelegation(ctx, staking.NewDelegation(suite.addresses[idx], bondedValidator.OperatorAddress, shares))
+	}
+	sk.SetValidator(ctx, bondedValidator)
+	unbondedValidator := staking.NewValidator(sdk.ValAddress(ed25519.GenPrivKey().PubKey().Address()), ed25519.GenPrivKey().PubKey(), staking.Description{})
+	unbondedValidator, shares := unbondedValidator.AddTokensFromDel(i(50))
+	sk.SetDelegation(ctx, staking.NewDelegation(suite.addresses[2], unbondedValidator.OperatorAddress, shares))
+	sk.SetValidator(ctx, unbondedValidator)
+	bondedPool := tApp.GetSupplyKeeper().GetModuleAccount(ctx, staking.BondedPoolName)
+	suite.Require().NoError(bondedPool.SetCoins(cs(c(sk.BondDenom(ctx), 100))))
+	tApp.GetSupplyKeeper().SetModuleAccount(ctx, bondedPool)
+
+	com := types.NewTokenCommittee(
+		12,
+		"This committee is for testing.",
+		[]types.Permission{types.GodPermission{}},
+		types.NewTokenTally(types.SourceStaked, "", d("0.5")),
+		d("0.5"),
+		time.Hour*24*7,
+		0,
+	)
+	keeper.SetCommittee(ctx, com)
+	pubProposal := gov.NewTextProposal("A Title", "A description of this proposal.")
+
+	// stake with unbonded validators doesn't give voting power
+	_, err := keeper.SubmitProposal(ctx, suite.addresses[2], com.ID, pubProposal)
+	suite.Require().True(errors.Is(err, types.ErrNoVotingPower))
+	proposalID, err := keeper.SubmitProposal(ctx, suite.addresses[1], com.ID, pubProposal)
+	suite.Require().NoError(err)
+	suite.Empty(keeper.GetVotingPowersByProposal(ctx, proposalID))
+
+	// a minority stake doesn't meet quorum
+	suite.Require().NoError(keeper.AddVote(ctx, proposalID, suite.addresses[1], types.OptionYes))
+	tally, err := keeper.TallyVotes(ctx, proposalID)
+	suite.Require().NoError(err)
+	suite.Equal(types.NewTallyResult(proposalID, i(40), i(0), i(0), i(100), d("0.5"), d("0.5")), tally)
+	suite.False(com.Passes(tally))
+
+	// an abstain vote meets quorum without counting towards the threshold
+	suite.Require().NoError(keeper.AddVote(ctx, proposalID, suite.addresses[0], types.OptionAbstain))
+	passes, err := keeper.GetProposalResult(ctx, proposalID)
+	suite.Require().NoError(err)
+	suite.True(passes)
+}
+
 func committeeGenState(cdc *codec.Codec, committees []types.Committee, proposals []types.Proposal, votes []types.Vote) app.GenesisState {
 	gs := types.NewGenesisState(
 		uint64(len(proposals)+1),
 		committees,
 		proposals,
 		votes,
+		[]types.VotingPower{},
+		[]types.ParamChangeRecord{},
 	)
 	return app.GenesisState{committee.ModuleName: cdc.MustMarshalJSON(gs)}
 }
@@ -495,6 +735,85 @@ func (suite *KeeperTestSuite) TestValidatePubProposal() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestEnactProposal_ParamChangeWindow() {
+	// Setup test state
+	firstBlockTime := time.Date(1998, time.January, 1, 1, 0, 0, 0, time.UTC)
+	window := 24 * time.Hour
+	com := types.NewCommittee(
+		1,
+		"This committee can nudge the savings rate and debt floor.",
+		suite.addresses[:1],
+		[]types.Permission{types.SubParamChangePermission{
+			AllowedParams: types.AllowedParams{{Subspace: cdptypes.ModuleName, Key: string(cdptypes.KeyDebtParam)}},
+			AllowedDebtParam: types.AllowedDebtParam{
+				SavingsRate:       true,
+				SavingsRateBounds: &types.DecBounds{Min: d("0.8"), Max: d("1"), MaxChange: d("0.1"), Window: window},
+				DebtFloor:         true,
+				DebtFloorBounds:   &types.IntBounds{Window: window},
+			},
+		}},
+		d("0.5"),
+		7*24*time.Hour,
+		0,
+	)
+	newDebtParamProposal := func(rate sdk.Dec, floor sdk.Int) types.Proposal {
+		dp := cdptypes.DefaultDebtParam
+		dp.SavingsRate = rate
+		dp.DebtFloor = floor
+		return types.NewProposal(
+			params.NewParameterChangeProposal(
+				"Change the debt param",
+				"This proposal changes the savings rate or debt floor of the cdp module.",
+				[]params.ParamChange{{
+					Subspace: cdptypes.ModuleName,
+					Key:      string(cdptypes.KeyDebtParam),
+					Value:    string(suite.app.Codec().MustMarshalJSON(dp)),
+				}},
+			),
+			1,
+			com.ID,
+			firstBlockTime.Add(com.ProposalDuration),
+		)
+	}
+
+	suite.app.InitializeFromGenesisStates(
+		committeeGenState(suite.app.Codec(), []types.Committee{com}, []types.Proposal{}, []types.Vote{}),
+	)
+	ctx := suite.app.NewContext(false, abci.Header{Height: 1, Time: firstBlockTime})
+
+	floor := cdptypes.DefaultDebtParam.DebtFloor
+	savingsRateKey := fmt.Sprintf("%s/SavingsRate", cdptypes.KeyDebtParam)
+	debtFloorKey := fmt.Sprintf("%s/DebtFloor", cdptypes.KeyDebtParam)
+
+	// a change within bounds is enacted and recorded for the param and the changed field
+	suite.Require().NoError(suite.keeper.EnactProposal(ctx, newDebtParamProposal(d("0.9"), floor)))
+	record, found := suite.keeper.GetParamChangeRecord(ctx, cdptypes.ModuleName, string(cdptypes.KeyDebtParam))
+	suite.Require().True(found)
+	suite.Equal(firstBlockTime, record.Time)
+	record, found = suite.keeper.GetParamChangeRecord(ctx, cdptypes.ModuleName, savingsRateKey)
+	suite.Require().True(found)
+	suite.Equal(firstBlockTime, record.Time)
+	_, found = suite.keeper.GetParamChangeRecord(ctx, cdptypes.ModuleName, debtFloorKey)
+	suite.False(found)
+
+	// another change to the field within the window is not allowed, but other fields can still be changed
+	ctx = ctx.WithBlockTime(firstBlockTime.Add(window / 2))
+	suite.Error(suite.keeper.EnactProposal(ctx, newDebtParamProposal(d("0.85"), floor)))
+	suite.NoError(suite.keeper.EnactProposal(ctx, newDebtParamProposal(d("0.9"), floor.MulRaw(2))))
+	record, found = suite.keeper.GetParamChangeRecord(ctx, cdptypes.ModuleName, debtFloorKey)
+	suite.Require().True(found)
+	suite.Equal(firstBlockTime.Add(window/2), record.Time)
+	floor = floor.MulRaw(2)
+
+	// once the window has passed, changes within bounds are allowed again
+	ctx = ctx.WithBlockTime(firstBlockTime.Add(window))
+	suite.Error(suite.keeper.EnactProposal(ctx, newDebtParamProposal(d("0.79"), floor)))
+	suite.NoError(suite.keeper.EnactProposal(ctx, newDebtParamProposal(d("0.85"), floor)))
+	record, found = suite.keeper.GetParamChangeRecord(ctx, cdptypes.ModuleName, savingsRateKey)
+	suite.Require().True(found)
+	suite.Equal(firstBlockTime.Add(window), record.Time)
+}
+
 func (suite *KeeperTestSuite) TestCloseExpiredProposals() {
 
 	// Setup test state
@@ -533,10 +852,12 @@ func (suite *KeeperTestSuite) TestCloseExpiredProposals() {
 			},
 		},
 		[]types.Vote{
-			{ProposalID: 1, Voter: suite.addresses[0]},
-			{ProposalID: 1, Voter: suite.addresses[1]},
-			{ProposalID: 2, Voter: suite.addresses[2]},
+			{ProposalID: 1, Voter: suite.addresses[0], Option: types.OptionYes},
+			{ProposalID: 1, Voter: suite.addresses[1], Option: types.OptionYes},
+			{ProposalID: 2, Voter: suite.addresses[2], Option: types.OptionYes},
 		},
+		[]types.VotingPower{},
+		[]types.ParamChangeRecord{},
 	)
 	suite.app.InitializeFromGenesisStates(
 		NewCommitteeGenesisState(suite.app.Codec(), testGenesis),
@@ -71,10 +71,12 @@ func (suite *QuerierTestSuite) SetupTest() {
 			{ID: 2, CommitteeID: 1, PubProposal: gov.NewTextProposal("Another Title", "A description of this other proposal."), Deadline: testTime.Add(21 * 24 * time.Hour)},
 		},
 		[]types.Vote{
-			{ProposalID: 1, Voter: suite.addresses[0]},
-			{ProposalID: 1, Voter: suite.addresses[1]},
-			{ProposalID: 2, Voter: suite.addresses[2]},
+			{ProposalID: 1, Voter: suite.addresses[0], Option: types.OptionYes},
+			{ProposalID: 1, Voter: suite.addresses[1], Option: types.OptionYes},
+			{ProposalID: 2, Voter: suite.addresses[2], Option: types.OptionYes},
 		},
+		[]types.VotingPower{},
+		[]types.ParamChangeRecord{},
 	)
 	suite.app.InitializeFromGenesisStates(
 		NewCommitteeGenesisState(suite.cdc, suite.testGenesis),
@@ -232,11 +234,14 @@ func (suite *QuerierTestSuite) TestQueryTally() {
 	suite.NotNil(bz)
 
 	// Unmarshal the bytes
-	var tally int64
+	var tally types.TallyResult
 	suite.NoError(suite.cdc.UnmarshalJSON(bz, &tally))
 
 	// Check
-	suite.Equal(int64(len(suite.votes[propID])), tally)
+	expectedTally, err := suite.keeper.TallyVotes(ctx, propID)
+	suite.NoError(err)
+	suite.Equal(expectedTally, tally)
+	suite.Equal(int64(len(suite.votes[propID])), tally.Yes.Int64())
 }
 func TestQuerierTestSuite(t *testing.T) {
 	suite.Run(t, new(QuerierTestSuite))
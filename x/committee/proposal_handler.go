@@ -13,6 +13,22 @@ func NewProposalHandler(k Keeper) govtypes.Handler {
 			return handleCommitteeChangeProposal(ctx, k, c)
 		case CommitteeDeleteProposal:
 			return handleCommitteeDeleteProposal(ctx, k, c)
+		case CommitteeCancelProposal:
+			return handleCommitteeCancelProposal(ctx, k, c)
+
+		default:
+			return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized %s proposal content type: %T", ModuleName, c)
+		}
+	}
+}
+
+// NewCancelProposalHandler returns a handler for only the committee proposals that committees are able to enact themselves.
+// It allows guardian committees to cancel queued proposals without being able to create, update, or delete committees.
+func NewCancelProposalHandler(k Keeper) govtypes.Handler {
+	return func(ctx sdk.Context, content govtypes.Content) error {
+		switch c := content.(type) {
+		case CommitteeCancelProposal:
+			return handleCommitteeCancelProposal(ctx, k, c)
 
 		default:
 			return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized %s proposal content type: %T", ModuleName, c)
@@ -50,3 +66,11 @@ func handleCommitteeDeleteProposal(ctx sdk.Context, k Keeper, committeeProposal
 	k.DeleteCommittee(ctx, committeeProposal.CommitteeID)
 	return nil
 }
+
+func handleCommitteeCancelProposal(ctx sdk.Context, k Keeper, committeeProposal CommitteeCancelProposal) error {
+	if err := committeeProposal.ValidateBasic(); err != nil {
+		return sdkerrors.Wrap(ErrInvalidPubProposal, err.Error())
+	}
+
+	return k.CancelProposal(ctx, committeeProposal.ProposalID)
+}
@@ -59,8 +59,10 @@ func (suite *ProposalHandlerTestSuite) SetupTest() {
 			{ID: 1, CommitteeID: 1, PubProposal: gov.NewTextProposal("A Title", "A description of this proposal."), Deadline: testTime.Add(7 * 24 * time.Hour)},
 		},
 		[]committee.Vote{
-			{ProposalID: 1, Voter: suite.addresses[0]},
+			{ProposalID: 1, Voter: suite.addresses[0], Option: committee.OptionYes},
 		},
+		[]committee.VotingPower{},
+		[]committee.ParamChangeRecord{},
 	)
 }
 
@@ -222,6 +224,81 @@ func (suite *ProposalHandlerTestSuite) TestProposalHandler_DeleteCommittee() {
 	}
 }
 
+func (suite *ProposalHandlerTestSuite) TestProposalHandler_CancelProposal() {
+	// add a proposal that has passed and is waiting to be enacted
+	genState := suite.testGenesis
+	genState.NextProposalID = 3
+	genState.Proposals = append(genState.Proposals, committee.Proposal{
+		ID:            2,
+		CommitteeID:   1,
+		PubProposal:   gov.NewTextProposal("Another Title", "A description of this other proposal."),
+		Deadline:      testTime.Add(7 * 24 * time.Hour),
+		EnactmentTime: testTime.Add(24 * time.Hour),
+	})
+	genState.Votes = append(genState.Votes, committee.Vote{ProposalID: 2, Voter: suite.addresses[0], Option: committee.OptionYes})
+
+	testCases := []struct {
+		name       string
+		proposal   committee.CommitteeCancelProposal
+		expectPass bool
+	}{
+		{
+			name: "normal",
+			proposal: committee.NewCommitteeCancelProposal(
+				"A Title",
+				"A proposal description.",
+				2,
+			),
+			expectPass: true,
+		},
+		{
+			name: "proposal not queued",
+			proposal: committee.NewCommitteeCancelProposal(
+				"A Title",
+				"A proposal description.",
+				1,
+			),
+			expectPass: false,
+		},
+		{
+			name: "unknown proposal",
+			proposal: committee.NewCommitteeCancelProposal(
+				"A Title",
+				"A proposal description.",
+				99,
+			),
+			expectPass: false,
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			// Setup
+			suite.app = app.NewTestApp()
+			suite.keeper = suite.app.GetCommitteeKeeper()
+			suite.app = suite.app.InitializeFromGenesisStates(
+				NewCommitteeGenState(suite.app.Codec(), genState),
+			)
+			suite.ctx = suite.app.NewContext(true, abci.Header{Height: 1, Time: testTime})
+			handler := committee.NewProposalHandler(suite.keeper)
+
+			// Run
+			err := handler(suite.ctx, tc.proposal)
+
+			// Check
+			if tc.expectPass {
+				suite.NoError(err)
+				// check proposal and votes have been removed
+				_, found := suite.keeper.GetProposal(suite.ctx, tc.proposal.ProposalID)
+				suite.False(found)
+				suite.Empty(suite.keeper.GetVotesByProposal(suite.ctx, tc.proposal.ProposalID))
+			} else {
+				suite.Error(err)
+				suite.Equal(genState, committee.ExportGenesis(suite.ctx, suite.keeper))
+			}
+		})
+	}
+}
+
 func TestProposalHandlerTestSuite(t *testing.T) {
 	suite.Run(t, new(ProposalHandlerTestSuite))
 }
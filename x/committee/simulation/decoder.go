@@ -32,6 +32,18 @@ func DecodeStore(cdc *codec.Codec, kvA, kvB kv.Pair) string {
 		cdc.MustUnmarshalBinaryLengthPrefixed(kvB.Value, &voteB)
 		return fmt.Sprintf("%v\n%v", voteA, voteB)
 
+	case bytes.Equal(kvA.Key[:1], types.VotingPowerKeyPrefix):
+		var votingPowerA, votingPowerB types.VotingPower
+		cdc.MustUnmarshalBinaryLengthPrefixed(kvA.Value, &votingPowerA)
+		cdc.MustUnmarshalBinaryLengthPrefixed(kvB.Value, &votingPowerB)
+		return fmt.Sprintf("%v\n%v", votingPowerA, votingPowerB)
+
+	case bytes.Equal(kvA.Key[:1], types.ParamChangeRecordKeyPrefix):
+		var recordA, recordB types.ParamChangeRecord
+		cdc.MustUnmarshalBinaryLengthPrefixed(kvA.Value, &recordA)
+		cdc.MustUnmarshalBinaryLengthPrefixed(kvB.Value, &recordB)
+		return fmt.Sprintf("%v\n%v", recordA, recordB)
+
 	case bytes.Equal(kvA.Key[:1], types.NextProposalIDKey):
 		proposalIDA := types.Uint64FromBytes(kvA.Value)
 		proposalIDB := types.Uint64FromBytes(kvB.Value)
@@ -33,6 +33,7 @@ func TestDecodeStore(t *testing.T) {
 		[]types.Permission{types.TextPermission{}},
 		sdk.MustNewDecFromStr("0.667"),
 		time.Hour*24*7,
+		0,
 	)
 	proposal := types.Proposal{
 		ID:          34,
@@ -43,12 +44,17 @@ func TestDecodeStore(t *testing.T) {
 	vote := types.Vote{
 		ProposalID: 9,
 		Voter:      nil,
+		Option:     types.OptionNo,
 	}
+	votingPower := types.NewVotingPower(9, nil, sdk.NewInt64Coin("hard", 1000))
+	paramChangeRecord := types.NewParamChangeRecord("cdp", "CollateralParams", time.Date(1998, time.January, 1, 0, 0, 0, 0, time.UTC))
 
 	kvPairs := kv.Pairs{
 		kv.Pair{Key: types.CommitteeKeyPrefix, Value: cdc.MustMarshalBinaryLengthPrefixed(&committee)},
 		kv.Pair{Key: types.ProposalKeyPrefix, Value: cdc.MustMarshalBinaryLengthPrefixed(&proposal)},
 		kv.Pair{Key: types.VoteKeyPrefix, Value: cdc.MustMarshalBinaryLengthPrefixed(&vote)},
+		kv.Pair{Key: types.VotingPowerKeyPrefix, Value: cdc.MustMarshalBinaryLengthPrefixed(&votingPower)},
+		kv.Pair{Key: types.ParamChangeRecordKeyPrefix, Value: cdc.MustMarshalBinaryLengthPrefixed(&paramChangeRecord)},
 		kv.Pair{Key: types.NextProposalIDKey, Value: sdk.Uint64ToBigEndian(10)},
 		kv.Pair{Key: []byte{0x99}, Value: []byte{0x99}},
 	}
@@ -60,6 +66,8 @@ func TestDecodeStore(t *testing.T) {
 		{"Committee", fmt.Sprintf("%v\n%v", committee, committee)},
 		{"Proposal", fmt.Sprintf("%v\n%v", proposal, proposal)},
 		{"Vote", fmt.Sprintf("%v\n%v", vote, vote)},
+		{"VotingPower", fmt.Sprintf("%v\n%v", votingPower, votingPower)},
+		{"ParamChangeRecord", fmt.Sprintf("%v\n%v", paramChangeRecord, paramChangeRecord)},
 		{"NextProposalID", "10\n10"},
 		{"other", ""},
 	}
@@ -38,6 +38,7 @@ func RandomizedGenState(simState *module.SimulationState) {
 		[]types.Permission{types.GodPermission{}},
 		sdk.MustNewDecFromStr("0.5"),
 		AverageBlockTime*10,
+		0,
 	)
 
 	// Create other committees
@@ -57,6 +58,8 @@ func RandomizedGenState(simState *module.SimulationState) {
 		committees,
 		[]types.Proposal{},
 		[]types.Vote{},
+		[]types.VotingPower{},
+		[]types.ParamChangeRecord{},
 	)
 	fmt.Printf("Selected randomly generated %s parameters:\n%s\n", types.ModuleName, []byte{})
 	simState.GenState[types.ModuleName] = simState.Cdc.MustMarshalJSON(genesisState)
@@ -78,6 +81,15 @@ func RandomCommittee(r *rand.Rand, availableAccs []simulation.Account, allowedPa
 		return types.Committee{}, err
 	}
 
+	// pick enactment delay, half of committees enact proposals immediately
+	var enactmentDelay time.Duration
+	if r.Intn(100) < 50 {
+		enactmentDelay, err = RandomPositiveDuration(r, 0, AverageBlockTime*5)
+		if err != nil {
+			return types.Committee{}, err
+		}
+	}
+
 	// pick committee vote threshold, must be in interval (0,1]
 	threshold := simulation.RandomDecAmount(r, sdk.MustNewDecFromStr("1").Sub(sdk.SmallestDec())).Add(sdk.SmallestDec())
 
@@ -88,6 +100,7 @@ func RandomCommittee(r *rand.Rand, availableAccs []simulation.Account, allowedPa
 		RandomPermissions(r, allowedParams),
 		threshold,
 		dur,
+		enactmentDelay,
 	), nil
 }
 
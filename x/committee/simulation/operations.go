@@ -73,10 +73,18 @@ func SimulateMsgSubmitProposal(cdc *codec.Codec, ak AccountKeeper, k keeper.Keep
 			}
 		}
 		// pick a committee that has permissions for proposal
-		pp := types.PubProposal(contentSim(r, ctx, accs))
+		content := contentSim(r, ctx, accs)
+		if content == nil {
+			return simulation.NoOpMsg(types.ModuleName), nil, nil
+		}
+		pp := types.PubProposal(content)
 		var selectedCommittee types.Committee
 		var found bool
 		for _, c := range committees {
+			// token committees have no members to propose and vote
+			if c.IsTokenCommittee() {
+				continue
+			}
 			if c.HasPermissionsFor(ctx, cdc, k.ParamKeeper, pp) {
 				selectedCommittee = c
 				found = true
@@ -143,16 +151,27 @@ func SimulateMsgSubmitProposal(cdc *codec.Codec, ak AccountKeeper, k keeper.Keep
 		}
 		voters := selectedCommittee.Members[:numVoters]
 
+		// members that don't vote yes on a failing proposal may vote no or abstain instead
+		var opposers []sdk.AccAddress
+		if !shouldPass {
+			numOpposers := r.Int63n(numMembers - numVoters + 1) // in interval [0, numMembers - numVoters]
+			opposers = selectedCommittee.Members[numVoters : numVoters+numOpposers]
+		}
+
 		// schedule vote operations
 		var futureOps []simulation.FutureOperation
-		for _, v := range voters {
+		for i, v := range append(voters, opposers...) {
+			option := types.OptionYes
+			if i >= len(voters) {
+				option = []types.VoteOption{types.OptionNo, types.OptionAbstain}[r.Intn(2)]
+			}
 			voteTime, err := RandomTime(r, ctx.BlockTime(), proposal.Deadline)
 			if err != nil {
 				return simulation.NoOpMsg(types.ModuleName), nil, fmt.Errorf("random time generation failed: %w", err)
 			}
 			fop := simulation.FutureOperation{
 				BlockTime: voteTime,
-				Op:        SimulateMsgVote(k, ak, v, proposal.ID),
+				Op:        SimulateMsgVote(k, ak, v, proposal.ID, option),
 			}
 			futureOps = append(futureOps, fop)
 		}
@@ -161,11 +180,16 @@ func SimulateMsgSubmitProposal(cdc *codec.Codec, ak AccountKeeper, k keeper.Keep
 	}
 }
 
-func SimulateMsgVote(k keeper.Keeper, ak AccountKeeper, voter sdk.AccAddress, proposalID uint64) simulation.Operation {
+func SimulateMsgVote(k keeper.Keeper, ak AccountKeeper, voter sdk.AccAddress, proposalID uint64, option types.VoteOption) simulation.Operation {
 	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simulation.Account, chainID string) (
 		opMsg simulation.OperationMsg, fOps []simulation.FutureOperation, err error) {
 
-		msg := types.NewMsgVote(voter, proposalID)
+		// the proposal may have been enacted, queued, or rejected before this vote was scheduled to run
+		if pr, found := k.GetProposal(ctx, proposalID); !found || pr.IsQueued() {
+			return simulation.NoOpMsg(types.ModuleName), nil, nil
+		}
+
+		msg := types.NewMsgVote(voter, proposalID, option)
 
 		account := ak.GetAccount(ctx, voter)
 		fees, err := simulation.RandomFees(r, ctx, account.SpendableCoins(ctx.BlockTime()))
@@ -1,7 +1,9 @@
 package types
 
 import (
+	"encoding/json"
 	"fmt"
+	"strings"
 	"time"
 
 	yaml "gopkg.in/yaml.v2"
@@ -18,16 +20,19 @@ const MaxCommitteeDescriptionLength int = 512
 // ------------------------------------------
 
 // A Committee is a collection of addresses that are allowed to vote and enact any governance proposal that passes their permissions.
+// Token committees have no members, instead anyone holding voting power through the committee's TokenTally can propose and vote.
 type Committee struct {
 	ID               uint64           `json:"id" yaml:"id"`
 	Description      string           `json:"description" yaml:"description"`
 	Members          []sdk.AccAddress `json:"members" yaml:"members"`
 	Permissions      []Permission     `json:"permissions" yaml:"permissions"`
-	VoteThreshold    sdk.Dec          `json:"vote_threshold" yaml:"vote_threshold"`       // Smallest percentage of members that must vote for a proposal to pass.
-	ProposalDuration time.Duration    `json:"proposal_duration" yaml:"proposal_duration"` // The length of time a proposal remains active for. Proposals will close earlier if they get enough votes.
+	VoteThreshold    sdk.Dec          `json:"vote_threshold" yaml:"vote_threshold"`               // Smallest percentage of members (or of yes and no voting power for token committees) that must vote yes for a proposal to pass.
+	ProposalDuration time.Duration    `json:"proposal_duration" yaml:"proposal_duration"`         // The length of time a proposal remains active for. Proposals will close earlier if they get enough votes.
+	EnactmentDelay   time.Duration    `json:"enactment_delay" yaml:"enactment_delay"`             // The length of time a passed proposal is queued for before it is enacted. Zero enacts proposals as soon as they pass.
+	TokenTally       *TokenTally      `json:"token_tally,omitempty" yaml:"token_tally,omitempty"` // If set, votes are weighted by token holdings rather than one vote per member.
 }
 
-func NewCommittee(id uint64, description string, members []sdk.AccAddress, permissions []Permission, threshold sdk.Dec, duration time.Duration) Committee {
+func NewCommittee(id uint64, description string, members []sdk.AccAddress, permissions []Permission, threshold sdk.Dec, duration, enactmentDelay time.Duration) Committee {
 	return Committee{
 		ID:               id,
 		Description:      description,
@@ -35,9 +40,28 @@ func NewCommittee(id uint64, description string, members []sdk.AccAddress, permi
 		Permissions:      permissions,
 		VoteThreshold:    threshold,
 		ProposalDuration: duration,
+		EnactmentDelay:   enactmentDelay,
 	}
 }
 
+// NewTokenCommittee returns a committee with no members where voting power comes from token holdings.
+func NewTokenCommittee(id uint64, description string, permissions []Permission, tokenTally TokenTally, threshold sdk.Dec, duration, enactmentDelay time.Duration) Committee {
+	return Committee{
+		ID:               id,
+		Description:      description,
+		Permissions:      permissions,
+		VoteThreshold:    threshold,
+		ProposalDuration: duration,
+		EnactmentDelay:   enactmentDelay,
+		TokenTally:       &tokenTally,
+	}
+}
+
+// IsTokenCommittee returns whether votes in the committee are weighted by token holdings.
+func (c Committee) IsTokenCommittee() bool {
+	return c.TokenTally != nil
+}
+
 func (c Committee) HasMember(addr sdk.AccAddress) bool {
 	for _, m := range c.Members {
 		if m.Equals(addr) {
@@ -73,7 +97,14 @@ func (c Committee) Validate() error {
 		addressMap[m.String()] = true
 	}
 
-	if len(c.Members) == 0 {
+	if c.IsTokenCommittee() {
+		if len(c.Members) != 0 {
+			return fmt.Errorf("token committee cannot have members")
+		}
+		if err := c.TokenTally.Validate(); err != nil {
+			return err
+		}
+	} else if len(c.Members) == 0 {
 		return fmt.Errorf("committee cannot have zero members")
 	}
 
@@ -96,9 +127,124 @@ func (c Committee) Validate() error {
 		return fmt.Errorf("invalid proposal duration: %s", c.ProposalDuration)
 	}
 
+	if c.EnactmentDelay < 0 {
+		return fmt.Errorf("invalid enactment delay: %s", c.EnactmentDelay)
+	}
+
 	return nil
 }
 
+// Passes returns whether a tally has enough votes for the proposal to pass.
+func (c Committee) Passes(tally TallyResult) bool {
+	if !c.IsTokenCommittee() {
+		// the threshold is a fraction of all members, so no and abstain votes count against the proposal
+		return tally.Yes.ToDec().GTE(c.VoteThreshold.MulInt(tally.PossibleVotes))
+	}
+	// the quorum is a fraction of all voting power, while the threshold is a fraction of the yes and no votes
+	if tally.Yes.Add(tally.No).Add(tally.Abstain).ToDec().LT(c.TokenTally.Quorum.MulInt(tally.PossibleVotes)) {
+		return false
+	}
+	return tally.Yes.IsPositive() && tally.Yes.ToDec().GTE(c.VoteThreshold.MulInt(tally.Yes.Add(tally.No)))
+}
+
+// IsRejected returns whether a tally can no longer pass, even if all outstanding votes are cast as yes.
+func (c Committee) IsRejected(tally TallyResult) bool {
+	best := tally
+	best.Yes = tally.Yes.Add(tally.Outstanding())
+	return !c.Passes(best)
+}
+
+// TokenTally configures a committee to weight votes by the tokens each voter holds.
+type TokenTally struct {
+	Source TallySource `json:"source" yaml:"source"`
+	Denom  string      `json:"denom" yaml:"denom"`   // Token that voters escrow to vote, only used by the balance source.
+	Quorum sdk.Dec     `json:"quorum" yaml:"quorum"` // Smallest percentage of all voting power that must vote for a proposal to pass.
+}
+
+func NewTokenTally(source TallySource, denom string, quorum sdk.Dec) TokenTally {
+	return TokenTally{
+		Source: source,
+		Denom:  denom,
+		Quorum: quorum,
+	}
+}
+
+// Validate performs basic validation of a token tally.
+func (tt TokenTally) Validate() error {
+	switch tt.Source {
+	case SourceStaked:
+		if tt.Denom != "" {
+			return fmt.Errorf("staked token tally cannot have a denom, got %s", tt.Denom)
+		}
+	case SourceBalance:
+		if err := sdk.ValidateDenom(tt.Denom); err != nil {
+			return fmt.Errorf("invalid token tally denom: %w", err)
+		}
+	default:
+		return fmt.Errorf("invalid token tally source: %s", tt.Source)
+	}
+
+	// quorum must be in the range [0,1]
+	if tt.Quorum.IsNil() || tt.Quorum.IsNegative() || tt.Quorum.GT(sdk.OneDec()) {
+		return fmt.Errorf("invalid quorum: %s", tt.Quorum)
+	}
+	return nil
+}
+
+// TallySource is where a token committee's voting power comes from.
+type TallySource byte
+
+const (
+	SourceEmpty   TallySource = 0x00
+	SourceStaked  TallySource = 0x01 // Voting power is the voter's bonded stake at the time of tallying.
+	SourceBalance TallySource = 0x02 // Voting power is the amount of a token the voter has escrowed on the proposal.
+)
+
+// NewTallySourceFromString converts string to TallySource type
+func NewTallySourceFromString(str string) TallySource {
+	switch str {
+	case "Staked", "staked":
+		return SourceStaked
+	case "Balance", "balance":
+		return SourceBalance
+	default:
+		return SourceEmpty
+	}
+}
+
+// String returns the string representation of a TallySource
+func (source TallySource) String() string {
+	switch source {
+	case SourceStaked:
+		return "staked"
+	case SourceBalance:
+		return "balance"
+	default:
+		return "INVALID"
+	}
+}
+
+// MarshalJSON marshals the TallySource
+func (source TallySource) MarshalJSON() ([]byte, error) {
+	return json.Marshal(source.String())
+}
+
+// UnmarshalJSON unmarshals the TallySource
+func (source *TallySource) UnmarshalJSON(data []byte) error {
+	var s string
+	err := json.Unmarshal(data, &s)
+	if err != nil {
+		return err
+	}
+	*source = NewTallySourceFromString(s)
+	return nil
+}
+
+// MarshalYAML marshals the TallySource
+func (source TallySource) MarshalYAML() (interface{}, error) {
+	return source.String(), nil
+}
+
 // ------------------------------------------
 //				Proposals
 // ------------------------------------------
@@ -110,10 +256,11 @@ type PubProposal govtypes.Content
 
 // Proposal is an internal record of a governance proposal submitted to a committee.
 type Proposal struct {
-	PubProposal `json:"pub_proposal" yaml:"pub_proposal"`
-	ID          uint64    `json:"id" yaml:"id"`
-	CommitteeID uint64    `json:"committee_id" yaml:"committee_id"`
-	Deadline    time.Time `json:"deadline" yaml:"deadline"`
+	PubProposal   `json:"pub_proposal" yaml:"pub_proposal"`
+	ID            uint64    `json:"id" yaml:"id"`
+	CommitteeID   uint64    `json:"committee_id" yaml:"committee_id"`
+	Deadline      time.Time `json:"deadline" yaml:"deadline"`
+	EnactmentTime time.Time `json:"enactment_time" yaml:"enactment_time"` // Set once the proposal has passed and is queued for enactment, zero otherwise.
 }
 
 func NewProposal(pubProposal PubProposal, id uint64, committeeID uint64, deadline time.Time) Proposal {
@@ -131,6 +278,16 @@ func (p Proposal) HasExpiredBy(time time.Time) bool {
 	return !time.Before(p.Deadline)
 }
 
+// IsQueued returns whether the proposal has passed and is waiting out its committee's enactment delay.
+func (p Proposal) IsQueued() bool {
+	return !p.EnactmentTime.IsZero()
+}
+
+// IsEnactableBy calculates if a queued proposal's enactment delay will have elapsed by a certain time.
+func (p Proposal) IsEnactableBy(time time.Time) bool {
+	return p.IsQueued() && !time.Before(p.EnactmentTime)
+}
+
 // String implements the fmt.Stringer interface, and importantly overrides the String methods inherited from the embedded PubProposal type.
 func (p Proposal) String() string {
 	bz, _ := yaml.Marshal(p)
@@ -144,18 +301,199 @@ func (p Proposal) String() string {
 type Vote struct {
 	ProposalID uint64         `json:"proposal_id" yaml:"proposal_id"`
 	Voter      sdk.AccAddress `json:"voter" yaml:"voter"`
+	Option     VoteOption     `json:"option" yaml:"option"`
 }
 
-func NewVote(proposalID uint64, voter sdk.AccAddress) Vote {
+func NewVote(proposalID uint64, voter sdk.AccAddress, option VoteOption) Vote {
 	return Vote{
 		ProposalID: proposalID,
 		Voter:      voter,
+		Option:     option,
+	}
+}
+
+// GetOption returns the vote's option. Votes cast before options were added have no option and are yes votes.
+func (v Vote) GetOption() VoteOption {
+	if v.Option == OptionEmpty {
+		return OptionYes
 	}
+	return v.Option
 }
 
 func (v Vote) Validate() error {
 	if v.Voter.Empty() {
 		return fmt.Errorf("voter address cannot be empty")
 	}
+	if !v.GetOption().IsValid() {
+		return fmt.Errorf("invalid vote option: %s", v.Option)
+	}
+	return nil
+}
+
+// VoteOption is the choice a committee member makes when voting on a proposal.
+type VoteOption byte
+
+const (
+	OptionEmpty   VoteOption = 0x00
+	OptionYes     VoteOption = 0x01
+	OptionNo      VoteOption = 0x02
+	OptionAbstain VoteOption = 0x03
+)
+
+// NewVoteOptionFromString converts string to VoteOption type
+func NewVoteOptionFromString(str string) VoteOption {
+	switch str {
+	case "Yes", "yes", "y":
+		return OptionYes
+	case "No", "no", "n":
+		return OptionNo
+	case "Abstain", "abstain", "a":
+		return OptionAbstain
+	default:
+		return OptionEmpty
+	}
+}
+
+// String returns the string representation of a VoteOption
+func (option VoteOption) String() string {
+	switch option {
+	case OptionYes:
+		return "Yes"
+	case OptionNo:
+		return "No"
+	case OptionAbstain:
+		return "Abstain"
+	default:
+		return "INVALID"
+	}
+}
+
+// MarshalJSON marshals the VoteOption
+func (option VoteOption) MarshalJSON() ([]byte, error) {
+	return json.Marshal(option.String())
+}
+
+// UnmarshalJSON unmarshals the VoteOption
+func (option *VoteOption) UnmarshalJSON(data []byte) error {
+	var s string
+	err := json.Unmarshal(data, &s)
+	if err != nil {
+		return err
+	}
+	*option = NewVoteOptionFromString(s)
+	return nil
+}
+
+// MarshalYAML marshals the VoteOption
+func (option VoteOption) MarshalYAML() (interface{}, error) {
+	return option.String(), nil
+}
+
+// IsValid returns true if the vote option is valid and false otherwise.
+func (option VoteOption) IsValid() bool {
+	if option == OptionYes ||
+		option == OptionNo ||
+		option == OptionAbstain {
+		return true
+	}
+	return false
+}
+
+// ------------------------------------------
+//				Tallies
+// ------------------------------------------
+
+// TallyResult is a count of the votes cast on a proposal, broken down by option.
+// For token committees votes are weighted by voting power.
+type TallyResult struct {
+	ProposalID    uint64  `json:"proposal_id" yaml:"proposal_id"`
+	Yes           sdk.Int `json:"yes" yaml:"yes"`
+	No            sdk.Int `json:"no" yaml:"no"`
+	Abstain       sdk.Int `json:"abstain" yaml:"abstain"`
+	PossibleVotes sdk.Int `json:"possible_votes" yaml:"possible_votes"` // Total votes that could be cast on the proposal.
+	VoteThreshold sdk.Dec `json:"vote_threshold" yaml:"vote_threshold"`
+	Quorum        sdk.Dec `json:"quorum" yaml:"quorum"` // Zero for member committees, which have no quorum.
+}
+
+func NewTallyResult(proposalID uint64, yes, no, abstain, possibleVotes sdk.Int, threshold, quorum sdk.Dec) TallyResult {
+	return TallyResult{
+		ProposalID:    proposalID,
+		Yes:           yes,
+		No:            no,
+		Abstain:       abstain,
+		PossibleVotes: possibleVotes,
+		VoteThreshold: threshold,
+		Quorum:        quorum,
+	}
+}
+
+// Outstanding returns the number of votes that have not been cast yet.
+func (tr TallyResult) Outstanding() sdk.Int {
+	return tr.PossibleVotes.Sub(tr.Yes).Sub(tr.No).Sub(tr.Abstain)
+}
+
+// String implements the fmt.Stringer interface.
+func (tr TallyResult) String() string {
+	bz, _ := yaml.Marshal(tr)
+	return string(bz)
+}
+
+// ------------------------------------------
+//				Voting Power
+// ------------------------------------------
+
+// VotingPower is a record of the tokens an address has escrowed to vote on a proposal.
+// The tokens are returned when the proposal is closed.
+type VotingPower struct {
+	ProposalID uint64         `json:"proposal_id" yaml:"proposal_id"`
+	Holder     sdk.AccAddress `json:"holder" yaml:"holder"`
+	Amount     sdk.Coin       `json:"amount" yaml:"amount"`
+}
+
+func NewVotingPower(proposalID uint64, holder sdk.AccAddress, amount sdk.Coin) VotingPower {
+	return VotingPower{
+		ProposalID: proposalID,
+		Holder:     holder,
+		Amount:     amount,
+	}
+}
+
+func (vp VotingPower) Validate() error {
+	if vp.Holder.Empty() {
+		return fmt.Errorf("holder address cannot be empty")
+	}
+	if !vp.Amount.IsValid() || !vp.Amount.IsPositive() {
+		return fmt.Errorf("voting power must be positive: %s", vp.Amount)
+	}
+	return nil
+}
+
+// ------------------------------------------
+//				Param Changes
+// ------------------------------------------
+
+// ParamChangeRecord is a record of the last time a committee proposal changed a param.
+// It is used by permissions that limit how often a param can be changed.
+type ParamChangeRecord struct {
+	Subspace string    `json:"subspace" yaml:"subspace"`
+	Key      string    `json:"key" yaml:"key"`
+	Time     time.Time `json:"time" yaml:"time"`
+}
+
+func NewParamChangeRecord(subspace, key string, changeTime time.Time) ParamChangeRecord {
+	return ParamChangeRecord{
+		Subspace: subspace,
+		Key:      key,
+		Time:     changeTime,
+	}
+}
+
+func (pcr ParamChangeRecord) Validate() error {
+	if pcr.Subspace == "" || strings.Contains(pcr.Subspace, "/") {
+		return fmt.Errorf("invalid param change subspace: %s", pcr.Subspace)
+	}
+	if pcr.Key == "" {
+		return fmt.Errorf("param change key cannot be empty")
+	}
 	return nil
 }
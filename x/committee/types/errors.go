@@ -13,4 +13,8 @@ var (
 	ErrUnknownVote             = sdkerrors.Register(ModuleName, 7, "vote not found")
 	ErrInvalidGenesis          = sdkerrors.Register(ModuleName, 8, "invalid genesis")
 	ErrNoProposalHandlerExists = sdkerrors.Register(ModuleName, 9, "pubproposal has no corresponding handler")
+	ErrInvalidVoteOption       = sdkerrors.Register(ModuleName, 10, "invalid vote option")
+	ErrNoVotingPower           = sdkerrors.Register(ModuleName, 11, "no voting power")
+	ErrProposalQueued          = sdkerrors.Register(ModuleName, 12, "proposal queued for enactment")
+	ErrProposalNotQueued       = sdkerrors.Register(ModuleName, 13, "proposal not queued for enactment")
 )
@@ -5,13 +5,18 @@ const (
 	EventTypeProposalSubmit = "proposal_submit"
 	EventTypeProposalClose  = "proposal_close"
 	EventTypeProposalVote   = "proposal_vote"
+	EventTypeProposalQueue  = "proposal_queue"
 
 	AttributeValueCategory          = "committee"
 	AttributeKeyCommitteeID         = "committee_id"
 	AttributeKeyProposalID          = "proposal_id"
 	AttributeKeyProposalCloseStatus = "status"
 	AttributeKeyVoter               = "voter"
+	AttributeKeyVoteOption          = "option"
+	AttributeKeyEnactmentTime       = "enactment_time"
 	AttributeValueProposalPassed    = "proposal_passed"
 	AttributeValueProposalTimeout   = "proposal_timeout"
 	AttributeValueProposalFailed    = "proposal_failed"
+	AttributeValueProposalRejected  = "proposal_rejected"
+	AttributeValueProposalCancelled = "proposal_cancelled"
 )
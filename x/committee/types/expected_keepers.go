@@ -1,9 +1,39 @@
 package types
 
 import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authexported "github.com/cosmos/cosmos-sdk/x/auth/exported"
 	"github.com/cosmos/cosmos-sdk/x/params"
+	stakingexported "github.com/cosmos/cosmos-sdk/x/staking/exported"
+	supplyexported "github.com/cosmos/cosmos-sdk/x/supply/exported"
 )
 
 type ParamKeeper interface {
 	GetSubspace(string) (params.Subspace, bool)
 }
+
+// ParamChangeHistory is implemented by param keepers that also know when committees last changed each param.
+// Permissions limiting how often params change require it.
+type ParamChangeHistory interface {
+	GetParamChangeRecord(ctx sdk.Context, subspace, key string) (ParamChangeRecord, bool)
+}
+
+// AccountKeeper defines the expected account keeper (noalias)
+type AccountKeeper interface {
+	GetAccount(ctx sdk.Context, addr sdk.AccAddress) authexported.Account
+}
+
+// SupplyKeeper defines the expected supply keeper (noalias)
+type SupplyKeeper interface {
+	SendCoinsFromAccountToModule(ctx sdk.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error
+	SendCoinsFromModuleToAccount(ctx sdk.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
+	GetSupply(ctx sdk.Context) supplyexported.SupplyI
+}
+
+// StakingKeeper defines the expected staking keeper (noalias)
+type StakingKeeper interface {
+	IterateDelegations(ctx sdk.Context, delegator sdk.AccAddress,
+		fn func(index int64, delegation stakingexported.DelegationI) (stop bool))
+	Validator(ctx sdk.Context, address sdk.ValAddress) stakingexported.ValidatorI
+	TotalBondedTokens(ctx sdk.Context) sdk.Int
+}
@@ -10,19 +10,23 @@ const DefaultNextProposalID uint64 = 1
 
 // GenesisState is state that must be provided at chain genesis.
 type GenesisState struct {
-	NextProposalID uint64      `json:"next_proposal_id" yaml:"next_proposal_id"`
-	Committees     []Committee `json:"committees" yaml:"committees"`
-	Proposals      []Proposal  `json:"proposals" yaml:"proposals"`
-	Votes          []Vote      `json:"votes" yaml:"votes"`
+	NextProposalID uint64              `json:"next_proposal_id" yaml:"next_proposal_id"`
+	Committees     []Committee         `json:"committees" yaml:"committees"`
+	Proposals      []Proposal          `json:"proposals" yaml:"proposals"`
+	Votes          []Vote              `json:"votes" yaml:"votes"`
+	VotingPowers   []VotingPower       `json:"voting_powers" yaml:"voting_powers"`
+	ParamChanges   []ParamChangeRecord `json:"param_changes" yaml:"param_changes"`
 }
 
 // NewGenesisState returns a new genesis state object for the module.
-func NewGenesisState(nextProposalID uint64, committees []Committee, proposals []Proposal, votes []Vote) GenesisState {
+func NewGenesisState(nextProposalID uint64, committees []Committee, proposals []Proposal, votes []Vote, votingPowers []VotingPower, paramChanges []ParamChangeRecord) GenesisState {
 	return GenesisState{
 		NextProposalID: nextProposalID,
 		Committees:     committees,
 		Proposals:      proposals,
 		Votes:          votes,
+		VotingPowers:   votingPowers,
+		ParamChanges:   paramChanges,
 	}
 }
 
@@ -33,6 +37,8 @@ func DefaultGenesisState() GenesisState {
 		[]Committee{},
 		[]Proposal{},
 		[]Vote{},
+		[]VotingPower{},
+		[]ParamChangeRecord{},
 	)
 }
 
@@ -102,5 +108,32 @@ func (gs GenesisState) Validate() error {
 			return fmt.Errorf("vote refers to non existent proposal; vote: %+v", v)
 		}
 	}
+
+	// validate voting power records
+	for _, vp := range gs.VotingPowers {
+		if err := vp.Validate(); err != nil {
+			return err
+		}
+
+		// check proposal exists
+		if !proposalMap[vp.ProposalID] {
+			return fmt.Errorf("voting power refers to non existent proposal; voting power: %+v", vp)
+		}
+	}
+
+	// validate param change records
+	paramChangeMap := make(map[string]bool, len(gs.ParamChanges))
+	for _, pcr := range gs.ParamChanges {
+		if err := pcr.Validate(); err != nil {
+			return err
+		}
+
+		// check there are no duplicate params
+		key := string(GetParamChangeRecordKey(pcr.Subspace, pcr.Key))
+		if paramChangeMap[key] {
+			return fmt.Errorf("duplicate param change record found in genesis state; param: %s", key)
+		}
+		paramChangeMap[key] = true
+	}
 	return nil
 }
@@ -45,10 +45,22 @@ func TestGenesisState_Validate(t *testing.T) {
 			{ID: 1, CommitteeID: 1, PubProposal: govtypes.NewTextProposal("A Title", "A description of this proposal."), Deadline: testTime.Add(7 * 24 * time.Hour)},
 		},
 		Votes: []Vote{
-			{ProposalID: 1, Voter: addresses[0]},
-			{ProposalID: 1, Voter: addresses[1]},
+			{ProposalID: 1, Voter: addresses[0], Option: OptionYes},
+			{ProposalID: 1, Voter: addresses[1], Option: OptionYes},
+		},
+		VotingPowers: []VotingPower{
+			{ProposalID: 1, Holder: addresses[0], Amount: sdk.NewInt64Coin("hard", 100)},
 		},
 	}
+	tokenCommittee := NewTokenCommittee(
+		3,
+		"This committee is for token holders.",
+		[]Permission{TextPermission{}},
+		NewTokenTally(SourceBalance, "hard", d("0.33")),
+		d("0.5"),
+		time.Hour*24*7,
+		0,
+	)
 
 	testCases := []struct {
 		name       string
@@ -85,6 +97,20 @@ func TestGenesisState_Validate(t *testing.T) {
 			},
 			expectPass: false,
 		},
+		{
+			name: "committee with negative enactment delay",
+			genState: GenesisState{
+				NextProposalID: testGenesis.NextProposalID,
+				Committees: append(testGenesis.Committees[:1:1], func() Committee {
+					com := testGenesis.Committees[1]
+					com.EnactmentDelay = -time.Hour
+					return com
+				}()),
+				Proposals: testGenesis.Proposals,
+				Votes:     testGenesis.Votes,
+			},
+			expectPass: false,
+		},
 		{
 			name: "duplicate proposal IDs",
 			genState: GenesisState{
@@ -151,6 +177,112 @@ func TestGenesisState_Validate(t *testing.T) {
 			},
 			expectPass: false,
 		},
+		{
+			name: "vote without option",
+			genState: GenesisState{
+				NextProposalID: testGenesis.NextProposalID,
+				Committees:     testGenesis.Committees,
+				Proposals:      testGenesis.Proposals,
+				Votes:          append(testGenesis.Votes, Vote{ProposalID: 1, Voter: addresses[2]}),
+			},
+			expectPass: true,
+		},
+		{
+			name: "token committee",
+			genState: GenesisState{
+				NextProposalID: testGenesis.NextProposalID,
+				Committees:     append(testGenesis.Committees, tokenCommittee),
+				Proposals:      testGenesis.Proposals,
+				Votes:          testGenesis.Votes,
+			},
+			expectPass: true,
+		},
+		{
+			name: "token committee with members",
+			genState: GenesisState{
+				NextProposalID: testGenesis.NextProposalID,
+				Committees: append(testGenesis.Committees, func() Committee {
+					com := tokenCommittee
+					com.Members = addresses[:1]
+					return com
+				}()),
+				Proposals: testGenesis.Proposals,
+				Votes:     testGenesis.Votes,
+			},
+			expectPass: false,
+		},
+		{
+			name: "token committee with invalid tally",
+			genState: GenesisState{
+				NextProposalID: testGenesis.NextProposalID,
+				Committees: append(testGenesis.Committees, func() Committee {
+					com := tokenCommittee
+					com.TokenTally = &TokenTally{Source: SourceStaked, Denom: "hard", Quorum: d("0.33")}
+					return com
+				}()),
+				Proposals: testGenesis.Proposals,
+				Votes:     testGenesis.Votes,
+			},
+			expectPass: false,
+		},
+		{
+			name: "voting power without proposal",
+			genState: GenesisState{
+				NextProposalID: testGenesis.NextProposalID,
+				Committees:     testGenesis.Committees,
+				Proposals:      testGenesis.Proposals,
+				Votes:          testGenesis.Votes,
+				VotingPowers:   append(testGenesis.VotingPowers, NewVotingPower(5, addresses[1], sdk.NewInt64Coin("hard", 100))),
+			},
+			expectPass: false,
+		},
+		{
+			name: "invalid voting power",
+			genState: GenesisState{
+				NextProposalID: testGenesis.NextProposalID,
+				Committees:     testGenesis.Committees,
+				Proposals:      testGenesis.Proposals,
+				Votes:          testGenesis.Votes,
+				VotingPowers:   append(testGenesis.VotingPowers, NewVotingPower(1, addresses[1], sdk.NewInt64Coin("hard", 0))),
+			},
+			expectPass: false,
+		},
+		{
+			name: "param change records",
+			genState: GenesisState{
+				NextProposalID: testGenesis.NextProposalID,
+				Committees:     testGenesis.Committees,
+				Proposals:      testGenesis.Proposals,
+				Votes:          testGenesis.Votes,
+				ParamChanges:   []ParamChangeRecord{NewParamChangeRecord("cdp", "CollateralParams", testTime)},
+			},
+			expectPass: true,
+		},
+		{
+			name: "invalid param change record",
+			genState: GenesisState{
+				NextProposalID: testGenesis.NextProposalID,
+				Committees:     testGenesis.Committees,
+				Proposals:      testGenesis.Proposals,
+				Votes:          testGenesis.Votes,
+				ParamChanges:   []ParamChangeRecord{NewParamChangeRecord("cdp", "", testTime)},
+			},
+			expectPass: false,
+		},
+		{
+			name: "duplicate param change records",
+			genState: GenesisState{
+				NextProposalID: testGenesis.NextProposalID,
+				Committees:     testGenesis.Committees,
+				Proposals:      testGenesis.Proposals,
+				Votes:          testGenesis.Votes,
+				ParamChanges: []ParamChangeRecord{
+					NewParamChangeRecord("cdp", "CollateralParams", testTime),
+					NewParamChangeRecord("cdp", "CollateralParams", testTime.Add(time.Hour)),
+				},
+			},
+			expectPass: false,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -30,6 +30,10 @@ var (
 	VoteKeyPrefix      = []byte{0x02} // prefix for keys that store votes
 
 	NextProposalIDKey = []byte{0x03} // key for the next proposal id
+
+	VotingPowerKeyPrefix = []byte{0x04} // prefix for keys that store tokens escrowed by token committee voters
+
+	ParamChangeRecordKeyPrefix = []byte{0x06} // prefix for keys that store the last time committees changed each param
 )
 
 // GetKeyFromID returns the bytes to use as a key for a uint64 id
@@ -41,6 +45,15 @@ func GetVoteKey(proposalID uint64, voter sdk.AccAddress) []byte {
 	return append(GetKeyFromID(proposalID), voter.Bytes()...)
 }
 
+func GetVotingPowerKey(proposalID uint64, holder sdk.AccAddress) []byte {
+	return append(GetKeyFromID(proposalID), holder.Bytes()...)
+}
+
+// GetParamChangeRecordKey returns the key for a param. Subspace names cannot contain '/' so keys are unique.
+func GetParamChangeRecordKey(subspace, key string) []byte {
+	return []byte(subspace + "/" + key)
+}
+
 // Uint64ToBytes converts a uint64 into fixed length bytes for use in store keys.
 func uint64ToBytes(id uint64) []byte {
 	bz := make([]byte, 8)
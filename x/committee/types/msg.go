@@ -61,11 +61,12 @@ func (msg MsgSubmitProposal) GetSigners() []sdk.AccAddress {
 type MsgVote struct {
 	ProposalID uint64         `json:"proposal_id" yaml:"proposal_id"`
 	Voter      sdk.AccAddress `json:"voter" yaml:"voter"`
+	Option     VoteOption     `json:"option" yaml:"option"`
 }
 
 // NewMsgVote creates a message to cast a vote on an active proposal
-func NewMsgVote(voter sdk.AccAddress, proposalID uint64) MsgVote {
-	return MsgVote{proposalID, voter}
+func NewMsgVote(voter sdk.AccAddress, proposalID uint64, option VoteOption) MsgVote {
+	return MsgVote{proposalID, voter, option}
 }
 
 // Route return the message type used for routing the message.
@@ -79,6 +80,9 @@ func (msg MsgVote) ValidateBasic() error {
 	if msg.Voter.Empty() {
 		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "voter address cannot be empty")
 	}
+	if !msg.Option.IsValid() {
+		return sdkerrors.Wrapf(ErrInvalidVoteOption, "%s", msg.Option)
+	}
 	return nil
 }
 
@@ -56,12 +56,32 @@ func TestMsgVote_ValidateBasic(t *testing.T) {
 	}{
 		{
 			name:       "normal",
-			msg:        MsgVote{5, addr},
+			msg:        MsgVote{5, addr, OptionYes},
+			expectPass: true,
+		},
+		{
+			name:       "no vote",
+			msg:        MsgVote{5, addr, OptionNo},
+			expectPass: true,
+		},
+		{
+			name:       "abstain vote",
+			msg:        MsgVote{5, addr, OptionAbstain},
 			expectPass: true,
 		},
 		{
 			name:       "empty address",
-			msg:        MsgVote{5, nil},
+			msg:        MsgVote{5, nil, OptionYes},
+			expectPass: false,
+		},
+		{
+			name:       "empty option",
+			msg:        MsgVote{5, addr, OptionEmpty},
+			expectPass: false,
+		},
+		{
+			name:       "invalid option",
+			msg:        MsgVote{5, addr, VoteOption(0xff)},
 			expectPass: false,
 		},
 	}
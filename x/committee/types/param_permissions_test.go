@@ -1,9 +1,14 @@
 package types
 
 import (
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	auctiontypes "github.com/kava-labs/kava/x/auction/types"
 	bep3types "github.com/kava-labs/kava/x/bep3/types"
 	cdptypes "github.com/kava-labs/kava/x/cdp/types"
+	incentivetypes "github.com/kava-labs/kava/x/incentive/types"
+	kavadisttypes "github.com/kava-labs/kava/x/kavadist/types"
 	pricefeedtypes "github.com/kava-labs/kava/x/pricefeed/types"
 )
 
@@ -396,6 +401,14 @@ func (suite *PermissionsTestSuite) TestAllowedCollateralParam_Allows() {
 	newMarketIDCP.MarketID = "btc:usd"
 	newDebtLimitCP.DebtLimit = c("usdx", 1000)
 
+	newUseTWAPCP := testCP
+	newUseTWAPCP.UseTWAP = true
+
+	newStabilityFeeCP := testCP
+	newStabilityFeeCP.StabilityFee = d("1.000000003022265980") // 10% apr
+
+	stabilityFeeBounds := &DecBounds{Min: d("1"), Max: d("1.000000005"), Window: 24 * time.Hour}
+
 	testcases := []struct {
 		name          string
 		allowed       AllowedCollateralParam
@@ -458,6 +471,84 @@ func (suite *PermissionsTestSuite) TestAllowedCollateralParam_Allows() {
 			incoming:      newMarketIDAndDebtLimitCP,
 			expectAllowed: false,
 		},
+		{
+			name: "allowed use twap change",
+			allowed: AllowedCollateralParam{
+				Denom:   "bnb",
+				UseTWAP: true,
+			},
+			current:       testCP,
+			incoming:      newUseTWAPCP,
+			expectAllowed: true,
+		},
+		{
+			name: "un-allowed use twap change",
+			allowed: AllowedCollateralParam{
+				Denom:     "bnb",
+				DebtLimit: true,
+			},
+			current:       testCP,
+			incoming:      newUseTWAPCP,
+			expectAllowed: false,
+		},
+		{
+			name: "allowed change within bounds",
+			allowed: AllowedCollateralParam{
+				Denom:              "bnb",
+				StabilityFee:       true,
+				StabilityFeeBounds: stabilityFeeBounds,
+			},
+			current:       testCP,
+			incoming:      newStabilityFeeCP,
+			expectAllowed: true,
+		},
+		{
+			name: "un-allowed change outside bounds",
+			allowed: AllowedCollateralParam{
+				Denom:              "bnb",
+				StabilityFee:       true,
+				StabilityFeeBounds: &DecBounds{Min: d("1"), Max: d("1.000000002")},
+			},
+			current:       testCP,
+			incoming:      newStabilityFeeCP,
+			expectAllowed: false,
+		},
+		{
+			name: "un-allowed change with bounds but not allowed",
+			allowed: AllowedCollateralParam{
+				Denom:              "bnb",
+				StabilityFeeBounds: stabilityFeeBounds,
+			},
+			current:       testCP,
+			incoming:      newStabilityFeeCP,
+			expectAllowed: false,
+		},
+		{
+			name: "un-allowed debt limit change larger than max change",
+			allowed: AllowedCollateralParam{
+				Denom:           "bnb",
+				DebtLimit:       true,
+				DebtLimitBounds: &IntBounds{MaxChange: d("0.1")},
+			},
+			current:       testCP,
+			incoming:      newDebtLimitCP,
+			expectAllowed: false,
+		},
+		{
+			name: "un-allowed debt limit denom change with bounds",
+			allowed: AllowedCollateralParam{
+				Denom:           "bnb",
+				DebtLimit:       true,
+				DebtLimitBounds: &IntBounds{MaxChange: d("0.1")},
+			},
+			current: testCP,
+			incoming: func() cdptypes.CollateralParam {
+				cp := testCP
+				cp.DebtLimit = c("usdz", testCP.DebtLimit.Amount.Int64())
+				return cp
+			}(),
+			expectAllowed: false,
+		},
 		// TODO {
 		// 	name: "nil Int values",
 		// 	allowed: AllowedCollateralParam{
@@ -498,6 +589,9 @@ func (suite *PermissionsTestSuite) TestAllowedDebtParam_Allows() {
 	newDenomAndDebtFloorDP.Denom = "usdz"
 	newDenomAndDebtFloorDP.DebtFloor = i(1000)
 
+	newSavingsRateDP := testDP
+	newSavingsRateDP.SavingsRate = d("0.9")
+
 	testcases := []struct {
 		name          string
 		allowed       AllowedDebtParam
@@ -545,6 +639,26 @@ func (suite *PermissionsTestSuite) TestAllowedDebtParam_Allows() {
 			incoming:      newDenomAndDebtFloorDP,
 			expectAllowed: false,
 		},
+		{
+			name: "allowed change within bounds",
+			allowed: AllowedDebtParam{
+				SavingsRate:       true,
+				SavingsRateBounds: &DecBounds{Min: d("0.5"), Max: d("1"), MaxChange: d("0.1")},
+			},
+			current:       testDP,
+			incoming:      newSavingsRateDP,
+			expectAllowed: true,
+		},
+		{
+			name: "un-allowed change outside bounds",
+			allowed: AllowedDebtParam{
+				DebtFloor:       true,
+				DebtFloorBounds: &IntBounds{Min: i(1000000)},
+			},
+			current:       testDP,
+			incoming:      newDebtFloorDP,
+			expectAllowed: false,
+		},
 		// TODO {
 		// 	name: "nil Int values",
 		// 	allowed: AllowedCollateralParam{
@@ -569,10 +683,16 @@ func (suite *PermissionsTestSuite) TestAllowedDebtParam_Allows() {
 
 func (suite *PermissionsTestSuite) TestAllowedAssetParam_Allows() {
 	testAP := bep3types.AssetParam{
-		Denom:  "usdx",
-		CoinID: 999,
-		Limit:  i(1000000000),
-		Active: true,
+		Denom:           "usdx",
+		CoinID:          999,
+		Limit:           i(1000000000),
+		Active:          true,
+		ChainID:         "Binance-Chain-Tigris",
+		AddressFormat:   bep3types.AddressFormatBech32,
+		AddressPrefix:   "bnb",
+		TimeWindow:      24 * time.Hour,
+		TimeWindowLimit: i(100000000),
+		Fee:             bep3types.NewAssetFee(i(1000), 0, i(0)),
 	}
 	newCoinidAP := testAP
 	newCoinidAP.CoinID = 0
@@ -584,6 +704,25 @@ func (suite *PermissionsTestSuite) TestAllowedAssetParam_Allows() {
 	newCoinidAndLimitAP.CoinID = 0
 	newCoinidAndLimitAP.Limit = i(1000)
 
+	newChainAP := testAP
+	newChainAP.ChainID = "ethereum-1"
+	newChainAP.AddressFormat = bep3types.AddressFormatHex
+	newChainAP.AddressPrefix = "0x"
+
+	newTimeWindowAP := testAP
+	newTimeWindowAP.TimeWindow = time.Hour
+	newTimeWindowAP.TimeWindowLimit = i(10000000)
+
+	newFeeAP := testAP
+	newFeeAP.Fee = bep3types.NewAssetFee(i(500), 10, i(1000))
+
+	noTimeWindowAP := testAP
+	noTimeWindowAP.TimeWindow = 0
+	noTimeWindowAP.TimeWindowLimit = i(0)
+
+	nilTimeWindowLimitAP := noTimeWindowAP
+	nilTimeWindowLimitAP.TimeWindowLimit = sdk.Int{}
+
 	testcases := []struct {
 		name          string
 		allowed       AllowedAssetParam
@@ -631,6 +770,87 @@ func (suite *PermissionsTestSuite) TestAllowedAssetParam_Allows() {
 			incoming:      newCoinidAndLimitAP,
 			expectAllowed: false,
 		},
+		{
+			name: "allowed chain change",
+			allowed: AllowedAssetParam{
+				Denom:         "usdx",
+				ChainID:       true,
+				AddressFormat: true,
+			},
+			current:       testAP,
+			incoming:      newChainAP,
+			expectAllowed: true,
+		},
+		{
+			name: "un-allowed address format change",
+			allowed: AllowedAssetParam{
+				Denom:   "usdx",
+				ChainID: true,
+			},
+			current:       testAP,
+			incoming:      newChainAP,
+			expectAllowed: false,
+		},
+		{
+			name: "allowed time window change",
+			allowed: AllowedAssetParam{
+				Denom:           "usdx",
+				TimeWindow:      true,
+				TimeWindowLimit: true,
+			},
+			current:       testAP,
+			incoming:      newTimeWindowAP,
+			expectAllowed: true,
+		},
+		{
+			name: "un-allowed time window limit change",
+			allowed: AllowedAssetParam{
+				Denom:      "usdx",
+				TimeWindow: true,
+			},
+			current:       testAP,
+			incoming:      newTimeWindowAP,
+			expectAllowed: false,
+		},
+		{
+			name: "allowed fee change",
+			allowed: AllowedAssetParam{
+				Denom: "usdx",
+				Fee:   true,
+			},
+			current:       testAP,
+			incoming:      newFeeAP,
+			expectAllowed: true,
+		},
+		{
+			name: "un-allowed fee change",
+			allowed: AllowedAssetParam{
+				Denom: "usdx",
+				Limit: true,
+			},
+			current:       testAP,
+			incoming:      newFeeAP,
+			expectAllowed: false,
+		},
+		{
+			name: "nil time window limit treated as zero",
+			allowed: AllowedAssetParam{
+				Denom: "usdx",
+			},
+			current:       noTimeWindowAP,
+			incoming:      nilTimeWindowLimitAP,
+			expectAllowed: true,
+		},
+		{
+			name: "un-allowed change to nil time window limit",
+			allowed: AllowedAssetParam{
+				Denom:      "usdx",
+				TimeWindow: true,
+			},
+			current:       testAP,
+			incoming:      nilTimeWindowLimitAP,
+			expectAllowed: false,
+		},
 		// TODO {
 		// 	name: "nil Int values",
 		// 	allowed: AllowedCollateralParam{
@@ -671,6 +891,21 @@ func (suite *PermissionsTestSuite) TestAllowedMarket_Allows() {
 	newOraclesAndActiveM.Oracles = nil
 	newOraclesAndActiveM.Active = false
 
+	newMaxBlockDeviationM := testM
+	newMaxBlockDeviationM.MaxBlockDeviation = d("0.1")
+
+	newOracleLimitsM := testM
+	newOracleLimitsM.MaxOracleMisses = 10
+	newOracleLimitsM.OracleOutlierDeviation = d("0.05")
+
+	newAggregationM := testM
+	newAggregationM.AggregationMode = pricefeedtypes.AggregationTrimmedMean
+	newAggregationM.TrimFraction = d("0.2")
+	newAggregationM.MinimumOracles = 3
+
+	newDerivationM := testM
+	newDerivationM.Derivation = pricefeedtypes.NewMarketDerivation(pricefeedtypes.DerivationProduct, "bnb:btc", "btc:usd")
+
 	testcases := []struct {
 		name          string
 		allowed       AllowedMarket
@@ -718,6 +953,90 @@ func (suite *PermissionsTestSuite) TestAllowedMarket_Allows() {
 			incoming:      newOraclesAndActiveM,
 			expectAllowed: false,
 		},
+		{
+			name: "allowed deviation limit change",
+			allowed: AllowedMarket{
+				MarketID:          "bnb:usd",
+				MaxBlockDeviation: true,
+			},
+			current:       testM,
+			incoming:      newMaxBlockDeviationM,
+			expectAllowed: true,
+		},
+		{
+			name: "un-allowed deviation limit change",
+			allowed: AllowedMarket{
+				MarketID: "bnb:usd",
+				Active:   true,
+			},
+			current:       testM,
+			incoming:      newMaxBlockDeviationM,
+			expectAllowed: false,
+		},
+		{
+			name: "allowed oracle limit change",
+			allowed: AllowedMarket{
+				MarketID:               "bnb:usd",
+				MaxOracleMisses:        true,
+				OracleOutlierDeviation: true,
+			},
+			current:       testM,
+			incoming:      newOracleLimitsM,
+			expectAllowed: true,
+		},
+		{
+			name: "un-allowed oracle limit change",
+			allowed: AllowedMarket{
+				MarketID:        "bnb:usd",
+				MaxOracleMisses: true,
+			},
+			current:       testM,
+			incoming:      newOracleLimitsM,
+			expectAllowed: false,
+		},
+		{
+			name: "allowed derivation change",
+			allowed: AllowedMarket{
+				MarketID:   "bnb:usd",
+				Derivation: true,
+			},
+			current:       testM,
+			incoming:      newDerivationM,
+			expectAllowed: true,
+		},
+		{
+			name: "un-allowed derivation change",
+			allowed: AllowedMarket{
+				MarketID: "bnb:usd",
+				Active:   true,
+			},
+			current:       testM,
+			incoming:      newDerivationM,
+			expectAllowed: false,
+		},
+		{
+			name: "allowed aggregation change",
+			allowed: AllowedMarket{
+				MarketID:        "bnb:usd",
+				AggregationMode: true,
+				TrimFraction:    true,
+				MinimumOracles:  true,
+			},
+			current:       testM,
+			incoming:      newAggregationM,
+			expectAllowed: true,
+		},
+		{
+			name: "un-allowed aggregation change",
+			allowed: AllowedMarket{
+				MarketID:        "bnb:usd",
+				AggregationMode: true,
+				TrimFraction:    true,
+			},
+			current:       testM,
+			incoming:      newAggregationM,
+			expectAllowed: false,
+		},
 		// TODO {
 		// 	name: "nil Int values",
 		// 	allowed: AllowedCollateralParam{
@@ -739,3 +1058,759 @@ func (suite *PermissionsTestSuite) TestAllowedMarket_Allows() {
 		})
 	}
 }
+
+func (suite *PermissionsTestSuite) TestAllowedCollateralAuctionParams_Allows() {
+	testCAPs := auctiontypes.CollateralAuctionParams{
+		auctiontypes.NewCollateralAuctionParam("bnb", 6*time.Hour, 10*time.Minute, d("0.01")),
+		auctiontypes.NewCollateralAuctionParam("btc", 24*time.Hour, 1*time.Hour, d("0.05")),
+		auctiontypes.NewCollateralAuctionParam("xrp", 24*time.Hour, 1*time.Hour, d("0.05")),
+	}
+	updatedTestCAPs := make(auctiontypes.CollateralAuctionParams, len(testCAPs))
+	updatedTestCAPs[0] = testCAPs[1]
+	updatedTestCAPs[1] = testCAPs[0]
+	updatedTestCAPs[2] = testCAPs[2]
+
+	updatedTestCAPs[0].BidDuration = 30 * time.Minute      // btc
+	updatedTestCAPs[1].Increment = d("0.02")               // bnb
+	updatedTestCAPs[2].MaxAuctionDuration = 12 * time.Hour // xrp
+	updatedTestCAPs[2].Increment = d("0.03")               // xrp
+
+	testcases := []struct {
+		name          string
+		allowed       AllowedCollateralAuctionParams
+		current       auctiontypes.CollateralAuctionParams
+		incoming      auctiontypes.CollateralAuctionParams
+		expectAllowed bool
+	}{
+		{
+			name: "disallowed add",
+			allowed: AllowedCollateralAuctionParams{
+				{
+					Denom:     "bnb",
+					Increment: true,
+				},
+				{
+					Denom:       "btc",
+					BidDuration: true,
+				},
+				{ // allow all fields
+					Denom:              "xrp",
+					MaxAuctionDuration: true,
+					BidDuration:        true,
+					Increment:          true,
+				},
+			},
+			current:       testCAPs[:2],
+			incoming:      testCAPs[:3],
+			expectAllowed: false,
+		},
+		{
+			name: "disallowed remove",
+			allowed: AllowedCollateralAuctionParams{
+				{
+					Denom:     "bnb",
+					Increment: true,
+				},
+				{ // allow all fields
+					Denom:              "btc",
+					MaxAuctionDuration: true,
+					BidDuration:        true,
+					Increment:          true,
+				},
+			},
+			current:       testCAPs[:2],
+			incoming:      testCAPs[:1], // removes btc
+			expectAllowed: false,
+		},
+		{
+			name: "allowed change with different order",
+			allowed: AllowedCollateralAuctionParams{
+				{
+					Denom:     "bnb",
+					Increment: true,
+				},
+				{
+					Denom:       "btc",
+					BidDuration: true,
+				},
+				{
+					Denom:              "xrp",
+					MaxAuctionDuration: true,
+					Increment:          true,
+				},
+			},
+			current:       testCAPs,
+			incoming:      updatedTestCAPs,
+			expectAllowed: true,
+		},
+		{
+			name: "disallowed change",
+			allowed: AllowedCollateralAuctionParams{
+				{
+					Denom:     "bnb",
+					Increment: true,
+				},
+				{
+					Denom: "btc",
+				},
+				{
+					Denom:              "xrp",
+					MaxAuctionDuration: true,
+					Increment:          true,
+				},
+			},
+			current:       testCAPs,
+			incoming:      updatedTestCAPs,
+			expectAllowed: false,
+		},
+	}
+	for _, tc := range testcases {
+		suite.Run(tc.name, func() {
+			suite.Require().Equal(
+				tc.expectAllowed,
+				tc.allowed.Allows(tc.current, tc.incoming),
+			)
+		})
+	}
+}
+
+func (suite *PermissionsTestSuite) TestAllowedCollateralAuctionParam_Allows() {
+	testCAP := auctiontypes.NewCollateralAuctionParam("bnb", 6*time.Hour, 10*time.Minute, d("0.01"))
+
+	newBidDurationCAP := testCAP
+	newBidDurationCAP.BidDuration = 30 * time.Minute
+
+	newIncrementCAP := testCAP
+	newIncrementCAP.Increment = d("0.02")
+
+	newBidDurationAndIncrementCAP := testCAP
+	newBidDurationAndIncrementCAP.BidDuration = 30 * time.Minute
+	newBidDurationAndIncrementCAP.Increment = d("0.02")
+
+	newDenomCAP := testCAP
+	newDenomCAP.Denom = "btc"
+
+	testcases := []struct {
+		name          string
+		allowed       AllowedCollateralAuctionParam
+		current       auctiontypes.CollateralAuctionParam
+		incoming      auctiontypes.CollateralAuctionParam
+		expectAllowed bool
+	}{
+		{
+			name: "allowed change",
+			allowed: AllowedCollateralAuctionParam{
+				Denom:       "bnb",
+				BidDuration: true,
+			},
+			current:       testCAP,
+			incoming:      newBidDurationCAP,
+			expectAllowed: true,
+		},
+		{
+			name: "un-allowed change",
+			allowed: AllowedCollateralAuctionParam{
+				Denom:       "bnb",
+				BidDuration: true,
+			},
+			current:       testCAP,
+			incoming:      newIncrementCAP,
+			expectAllowed: false,
+		},
+		{
+			name: "allowed no change",
+			allowed: AllowedCollateralAuctionParam{
+				Denom:       "bnb",
+				BidDuration: true,
+			},
+			current:       testCAP,
+			incoming:      testCAP, // no change
+			expectAllowed: true,
+		},
+		{
+			name: "un-allowed change with allowed change",
+			allowed: AllowedCollateralAuctionParam{
+				Denom:       "bnb",
+				BidDuration: true,
+			},
+			current:       testCAP,
+			incoming:      newBidDurationAndIncrementCAP,
+			expectAllowed: false,
+		},
+		{
+			name: "un-allowed denom change",
+			allowed: AllowedCollateralAuctionParam{
+				Denom:              "bnb",
+				MaxAuctionDuration: true,
+				BidDuration:        true,
+				Increment:          true,
+			},
+			current:       testCAP,
+			incoming:      newDenomCAP,
+			expectAllowed: false,
+		},
+	}
+
+	for _, tc := range testcases {
+		suite.Run(tc.name, func() {
+			suite.Require().Equal(
+				tc.expectAllowed,
+				tc.allowed.Allows(tc.current, tc.incoming),
+			)
+		})
+	}
+}
+
+func (suite *PermissionsTestSuite) TestAllowedAuctionParams_Allows() {
+	testParams := auctiontypes.DefaultParams()
+
+	newBidDurationParams := testParams
+	newBidDurationParams.BidDurationDebt = 30 * time.Minute
+
+	newIncrementParams := testParams
+	newIncrementParams.IncrementSurplus = d("0.02")
+
+	newSurplusRecipientParams := testParams
+	newSurplusRecipientParams.SurplusMode = auctiontypes.SurplusModeModuleAccount
+	newSurplusRecipientParams.SurplusRecipient = "kavadist"
+
+	newCollateralAuctionParams := testParams
+	newCollateralAuctionParams.CollateralAuctionParams = auctiontypes.CollateralAuctionParams{
+		auctiontypes.NewCollateralAuctionParam("bnb", 6*time.Hour, 10*time.Minute, d("0.01")),
+	}
+
+	testcases := []struct {
+		name          string
+		allowed       AllowedAuctionParams
+		current       auctiontypes.Params
+		incoming      auctiontypes.Params
+		expectAllowed bool
+	}{
+		{
+			name: "allowed change",
+			allowed: AllowedAuctionParams{
+				BidDurationDebt: true,
+			},
+			current:       testParams,
+			incoming:      newBidDurationParams,
+			expectAllowed: true,
+		},
+		{
+			name: "un-allowed change",
+			allowed: AllowedAuctionParams{
+				BidDurationDebt: true,
+			},
+			current:       testParams,
+			incoming:      newIncrementParams,
+			expectAllowed: false,
+		},
+		{
+			name:          "allowed no change",
+			allowed:       AllowedAuctionParams{},
+			current:       testParams,
+			incoming:      testParams, // no change
+			expectAllowed: true,
+		},
+		{
+			name: "allowed surplus mode and recipient change",
+			allowed: AllowedAuctionParams{
+				SurplusMode: true,
+			},
+			current:       testParams,
+			incoming:      newSurplusRecipientParams,
+			expectAllowed: true,
+		},
+		{
+			name:          "un-allowed surplus mode and recipient change",
+			allowed:       AllowedAuctionParams{},
+			current:       testParams,
+			incoming:      newSurplusRecipientParams,
+			expectAllowed: false,
+		},
+		{
+			name:          "collateral auction params are ignored",
+			allowed:       AllowedAuctionParams{},
+			current:       testParams,
+			incoming:      newCollateralAuctionParams,
+			expectAllowed: true,
+		},
+	}
+
+	for _, tc := range testcases {
+		suite.Run(tc.name, func() {
+			suite.Require().Equal(
+				tc.expectAllowed,
+				tc.allowed.Allows(tc.current, tc.incoming),
+			)
+		})
+	}
+}
+
+func (suite *PermissionsTestSuite) TestAllowedRewards_Allows() {
+	testRs := incentivetypes.Rewards{
+		incentivetypes.NewReward(true, "bnb", c("ukava", 1000000), 24*time.Hour, 30*24*time.Hour, 7*24*time.Hour),
+		incentivetypes.NewReward(true, "btc", c("ukava", 500000), 24*time.Hour, 30*24*time.Hour, 7*24*time.Hour),
+		incentivetypes.NewReward(false, "xrp", c("ukava", 100000), 24*time.Hour, 30*24*time.Hour, 7*24*time.Hour),
+	}
+	updatedTestRs := make(incentivetypes.Rewards, len(testRs))
+	updatedTestRs[0] = testRs[1]
+	updatedTestRs[1] = testRs[0]
+	updatedTestRs[2] = testRs[2]
+
+	updatedTestRs[0].AvailableRewards = c("ukava", 250000) // btc
+	updatedTestRs[1].Active = false                        // bnb
+	updatedTestRs[2].Active = true                         // xrp
+	updatedTestRs[2].ClaimDuration = 14 * 24 * time.Hour   // xrp
+
+	testcases := []struct {
+		name          string
+		allowed       AllowedRewards
+		current       incentivetypes.Rewards
+		incoming      incentivetypes.Rewards
+		expectAllowed bool
+	}{
+		{
+			name: "disallowed add",
+			allowed: AllowedRewards{
+				{
+					Denom:  "bnb",
+					Active: true,
+				},
+				{
+					Denom:            "btc",
+					AvailableRewards: true,
+				},
+				{ // allow all fields
+					Denom:            "xrp",
+					Active:           true,
+					AvailableRewards: true,
+					Duration:         true,
+					TimeLock:         true,
+					ClaimDuration:    true,
+				},
+			},
+			current:       testRs[:2],
+			incoming:      testRs[:3],
+			expectAllowed: false,
+		},
+		{
+			name: "disallowed remove",
+			allowed: AllowedRewards{
+				{
+					Denom:  "bnb",
+					Active: true,
+				},
+				{ // allow all fields
+					Denom:            "btc",
+					Active:           true,
+					AvailableRewards: true,
+					Duration:         true,
+					TimeLock:         true,
+					ClaimDuration:    true,
+				},
+			},
+			current:       testRs[:2],
+			incoming:      testRs[:1], // removes btc
+			expectAllowed: false,
+		},
+		{
+			name: "allowed change with different order",
+			allowed: AllowedRewards{
+				{
+					Denom:  "bnb",
+					Active: true,
+				},
+				{
+					Denom:            "btc",
+					AvailableRewards: true,
+				},
+				{
+					Denom:         "xrp",
+					Active:        true,
+					ClaimDuration: true,
+				},
+			},
+			current:       testRs,
+			incoming:      updatedTestRs,
+			expectAllowed: true,
+		},
+		{
+			name: "disallowed change",
+			allowed: AllowedRewards{
+				{
+					Denom:  "bnb",
+					Active: true,
+				},
+				{
+					Denom: "btc",
+				},
+				{
+					Denom:         "xrp",
+					Active:        true,
+					ClaimDuration: true,
+				},
+			},
+			current:       testRs,
+			incoming:      updatedTestRs,
+			expectAllowed: false,
+		},
+	}
+	for _, tc := range testcases {
+		suite.Run(tc.name, func() {
+			suite.Require().Equal(
+				tc.expectAllowed,
+				tc.allowed.Allows(tc.current, tc.incoming),
+			)
+		})
+	}
+}
+
+func (suite *PermissionsTestSuite) TestAllowedReward_Allows() {
+	testR := incentivetypes.NewReward(true, "bnb", c("ukava", 1000000), 24*time.Hour, 30*24*time.Hour, 7*24*time.Hour)
+
+	newActiveR := testR
+	newActiveR.Active = false
+
+	newAvailableRewardsR := testR
+	newAvailableRewardsR.AvailableRewards = c("ukava", 2000000)
+
+	newRewardDenomR := testR
+	newRewardDenomR.AvailableRewards = c("hard", 1000000)
+
+	newDenomR := testR
+	newDenomR.Denom = "btc"
+
+	testcases := []struct {
+		name          string
+		allowed       AllowedReward
+		current       incentivetypes.Reward
+		incoming      incentivetypes.Reward
+		expectAllowed bool
+	}{
+		{
+			name: "allowed change",
+			allowed: AllowedReward{
+				Denom:  "bnb",
+				Active: true,
+			},
+			current:       testR,
+			incoming:      newActiveR,
+			expectAllowed: true,
+		},
+		{
+			name: "un-allowed change",
+			allowed: AllowedReward{
+				Denom:  "bnb",
+				Active: true,
+			},
+			current:       testR,
+			incoming:      newAvailableRewardsR,
+			expectAllowed: false,
+		},
+		{
+			name: "un-allowed reward denom change",
+			allowed: AllowedReward{
+				Denom:  "bnb",
+				Active: true,
+			},
+			current:       testR,
+			incoming:      newRewardDenomR,
+			expectAllowed: false,
+		},
+		{
+			name: "allowed no change",
+			allowed: AllowedReward{
+				Denom:  "bnb",
+				Active: true,
+			},
+			current:       testR,
+			incoming:      testR, // no change
+			expectAllowed: true,
+		},
+		{
+			name: "un-allowed denom change",
+			allowed: AllowedReward{
+				Denom:            "bnb",
+				Active:           true,
+				AvailableRewards: true,
+				Duration:         true,
+				TimeLock:         true,
+				ClaimDuration:    true,
+			},
+			current:       testR,
+			incoming:      newDenomR,
+			expectAllowed: false,
+		},
+	}
+
+	for _, tc := range testcases {
+		suite.Run(tc.name, func() {
+			suite.Require().Equal(
+				tc.expectAllowed,
+				tc.allowed.Allows(tc.current, tc.incoming),
+			)
+		})
+	}
+}
+
+func (suite *PermissionsTestSuite) TestAllowedKavadistPeriods_Allows() {
+	firstStart := time.Date(2020, time.March, 1, 1, 0, 0, 0, time.UTC)
+	testPs := kavadisttypes.Periods{
+		kavadisttypes.NewPeriod(firstStart, firstStart.Add(30*24*time.Hour), d("1.000000003022265980")),
+		kavadisttypes.NewPeriod(firstStart.Add(30*24*time.Hour), firstStart.Add(60*24*time.Hour), d("1.000000002659864411")),
+	}
+	updatedTestPs := make(kavadisttypes.Periods, len(testPs))
+	copy(updatedTestPs, testPs)
+	updatedTestPs[1].Inflation = d("1.000000001")
+
+	movedStartTestPs := make(kavadisttypes.Periods, len(testPs))
+	copy(movedStartTestPs, testPs)
+	movedStartTestPs[1].Start = firstStart.Add(31 * 24 * time.Hour)
+
+	testcases := []struct {
+		name          string
+		allowed       AllowedKavadistPeriods
+		current       kavadisttypes.Periods
+		incoming      kavadisttypes.Periods
+		expectAllowed bool
+	}{
+		{
+			name: "disallowed add",
+			allowed: AllowedKavadistPeriods{
+				{Start: testPs[0].Start, End: true, Inflation: true},
+				{Start: testPs[1].Start, End: true, Inflation: true},
+			},
+			current:       testPs[:1],
+			incoming:      testPs,
+			expectAllowed: false,
+		},
+		{
+			name: "disallowed remove",
+			allowed: AllowedKavadistPeriods{
+				{Start: testPs[0].Start, End: true, Inflation: true},
+				{Start: testPs[1].Start, End: true, Inflation: true},
+			},
+			current:       testPs,
+			incoming:      testPs[:1],
+			expectAllowed: false,
+		},
+		{
+			name: "allowed change",
+			allowed: AllowedKavadistPeriods{
+				{Start: testPs[0].Start},
+				{Start: testPs[1].Start, Inflation: true},
+			},
+			current:       testPs,
+			incoming:      updatedTestPs,
+			expectAllowed: true,
+		},
+		{
+			name: "disallowed change",
+			allowed: AllowedKavadistPeriods{
+				{Start: testPs[0].Start, Inflation: true},
+				{Start: testPs[1].Start, End: true},
+			},
+			current:       testPs,
+			incoming:      updatedTestPs,
+			expectAllowed: false,
+		},
+		{
+			name: "disallowed start change",
+			allowed: AllowedKavadistPeriods{
+				{Start: testPs[0].Start, End: true, Inflation: true},
+				{Start: testPs[1].Start, End: true, Inflation: true},
+			},
+			current:       testPs,
+			incoming:      movedStartTestPs,
+			expectAllowed: false,
+		},
+	}
+	for _, tc := range testcases {
+		suite.Run(tc.name, func() {
+			suite.Require().Equal(
+				tc.expectAllowed,
+				tc.allowed.Allows(tc.current, tc.incoming),
+			)
+		})
+	}
+}
+
+func (suite *PermissionsTestSuite) TestDecBounds_Allows() {
+	testcases := []struct {
+		name          string
+		bounds        *DecBounds
+		current       sdk.Dec
+		incoming      sdk.Dec
+		expectAllowed bool
+	}{
+		{
+			name:          "nil bounds",
+			bounds:        nil,
+			current:       d("0.05"),
+			incoming:      d("100"),
+			expectAllowed: true,
+		},
+		{
+			name:          "empty bounds",
+			bounds:        &DecBounds{},
+			current:       d("0.05"),
+			incoming:      d("100"),
+			expectAllowed: true,
+		},
+		{
+			name:          "within min and max",
+			bounds:        &DecBounds{Min: d("0.01"), Max: d("0.2")},
+			current:       d("0.05"),
+			incoming:      d("0.2"),
+			expectAllowed: true,
+		},
+		{
+			name:          "below min",
+			bounds:        &DecBounds{Min: d("0.01"), Max: d("0.2")},
+			current:       d("0.05"),
+			incoming:      d("0.009"),
+			expectAllowed: false,
+		},
+		{
+			name:          "above max",
+			bounds:        &DecBounds{Min: d("0.01"), Max: d("0.2")},
+			current:       d("0.05"),
+			incoming:      d("0.21"),
+			expectAllowed: false,
+		},
+		{
+			name:          "within max change",
+			bounds:        &DecBounds{MaxChange: d("0.1")},
+			current:       d("0.05"),
+			incoming:      d("0.045"),
+			expectAllowed: true,
+		},
+		{
+			name:          "above max change",
+			bounds:        &DecBounds{MaxChange: d("0.1")},
+			current:       d("0.05"),
+			incoming:      d("0.0551"),
+			expectAllowed: false,
+		},
+		{
+			name:          "max change from zero",
+			bounds:        &DecBounds{MaxChange: d("0.1")},
+			current:       d("0"),
+			incoming:      d("0.01"),
+			expectAllowed: false,
+		},
+		{
+			name:          "within max absolute change from zero",
+			bounds:        &DecBounds{MaxChange: d("0.1"), MaxAbsoluteChange: d("0.01")},
+			current:       d("0"),
+			incoming:      d("0.01"),
+			expectAllowed: true,
+		},
+		{
+			name:          "above max absolute change from zero",
+			bounds:        &DecBounds{MaxChange: d("0.1"), MaxAbsoluteChange: d("0.01")},
+			current:       d("0"),
+			incoming:      d("0.0101"),
+			expectAllowed: false,
+		},
+		{
+			name:          "relative change above max absolute change",
+			bounds:        &DecBounds{MaxChange: d("0.1"), MaxAbsoluteChange: d("0.01")},
+			current:       d("0.5"),
+			incoming:      d("0.55"),
+			expectAllowed: true,
+		},
+	}
+
+	for _, tc := range testcases {
+		suite.Run(tc.name, func() {
+			suite.Require().Equal(
+				tc.expectAllowed,
+				tc.bounds.Allows(tc.current, tc.incoming),
+			)
+		})
+	}
+}
+
+func (suite *PermissionsTestSuite) TestIntBounds_Allows() {
+	testcases := []struct {
+		name          string
+		bounds        *IntBounds
+		current       sdk.Int
+		incoming      sdk.Int
+		expectAllowed bool
+	}{
+		{
+			name:          "nil bounds",
+			bounds:        nil,
+			current:       i(1000),
+			incoming:      i(1000000),
+			expectAllowed: true,
+		},
+		{
+			name:          "within min and max",
+			bounds:        &IntBounds{Min: i(500), Max: i(2000)},
+			current:       i(1000),
+			incoming:      i(500),
+			expectAllowed: true,
+		},
+		{
+			name:          "below min",
+			bounds:        &IntBounds{Min: i(500), Max: i(2000)},
+			current:       i(1000),
+			incoming:      i(499),
+			expectAllowed: false,
+		},
+		{
+			name:          "above max",
+			bounds:        &IntBounds{Min: i(500), Max: i(2000)},
+			current:       i(1000),
+			incoming:      i(2001),
+			expectAllowed: false,
+		},
+		{
+			name:          "within max change",
+			bounds:        &IntBounds{MaxChange: d("0.25")},
+			current:       i(1000),
+			incoming:      i(1250),
+			expectAllowed: true,
+		},
+		{
+			name:          "above max change",
+			bounds:        &IntBounds{MaxChange: d("0.25")},
+			current:       i(1000),
+			incoming:      i(749),
+			expectAllowed: false,
+		},
+		{
+			name:          "max change from zero",
+			bounds:        &IntBounds{MaxChange: d("0.25")},
+			current:       i(0),
+			incoming:      i(1),
+			expectAllowed: false,
+		},
+		{
+			name:          "within max absolute change from zero",
+			bounds:        &IntBounds{MaxChange: d("0.25"), MaxAbsoluteChange: i(100)},
+			current:       i(0),
+			incoming:      i(100),
+			expectAllowed: true,
+		},
+		{
+			name:          "above max absolute change",
+			bounds:        &IntBounds{MaxAbsoluteChange: i(100)},
+			current:       i(1000),
+			incoming:      i(1101),
+			expectAllowed: false,
+		},
+	}
+
+	for _, tc := range testcases {
+		suite.Run(tc.name, func() {
+			suite.Require().Equal(
+				tc.expectAllowed,
+				tc.bounds.Allows(tc.current, tc.incoming),
+			)
+		})
+	}
+}
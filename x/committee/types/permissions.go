@@ -1,14 +1,20 @@
 package types
 
 import (
+	"fmt"
+	"time"
+
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
 	upgrade "github.com/cosmos/cosmos-sdk/x/upgrade"
 
+	auctiontypes "github.com/kava-labs/kava/x/auction/types"
 	bep3types "github.com/kava-labs/kava/x/bep3/types"
 	cdptypes "github.com/kava-labs/kava/x/cdp/types"
+	incentivetypes "github.com/kava-labs/kava/x/incentive/types"
+	kavadisttypes "github.com/kava-labs/kava/x/kavadist/types"
 	"github.com/kava-labs/kava/x/pricefeed"
 	pricefeedtypes "github.com/kava-labs/kava/x/pricefeed/types"
 )
@@ -21,7 +27,9 @@ func init() {
 	govtypes.RegisterProposalTypeCodec(SimpleParamChangePermission{}, "kava/SimpleParamChangePermission")
 	govtypes.RegisterProposalTypeCodec(TextPermission{}, "kava/TextPermission")
 	govtypes.RegisterProposalTypeCodec(SoftwareUpgradePermission{}, "kava/SoftwareUpgradePermission")
+	govtypes.RegisterProposalTypeCodec(ConfirmPricePermission{}, "kava/ConfirmPricePermission")
 	govtypes.RegisterProposalTypeCodec(SubParamChangePermission{}, "kava/SubParamChangePermission")
+	govtypes.RegisterProposalTypeCodec(CancelPermission{}, "kava/CancelPermission")
 }
 
 // Permission is anything with a method that validates whether a proposal is allowed by it or not.
@@ -144,36 +152,95 @@ func (SoftwareUpgradePermission) MarshalYAML() (interface{}, error) {
 	return valueToMarshal, nil
 }
 
+// ------------------------------------------
+//				ConfirmPricePermission
+// ------------------------------------------
+
+// ConfirmPricePermission allows any pricefeed proposal confirming the price of an unstable market.
+type ConfirmPricePermission struct{}
+
+var _ Permission = ConfirmPricePermission{}
+
+func (ConfirmPricePermission) Allows(_ sdk.Context, _ *codec.Codec, _ ParamKeeper, p PubProposal) bool {
+	_, ok := p.(pricefeedtypes.ConfirmPriceProposal)
+	return ok
+}
+
+func (ConfirmPricePermission) MarshalYAML() (interface{}, error) {
+	valueToMarshal := struct {
+		Type string `yaml:"type"`
+	}{
+		Type: "confirm_price_permission",
+	}
+	return valueToMarshal, nil
+}
+
+// ------------------------------------------
+//				CancelPermission
+// ------------------------------------------
+
+// CancelPermission allows proposals cancelling committee proposals that are queued for enactment.
+// Committees with this permission act as guardians over other committees' enactment delays.
+type CancelPermission struct{}
+
+var _ Permission = CancelPermission{}
+
+func (CancelPermission) Allows(_ sdk.Context, _ *codec.Codec, _ ParamKeeper, p PubProposal) bool {
+	_, ok := p.(CommitteeCancelProposal)
+	return ok
+}
+
+func (CancelPermission) MarshalYAML() (interface{}, error) {
+	valueToMarshal := struct {
+		Type string `yaml:"type"`
+	}{
+		Type: "cancel_permission",
+	}
+	return valueToMarshal, nil
+}
+
 // ------------------------------------------
 //				SubParamChangePermission
 // ------------------------------------------
 
 // ParamChangeProposal only allows changes to certain params
 type SubParamChangePermission struct {
-	AllowedParams           AllowedParams           `json:"allowed_params" yaml:"allowed_params"`
-	AllowedCollateralParams AllowedCollateralParams `json:"allowed_collateral_params" yaml:"allowed_collateral_params"`
-	AllowedDebtParam        AllowedDebtParam        `json:"allowed_debt_param" yaml:"allowed_debt_param"`
-	AllowedAssetParams      AllowedAssetParams      `json:"allowed_asset_params" yaml:"allowed_asset_params"`
-	AllowedMarkets          AllowedMarkets          `json:"allowed_markets" yaml:"allowed_markets"`
+	AllowedParams                  AllowedParams                  `json:"allowed_params" yaml:"allowed_params"`
+	AllowedCollateralParams        AllowedCollateralParams        `json:"allowed_collateral_params" yaml:"allowed_collateral_params"`
+	AllowedDebtParam               AllowedDebtParam               `json:"allowed_debt_param" yaml:"allowed_debt_param"`
+	AllowedAssetParams             AllowedAssetParams             `json:"allowed_asset_params" yaml:"allowed_asset_params"`
+	AllowedMarkets                 AllowedMarkets                 `json:"allowed_markets" yaml:"allowed_markets"`
+	AllowedCollateralAuctionParams AllowedCollateralAuctionParams `json:"allowed_collateral_auction_params" yaml:"allowed_collateral_auction_params"`
+	AllowedAuctionParams           AllowedAuctionParams           `json:"allowed_auction_params" yaml:"allowed_auction_params"`
+	AllowedRewards                 AllowedRewards                 `json:"allowed_rewards" yaml:"allowed_rewards"`
+	AllowedKavadistPeriods         AllowedKavadistPeriods         `json:"allowed_kavadist_periods" yaml:"allowed_kavadist_periods"`
 }
 
 var _ Permission = SubParamChangePermission{}
 
 func (perm SubParamChangePermission) MarshalYAML() (interface{}, error) {
 	valueToMarshal := struct {
-		Type                    string                  `yaml:"type"`
-		AllowedParams           AllowedParams           `yaml:"allowed_params"`
-		AllowedCollateralParams AllowedCollateralParams `yaml:"allowed_collateral_params"`
-		AllowedDebtParam        AllowedDebtParam        `yaml:"allowed_debt_param"`
-		AllowedAssetParams      AllowedAssetParams      `yaml:"allowed_asset_params"`
-		AllowedMarkets          AllowedMarkets          `yaml:"allowed_markets"`
+		Type                           string                         `yaml:"type"`
+		AllowedParams                  AllowedParams                  `yaml:"allowed_params"`
+		AllowedCollateralParams        AllowedCollateralParams        `yaml:"allowed_collateral_params"`
+		AllowedDebtParam               AllowedDebtParam               `yaml:"allowed_debt_param"`
+		AllowedAssetParams             AllowedAssetParams             `yaml:"allowed_asset_params"`
+		AllowedMarkets                 AllowedMarkets                 `yaml:"allowed_markets"`
+		AllowedCollateralAuctionParams AllowedCollateralAuctionParams `yaml:"allowed_collateral_auction_params"`
+		AllowedAuctionParams           AllowedAuctionParams           `yaml:"allowed_auction_params"`
+		AllowedRewards                 AllowedRewards                 `yaml:"allowed_rewards"`
+		AllowedKavadistPeriods         AllowedKavadistPeriods         `yaml:"allowed_kavadist_periods"`
 	}{
-		Type:                    "param_change_permission",
-		AllowedParams:           perm.AllowedParams,
-		AllowedCollateralParams: perm.AllowedCollateralParams,
-		AllowedDebtParam:        perm.AllowedDebtParam,
-		AllowedAssetParams:      perm.AllowedAssetParams,
-		AllowedMarkets:          perm.AllowedMarkets,
+		Type:                           "param_change_permission",
+		AllowedParams:                  perm.AllowedParams,
+		AllowedCollateralParams:        perm.AllowedCollateralParams,
+		AllowedDebtParam:               perm.AllowedDebtParam,
+		AllowedAssetParams:             perm.AllowedAssetParams,
+		AllowedMarkets:                 perm.AllowedMarkets,
+		AllowedCollateralAuctionParams: perm.AllowedCollateralAuctionParams,
+		AllowedAuctionParams:           perm.AllowedAuctionParams,
+		AllowedRewards:                 perm.AllowedRewards,
+		AllowedKavadistPeriods:         perm.AllowedKavadistPeriods,
 	}
 	return valueToMarshal, nil
 }
@@ -220,6 +287,11 @@ func (perm SubParamChangePermission) Allows(ctx sdk.Context, appCdc *codec.Codec
 		if !collateralParamChangesAllowed {
 			return false
 		}
+
+		// Check bounded fields have not been changed too recently
+		if perm.AllowedCollateralParams.changedWithinWindow(ctx, pk, currentCP, incomingCP) {
+			return false
+		}
 	}
 
 	// Check any DebtParam changes are allowed
@@ -252,6 +324,11 @@ func (perm SubParamChangePermission) Allows(ctx sdk.Context, appCdc *codec.Codec
 		if !debtParamChangeAllowed {
 			return false
 		}
+
+		// Check bounded fields have not been changed too recently
+		if perm.AllowedDebtParam.changedWithinWindow(ctx, pk, currentDP, incomingDP) {
+			return false
+		}
 	}
 
 	// Check any AssetParams changes are allowed
@@ -318,6 +395,147 @@ func (perm SubParamChangePermission) Allows(ctx sdk.Context, appCdc *codec.Codec
 		}
 	}
 
+	// Check any CollateralAuctionParams changes are allowed
+
+	// Get the incoming CollateralAuctionParams value
+	var foundIncomingCAPs bool
+	var incomingCAPs auctiontypes.CollateralAuctionParams
+	for _, change := range proposal.Changes {
+		if !(change.Subspace == auctiontypes.ModuleName && change.Key == string(auctiontypes.KeyCollateralAuctionParams)) {
+			continue
+		}
+		// note: in case of duplicates take the last value
+		foundIncomingCAPs = true
+		if err := appCdc.UnmarshalJSON([]byte(change.Value), &incomingCAPs); err != nil {
+			return false // invalid json value, so just disallow
+		}
+	}
+	// only check if there was a proposed change
+	if foundIncomingCAPs {
+		// Get the current value of the CollateralAuctionParams
+		subspace, found := pk.GetSubspace(auctiontypes.ModuleName)
+		if !found {
+			return false // not using a panic to help avoid begin blocker panics
+		}
+		// the key is unset on chains upgraded from before it was added, treat that as an empty list
+		var currentCAPs auctiontypes.CollateralAuctionParams
+		subspace.GetIfExists(ctx, auctiontypes.KeyCollateralAuctionParams, &currentCAPs)
+
+		// Check all the incoming changes in the CollateralAuctionParams are allowed
+		collateralAuctionParamsChangesAllowed := perm.AllowedCollateralAuctionParams.Allows(currentCAPs, incomingCAPs)
+		if !collateralAuctionParamsChangesAllowed {
+			return false
+		}
+	}
+
+	// Check any other auction param changes are allowed
+
+	// Auction params are stored under individual keys, so check if any (other than the CollateralAuctionParams) are changed
+	var foundIncomingAuctionParams bool
+	for _, change := range proposal.Changes {
+		if change.Subspace == auctiontypes.ModuleName && change.Key != string(auctiontypes.KeyCollateralAuctionParams) {
+			foundIncomingAuctionParams = true
+		}
+	}
+	// only check if there was a proposed change
+	if foundIncomingAuctionParams {
+		// Get the current value of the auction Params
+		subspace, found := pk.GetSubspace(auctiontypes.ModuleName)
+		if !found {
+			return false // not using a panic to help avoid begin blocker panics
+		}
+		// read the params as the auction module does, including the legacy duration fallback
+		currentAuctionParams := auctiontypes.GetParamsFromSubspace(ctx, subspace)
+
+		// Get the incoming value of the auction Params by applying the changes to the current value
+		incomingAuctionParams := currentAuctionParams
+		incomingPairs := incomingAuctionParams.ParamSetPairs()
+		for _, change := range proposal.Changes {
+			if change.Subspace != auctiontypes.ModuleName {
+				continue
+			}
+			for _, pair := range incomingPairs {
+				if change.Key != string(pair.Key) {
+					continue
+				}
+				// note: in case of duplicates take the last value
+				if err := appCdc.UnmarshalJSON([]byte(change.Value), pair.Value); err != nil {
+					return false // invalid json value, so just disallow
+				}
+			}
+		}
+
+		// Check all the incoming changes in the auction Params are allowed
+		auctionParamsChangesAllowed := perm.AllowedAuctionParams.Allows(currentAuctionParams, incomingAuctionParams)
+		if !auctionParamsChangesAllowed {
+			return false
+		}
+	}
+
+	// Check any Rewards changes are allowed
+
+	// Get the incoming Rewards value
+	var foundIncomingRewards bool
+	var incomingRewards incentivetypes.Rewards
+	for _, change := range proposal.Changes {
+		if !(change.Subspace == incentivetypes.ModuleName && change.Key == string(incentivetypes.KeyRewards)) {
+			continue
+		}
+		// note: in case of duplicates take the last value
+		foundIncomingRewards = true
+		if err := appCdc.UnmarshalJSON([]byte(change.Value), &incomingRewards); err != nil {
+			return false // invalid json value, so just disallow
+		}
+	}
+	// only check if there was a proposed change
+	if foundIncomingRewards {
+		// Get the current value of the Rewards
+		subspace, found := pk.GetSubspace(incentivetypes.ModuleName)
+		if !found {
+			return false // not using a panic to help avoid begin blocker panics
+		}
+		var currentRewards incentivetypes.Rewards
+		subspace.Get(ctx, incentivetypes.KeyRewards, &currentRewards) // panics if something goes wrong
+
+		// Check all the incoming changes in the Rewards are allowed
+		rewardsChangesAllowed := perm.AllowedRewards.Allows(currentRewards, incomingRewards)
+		if !rewardsChangesAllowed {
+			return false
+		}
+	}
+
+	// Check any kavadist Periods changes are allowed
+
+	// Get the incoming Periods value
+	var foundIncomingPeriods bool
+	var incomingPeriods kavadisttypes.Periods
+	for _, change := range proposal.Changes {
+		if !(change.Subspace == kavadisttypes.ModuleName && change.Key == string(kavadisttypes.KeyPeriods)) {
+			continue
+		}
+		// note: in case of duplicates take the last value
+		foundIncomingPeriods = true
+		if err := appCdc.UnmarshalJSON([]byte(change.Value), &incomingPeriods); err != nil {
+			return false // invalid json value, so just disallow
+		}
+	}
+	// only check if there was a proposed change
+	if foundIncomingPeriods {
+		// Get the current value of the Periods
+		subspace, found := pk.GetSubspace(kavadisttypes.ModuleName)
+		if !found {
+			return false // not using a panic to help avoid begin blocker panics
+		}
+		var currentPeriods kavadisttypes.Periods
+		subspace.Get(ctx, kavadisttypes.KeyPeriods, &currentPeriods) // panics if something goes wrong
+
+		// Check all the incoming changes in the Periods are allowed
+		periodsChangesAllowed := perm.AllowedKavadistPeriods.Allows(currentPeriods, incomingPeriods)
+		if !periodsChangesAllowed {
+			return false
+		}
+	}
+
 	return true
 }
 
@@ -371,6 +589,30 @@ func (acps AllowedCollateralParams) Allows(current, incoming cdptypes.Collateral
 	return allAllowed
 }
 
+// changedWithinWindow returns whether committees changed any of the changed bounded fields within the window of their bounds.
+// Changes are recorded per denom and field, so changing one field does not hold back changes to the others.
+func (acps AllowedCollateralParams) changedWithinWindow(ctx sdk.Context, pk ParamKeeper, current, incoming cdptypes.CollateralParams) bool {
+	for _, incomingCP := range incoming {
+		for _, allowedCP := range acps {
+			if allowedCP.Denom != incomingCP.Denom {
+				continue
+			}
+			for _, currentCP := range current {
+				if currentCP.Denom != incomingCP.Denom {
+					continue
+				}
+				for _, field := range changedCollateralParamFields(currentCP, incomingCP) {
+					key := collateralParamFieldKey(incomingCP.Denom, field)
+					if changedWithin(ctx, pk, cdptypes.ModuleName, key, allowedCP.fieldWindow(field)) {
+						return true
+					}
+				}
+			}
+		}
+	}
+	return false
+}
+
 type AllowedCollateralParam struct {
 	Denom              string `json:"denom" yaml:"denom"`
 	LiquidationRatio   bool   `json:"liquidation_ratio" yaml:"liquidation_ratio"`
@@ -380,39 +622,135 @@ type AllowedCollateralParam struct {
 	LiquidationPenalty bool   `json:"liquidation_penalty" yaml:"liquidation_penalty"`
 	Prefix             bool   `json:"prefix" yaml:"prefix"`
 	MarketID           bool   `json:"market_id" yaml:"market_id"`
+	UseTWAP            bool   `json:"use_twap" yaml:"use_twap"`
 	ConversionFactor   bool   `json:"conversion_factor" yaml:"conversion_factor"`
+
+	// optional limits on the values allowed fields can be changed to
+	LiquidationRatioBounds   *DecBounds `json:"liquidation_ratio_bounds,omitempty" yaml:"liquidation_ratio_bounds,omitempty"`
+	DebtLimitBounds          *IntBounds `json:"debt_limit_bounds,omitempty" yaml:"debt_limit_bounds,omitempty"`
+	StabilityFeeBounds       *DecBounds `json:"stability_fee_bounds,omitempty" yaml:"stability_fee_bounds,omitempty"`
+	AuctionSizeBounds        *IntBounds `json:"auction_size_bounds,omitempty" yaml:"auction_size_bounds,omitempty"`
+	LiquidationPenaltyBounds *DecBounds `json:"liquidation_penalty_bounds,omitempty" yaml:"liquidation_penalty_bounds,omitempty"`
 }
 
 func (acp AllowedCollateralParam) Allows(current, incoming cdptypes.CollateralParam) bool {
 	allowed := ((acp.Denom == current.Denom) && (acp.Denom == incoming.Denom)) && // require denoms to be all equal
-		(current.LiquidationRatio.Equal(incoming.LiquidationRatio) || acp.LiquidationRatio) &&
-		(current.DebtLimit.IsEqual(incoming.DebtLimit) || acp.DebtLimit) &&
-		(current.StabilityFee.Equal(incoming.StabilityFee) || acp.StabilityFee) &&
-		(current.AuctionSize.Equal(incoming.AuctionSize) || acp.AuctionSize) &&
-		(current.LiquidationPenalty.Equal(incoming.LiquidationPenalty) || acp.LiquidationPenalty) &&
+		(current.LiquidationRatio.Equal(incoming.LiquidationRatio) || (acp.LiquidationRatio && acp.LiquidationRatioBounds.Allows(current.LiquidationRatio, incoming.LiquidationRatio))) &&
+		(coinsEqual(current.DebtLimit, incoming.DebtLimit) || (acp.DebtLimit && acp.DebtLimitBounds.allowsCoin(current.DebtLimit, incoming.DebtLimit))) &&
+		(current.StabilityFee.Equal(incoming.StabilityFee) || (acp.StabilityFee && acp.StabilityFeeBounds.Allows(current.StabilityFee, incoming.StabilityFee))) &&
+		(current.AuctionSize.Equal(incoming.AuctionSize) || (acp.AuctionSize && acp.AuctionSizeBounds.Allows(current.AuctionSize, incoming.AuctionSize))) &&
+		(current.LiquidationPenalty.Equal(incoming.LiquidationPenalty) || (acp.LiquidationPenalty && acp.LiquidationPenaltyBounds.Allows(current.LiquidationPenalty, incoming.LiquidationPenalty))) &&
 		((current.Prefix == incoming.Prefix) || acp.Prefix) &&
 		((current.MarketID == incoming.MarketID) || acp.MarketID) &&
+		((current.UseTWAP == incoming.UseTWAP) || acp.UseTWAP) &&
 		(current.ConversionFactor.Equal(incoming.ConversionFactor) || acp.ConversionFactor)
 	return allowed
 }
 
+// fieldWindow returns the change window of the bounds on a bounded field.
+func (acp AllowedCollateralParam) fieldWindow(field string) time.Duration {
+	switch field {
+	case fieldLiquidationRatio:
+		return acp.LiquidationRatioBounds.window()
+	case fieldDebtLimit:
+		return acp.DebtLimitBounds.window()
+	case fieldStabilityFee:
+		return acp.StabilityFeeBounds.window()
+	case fieldAuctionSize:
+		return acp.AuctionSizeBounds.window()
+	case fieldLiquidationPenalty:
+		return acp.LiquidationPenaltyBounds.window()
+	default:
+		return 0
+	}
+}
+
+// changedCollateralParamFields returns the names of the bounded fields that differ between two collateral params.
+func changedCollateralParamFields(current, incoming cdptypes.CollateralParam) []string {
+	var fields []string
+	if !decsEqual(current.LiquidationRatio, incoming.LiquidationRatio) {
+		fields = append(fields, fieldLiquidationRatio)
+	}
+	if !coinsEqual(current.DebtLimit, incoming.DebtLimit) {
+		fields = append(fields, fieldDebtLimit)
+	}
+	if !decsEqual(current.StabilityFee, incoming.StabilityFee) {
+		fields = append(fields, fieldStabilityFee)
+	}
+	if !intsEqual(current.AuctionSize, incoming.AuctionSize) {
+		fields = append(fields, fieldAuctionSize)
+	}
+	if !decsEqual(current.LiquidationPenalty, incoming.LiquidationPenalty) {
+		fields = append(fields, fieldLiquidationPenalty)
+	}
+	return fields
+}
+
+// collateralParamFieldKey returns the key committee changes to a field of a denom's collateral param are recorded under.
+func collateralParamFieldKey(denom, field string) string {
+	return fmt.Sprintf("%s/%s/%s", cdptypes.KeyCollateralParams, denom, field)
+}
+
 type AllowedDebtParam struct {
 	Denom            bool `json:"denom" yaml:"denom"`
 	ReferenceAsset   bool `json:"reference_asset" yaml:"reference_asset"`
 	ConversionFactor bool `json:"conversion_factor" yaml:"conversion_factor"`
 	DebtFloor        bool `json:"debt_floor" yaml:"debt_floor"`
 	SavingsRate      bool `json:"savings_rate" yaml:"savings_rate"`
+
+	// optional limits on the values allowed fields can be changed to
+	DebtFloorBounds   *IntBounds `json:"debt_floor_bounds,omitempty" yaml:"debt_floor_bounds,omitempty"`
+	SavingsRateBounds *DecBounds `json:"savings_rate_bounds,omitempty" yaml:"savings_rate_bounds,omitempty"`
 }
 
 func (adp AllowedDebtParam) Allows(current, incoming cdptypes.DebtParam) bool {
 	allowed := ((current.Denom == incoming.Denom) || adp.Denom) &&
 		((current.ReferenceAsset == incoming.ReferenceAsset) || adp.ReferenceAsset) &&
 		(current.ConversionFactor.Equal(incoming.ConversionFactor) || adp.ConversionFactor) &&
-		(current.DebtFloor.Equal(incoming.DebtFloor) || adp.DebtFloor) &&
-		(current.SavingsRate.Equal(incoming.SavingsRate) || adp.SavingsRate)
+		(current.DebtFloor.Equal(incoming.DebtFloor) || (adp.DebtFloor && adp.DebtFloorBounds.Allows(current.DebtFloor, incoming.DebtFloor))) &&
+		(current.SavingsRate.Equal(incoming.SavingsRate) || (adp.SavingsRate && adp.SavingsRateBounds.Allows(current.SavingsRate, incoming.SavingsRate)))
 	return allowed
 }
 
+// changedWithinWindow returns whether committees changed any of the changed bounded fields within the window of their bounds.
+func (adp AllowedDebtParam) changedWithinWindow(ctx sdk.Context, pk ParamKeeper, current, incoming cdptypes.DebtParam) bool {
+	for _, field := range changedDebtParamFields(current, incoming) {
+		if changedWithin(ctx, pk, cdptypes.ModuleName, debtParamFieldKey(field), adp.fieldWindow(field)) {
+			return true
+		}
+	}
+	return false
+}
+
+// fieldWindow returns the change window of the bounds on a bounded field.
+func (adp AllowedDebtParam) fieldWindow(field string) time.Duration {
+	switch field {
+	case fieldDebtFloor:
+		return adp.DebtFloorBounds.window()
+	case fieldSavingsRate:
+		return adp.SavingsRateBounds.window()
+	default:
+		return 0
+	}
+}
+
+// changedDebtParamFields returns the names of the bounded fields that differ between two debt params.
+func changedDebtParamFields(current, incoming cdptypes.DebtParam) []string {
+	var fields []string
+	if !intsEqual(current.DebtFloor, incoming.DebtFloor) {
+		fields = append(fields, fieldDebtFloor)
+	}
+	if !decsEqual(current.SavingsRate, incoming.SavingsRate) {
+		fields = append(fields, fieldSavingsRate)
+	}
+	return fields
+}
+
+// debtParamFieldKey returns the key committee changes to a field of the debt param are recorded under.
+func debtParamFieldKey(field string) string {
+	return fmt.Sprintf("%s/%s", cdptypes.KeyDebtParam, field)
+}
+
 type AllowedAssetParams []AllowedAssetParam
 
 func (aaps AllowedAssetParams) Allows(current, incoming bep3types.AssetParams) bool {
@@ -464,17 +802,27 @@ func (aaps AllowedAssetParams) Allows(current, incoming bep3types.AssetParams) b
 }
 
 type AllowedAssetParam struct {
-	Denom  string `json:"denom" yaml:"denom"`
-	CoinID bool   `json:"coin_id" yaml:"coin_id"`
-	Limit  bool   `json:"limit" yaml:"limit"`
-	Active bool   `json:"active" yaml:"active"`
+	Denom           string `json:"denom" yaml:"denom"`
+	CoinID          bool   `json:"coin_id" yaml:"coin_id"`
+	Limit           bool   `json:"limit" yaml:"limit"`
+	Active          bool   `json:"active" yaml:"active"`
+	ChainID         bool   `json:"chain_id" yaml:"chain_id"`
+	AddressFormat   bool   `json:"address_format" yaml:"address_format"` // covers both the address format and prefix
+	TimeWindow      bool   `json:"time_window" yaml:"time_window"`
+	TimeWindowLimit bool   `json:"time_window_limit" yaml:"time_window_limit"`
+	Fee             bool   `json:"fee" yaml:"fee"` // covers the fixed fee, fee rate and minimum fee
 }
 
 func (aap AllowedAssetParam) Allows(current, incoming bep3types.AssetParam) bool {
 	allowed := ((aap.Denom == current.Denom) && (aap.Denom == incoming.Denom)) && // require denoms to be all equal
 		((current.CoinID == incoming.CoinID) || aap.CoinID) &&
 		(current.Limit.Equal(incoming.Limit) || aap.Limit) &&
-		((current.Active == incoming.Active) || aap.Active)
+		((current.Active == incoming.Active) || aap.Active) &&
+		((current.ChainID == incoming.ChainID) || aap.ChainID) &&
+		((current.AddressFormat == incoming.AddressFormat && current.AddressPrefix == incoming.AddressPrefix) || aap.AddressFormat) &&
+		((current.TimeWindow == incoming.TimeWindow) || aap.TimeWindow) &&
+		(intsEqual(current.TimeWindowLimit, incoming.TimeWindowLimit) || aap.TimeWindowLimit) &&
+		(assetFeesEqual(current.Fee, incoming.Fee) || aap.Fee)
 	return allowed
 }
 
@@ -529,11 +877,21 @@ func (ams AllowedMarkets) Allows(current, incoming pricefeedtypes.Markets) bool
 }
 
 type AllowedMarket struct {
-	MarketID   string `json:"market_id" yaml:"market_id"`
-	BaseAsset  bool   `json:"base_asset" yaml:"base_asset"`
-	QuoteAsset bool   `json:"quote_asset" yaml:"quote_asset"`
-	Oracles    bool   `json:"oracles" yaml:"oracles"`
-	Active     bool   `json:"active" yaml:"active"`
+	MarketID               string `json:"market_id" yaml:"market_id"`
+	BaseAsset              bool   `json:"base_asset" yaml:"base_asset"`
+	QuoteAsset             bool   `json:"quote_asset" yaml:"quote_asset"`
+	Oracles                bool   `json:"oracles" yaml:"oracles"`
+	Active                 bool   `json:"active" yaml:"active"`
+	MaxBlockDeviation      bool   `json:"max_block_deviation" yaml:"max_block_deviation"`
+	MaxWindowDeviation     bool   `json:"max_window_deviation" yaml:"max_window_deviation"`
+	DeviationWindow        bool   `json:"deviation_window" yaml:"deviation_window"`
+	MaxOracleMisses        bool   `json:"max_oracle_misses" yaml:"max_oracle_misses"`
+	MaxOracleOutliers      bool   `json:"max_oracle_outliers" yaml:"max_oracle_outliers"`
+	OracleOutlierDeviation bool   `json:"oracle_outlier_deviation" yaml:"oracle_outlier_deviation"`
+	Derivation             bool   `json:"derivation" yaml:"derivation"`
+	AggregationMode        bool   `json:"aggregation_mode" yaml:"aggregation_mode"`
+	TrimFraction           bool   `json:"trim_fraction" yaml:"trim_fraction"`
+	MinimumOracles         bool   `json:"minimum_oracles" yaml:"minimum_oracles"`
 }
 
 func (am AllowedMarket) Allows(current, incoming pricefeedtypes.Market) bool {
@@ -541,10 +899,428 @@ func (am AllowedMarket) Allows(current, incoming pricefeedtypes.Market) bool {
 		((current.BaseAsset == incoming.BaseAsset) || am.BaseAsset) &&
 		((current.QuoteAsset == incoming.QuoteAsset) || am.QuoteAsset) &&
 		(addressesEqual(current.Oracles, incoming.Oracles) || am.Oracles) &&
-		((current.Active == incoming.Active) || am.Active)
+		((current.Active == incoming.Active) || am.Active) &&
+		(decsEqual(current.MaxBlockDeviation, incoming.MaxBlockDeviation) || am.MaxBlockDeviation) &&
+		(decsEqual(current.MaxWindowDeviation, incoming.MaxWindowDeviation) || am.MaxWindowDeviation) &&
+		((current.DeviationWindow == incoming.DeviationWindow) || am.DeviationWindow) &&
+		((current.MaxOracleMisses == incoming.MaxOracleMisses) || am.MaxOracleMisses) &&
+		((current.MaxOracleOutliers == incoming.MaxOracleOutliers) || am.MaxOracleOutliers) &&
+		(decsEqual(current.OracleOutlierDeviation, incoming.OracleOutlierDeviation) || am.OracleOutlierDeviation) &&
+		((current.Derivation == incoming.Derivation) || am.Derivation) &&
+		((current.AggregationMode == incoming.AggregationMode) || am.AggregationMode) &&
+		(decsEqual(current.TrimFraction, incoming.TrimFraction) || am.TrimFraction) &&
+		((current.MinimumOracles == incoming.MinimumOracles) || am.MinimumOracles)
+	return allowed
+}
+
+type AllowedCollateralAuctionParams []AllowedCollateralAuctionParam
+
+func (acaps AllowedCollateralAuctionParams) Allows(current, incoming auctiontypes.CollateralAuctionParams) bool {
+	allAllowed := true
+
+	// do not allow CollateralAuctionParams to be added or removed
+	// this checks both lists are the same size, then below checks each incoming matches a current
+	if len(incoming) != len(current) {
+		return false
+	}
+
+	// for each param struct, check it is allowed, and if it is not, check the value has not changed
+	for _, incomingCAP := range incoming {
+		// 1) check incoming cap is in list of allowed caps
+		var foundAllowedCAP bool
+		var allowedCAP AllowedCollateralAuctionParam
+		for _, p := range acaps {
+			if p.Denom != incomingCAP.Denom {
+				continue
+			}
+			foundAllowedCAP = true
+			allowedCAP = p
+		}
+		if !foundAllowedCAP {
+			// incoming had a CollateralAuctionParam that wasn't in the list of allowed ones
+			return false
+		}
+
+		// 2) Check incoming changes are individually allowed
+		// find existing CollateralAuctionParam
+		var foundCurrentCAP bool
+		var currentCAP auctiontypes.CollateralAuctionParam
+		for _, p := range current {
+			if p.Denom != incomingCAP.Denom {
+				continue
+			}
+			foundCurrentCAP = true
+			currentCAP = p
+		}
+		if !foundCurrentCAP {
+			return false // not allowed to add param to list
+		}
+		// check changed values are all allowed
+		allowed := allowedCAP.Allows(currentCAP, incomingCAP)
+
+		allAllowed = allAllowed && allowed
+	}
+	return allAllowed
+}
+
+type AllowedCollateralAuctionParam struct {
+	Denom              string `json:"denom" yaml:"denom"`
+	MaxAuctionDuration bool   `json:"max_auction_duration" yaml:"max_auction_duration"`
+	BidDuration        bool   `json:"bid_duration" yaml:"bid_duration"`
+	Increment          bool   `json:"increment" yaml:"increment"`
+}
+
+func (acap AllowedCollateralAuctionParam) Allows(current, incoming auctiontypes.CollateralAuctionParam) bool {
+	allowed := ((acap.Denom == current.Denom) && (acap.Denom == incoming.Denom)) && // require denoms to be all equal
+		((current.MaxAuctionDuration == incoming.MaxAuctionDuration) || acap.MaxAuctionDuration) &&
+		((current.BidDuration == incoming.BidDuration) || acap.BidDuration) &&
+		(current.Increment.Equal(incoming.Increment) || acap.Increment)
 	return allowed
 }
 
+// AllowedAuctionParams restricts changes to the auction module params, other than the CollateralAuctionParams which have their own allow list.
+type AllowedAuctionParams struct {
+	MaxAuctionDurationSurplus    bool `json:"max_auction_duration_surplus" yaml:"max_auction_duration_surplus"`
+	BidDurationSurplus           bool `json:"bid_duration_surplus" yaml:"bid_duration_surplus"`
+	IncrementSurplus             bool `json:"increment_surplus" yaml:"increment_surplus"`
+	MaxAuctionDurationDebt       bool `json:"max_auction_duration_debt" yaml:"max_auction_duration_debt"`
+	BidDurationDebt              bool `json:"bid_duration_debt" yaml:"bid_duration_debt"`
+	IncrementDebt                bool `json:"increment_debt" yaml:"increment_debt"`
+	MaxAuctionDurationCollateral bool `json:"max_auction_duration_collateral" yaml:"max_auction_duration_collateral"`
+	BidDurationCollateral        bool `json:"bid_duration_collateral" yaml:"bid_duration_collateral"`
+	IncrementCollateral          bool `json:"increment_collateral" yaml:"increment_collateral"`
+	MaxCollateralRestarts        bool `json:"max_collateral_restarts" yaml:"max_collateral_restarts"`
+	CollateralRestartDiscount    bool `json:"collateral_restart_discount" yaml:"collateral_restart_discount"`
+	SurplusMode                  bool `json:"surplus_mode" yaml:"surplus_mode"` // covers both the surplus mode and recipient
+}
+
+func (aap AllowedAuctionParams) Allows(current, incoming auctiontypes.Params) bool {
+	allowed := ((current.MaxAuctionDurationSurplus == incoming.MaxAuctionDurationSurplus) || aap.MaxAuctionDurationSurplus) &&
+		((current.BidDurationSurplus == incoming.BidDurationSurplus) || aap.BidDurationSurplus) &&
+		(decsEqual(current.IncrementSurplus, incoming.IncrementSurplus) || aap.IncrementSurplus) &&
+		((current.MaxAuctionDurationDebt == incoming.MaxAuctionDurationDebt) || aap.MaxAuctionDurationDebt) &&
+		((current.BidDurationDebt == incoming.BidDurationDebt) || aap.BidDurationDebt) &&
+		(decsEqual(current.IncrementDebt, incoming.IncrementDebt) || aap.IncrementDebt) &&
+		((current.MaxAuctionDurationCollateral == incoming.MaxAuctionDurationCollateral) || aap.MaxAuctionDurationCollateral) &&
+		((current.BidDurationCollateral == incoming.BidDurationCollateral) || aap.BidDurationCollateral) &&
+		(decsEqual(current.IncrementCollateral, incoming.IncrementCollateral) || aap.IncrementCollateral) &&
+		((current.MaxCollateralRestarts == incoming.MaxCollateralRestarts) || aap.MaxCollateralRestarts) &&
+		(decsEqual(current.CollateralRestartDiscount, incoming.CollateralRestartDiscount) || aap.CollateralRestartDiscount) &&
+		((current.SurplusMode == incoming.SurplusMode && current.SurplusRecipient == incoming.SurplusRecipient) || aap.SurplusMode)
+	return allowed
+}
+
+type AllowedRewards []AllowedReward
+
+func (ars AllowedRewards) Allows(current, incoming incentivetypes.Rewards) bool {
+	allAllowed := true
+
+	// do not allow Rewards to be added or removed
+	// this checks both lists are the same size, then below checks each incoming matches a current
+	if len(incoming) != len(current) {
+		return false
+	}
+
+	// for each reward struct, check it is allowed, and if it is not, check the value has not changed
+	for _, incomingR := range incoming {
+		// 1) check incoming reward is in list of allowed rewards
+		var foundAllowedR bool
+		var allowedR AllowedReward
+		for _, p := range ars {
+			if p.Denom != incomingR.Denom {
+				continue
+			}
+			foundAllowedR = true
+			allowedR = p
+		}
+		if !foundAllowedR {
+			// incoming had a Reward that wasn't in the list of allowed ones
+			return false
+		}
+
+		// 2) Check incoming changes are individually allowed
+		// find existing Reward
+		var foundCurrentR bool
+		var currentR incentivetypes.Reward
+		for _, p := range current {
+			if p.Denom != incomingR.Denom {
+				continue
+			}
+			foundCurrentR = true
+			currentR = p
+		}
+		if !foundCurrentR {
+			return false // not allowed to add reward to list
+		}
+		// check changed values are all allowed
+		allowed := allowedR.Allows(currentR, incomingR)
+
+		allAllowed = allAllowed && allowed
+	}
+	return allAllowed
+}
+
+type AllowedReward struct {
+	Denom            string `json:"denom" yaml:"denom"`
+	Active           bool   `json:"active" yaml:"active"`
+	AvailableRewards bool   `json:"available_rewards" yaml:"available_rewards"`
+	Duration         bool   `json:"duration" yaml:"duration"`
+	TimeLock         bool   `json:"time_lock" yaml:"time_lock"`
+	ClaimDuration    bool   `json:"claim_duration" yaml:"claim_duration"`
+}
+
+func (ar AllowedReward) Allows(current, incoming incentivetypes.Reward) bool {
+	allowed := ((ar.Denom == current.Denom) && (ar.Denom == incoming.Denom)) && // require denoms to be all equal
+		((current.Active == incoming.Active) || ar.Active) &&
+		(coinsEqual(current.AvailableRewards, incoming.AvailableRewards) || ar.AvailableRewards) &&
+		((current.Duration == incoming.Duration) || ar.Duration) &&
+		((current.TimeLock == incoming.TimeLock) || ar.TimeLock) &&
+		((current.ClaimDuration == incoming.ClaimDuration) || ar.ClaimDuration)
+	return allowed
+}
+
+type AllowedKavadistPeriods []AllowedKavadistPeriod
+
+func (akps AllowedKavadistPeriods) Allows(current, incoming kavadisttypes.Periods) bool {
+	allAllowed := true
+
+	// do not allow Periods to be added or removed
+	// this checks both lists are the same size, then below checks each incoming matches a current
+	if len(incoming) != len(current) {
+		return false
+	}
+
+	// for each period struct, check it is allowed, and if it is not, check the value has not changed
+	for _, incomingP := range incoming {
+		// 1) check incoming period is in list of allowed periods
+		var foundAllowedP bool
+		var allowedP AllowedKavadistPeriod
+		for _, p := range akps {
+			if !p.Start.Equal(incomingP.Start) {
+				continue
+			}
+			foundAllowedP = true
+			allowedP = p
+		}
+		if !foundAllowedP {
+			// incoming had a Period that wasn't in the list of allowed ones
+			return false
+		}
+
+		// 2) Check incoming changes are individually allowed
+		// find existing Period
+		var foundCurrentP bool
+		var currentP kavadisttypes.Period
+		for _, p := range current {
+			if !p.Start.Equal(incomingP.Start) {
+				continue
+			}
+			foundCurrentP = true
+			currentP = p
+		}
+		if !foundCurrentP {
+			return false // not allowed to add period to list
+		}
+		// check changed values are all allowed
+		allowed := allowedP.Allows(currentP, incomingP)
+
+		allAllowed = allAllowed && allowed
+	}
+	return allAllowed
+}
+
+// AllowedKavadistPeriod restricts changes to a kavadist period. Periods have no ID so they are identified by their start time, which cannot be changed.
+type AllowedKavadistPeriod struct {
+	Start     time.Time `json:"start" yaml:"start"`
+	End       bool      `json:"end" yaml:"end"`
+	Inflation bool      `json:"inflation" yaml:"inflation"`
+}
+
+func (akp AllowedKavadistPeriod) Allows(current, incoming kavadisttypes.Period) bool {
+	allowed := (akp.Start.Equal(current.Start) && akp.Start.Equal(incoming.Start)) && // require start times to be all equal
+		(current.End.Equal(incoming.End) || akp.End) &&
+		(decsEqual(current.Inflation, incoming.Inflation) || akp.Inflation)
+	return allowed
+}
+
+// names of the bounded cdp param fields, used in the keys committee changes to them are recorded under
+const (
+	fieldLiquidationRatio   = "LiquidationRatio"
+	fieldDebtLimit          = "DebtLimit"
+	fieldStabilityFee       = "StabilityFee"
+	fieldAuctionSize        = "AuctionSize"
+	fieldLiquidationPenalty = "LiquidationPenalty"
+	fieldDebtFloor          = "DebtFloor"
+	fieldSavingsRate        = "SavingsRate"
+)
+
+// DecBounds limits the values a decimal param can be changed to.
+// Zero values mean no limit, except Min which always applies.
+type DecBounds struct {
+	Min       sdk.Dec       `json:"min" yaml:"min"`               // smallest allowed value
+	Max       sdk.Dec       `json:"max" yaml:"max"`               // largest allowed value
+	MaxChange sdk.Dec       `json:"max_change" yaml:"max_change"` // largest allowed change, as a fraction of the current value
+	Window    time.Duration `json:"window" yaml:"window"`         // minimum time between committee changes to the param
+	// largest allowed change in absolute terms, so params at or near zero can still be changed when MaxChange is set
+	MaxAbsoluteChange sdk.Dec `json:"max_absolute_change" yaml:"max_absolute_change"`
+}
+
+// Allows returns whether a decimal param can be changed from current to incoming. Nil bounds allow any change.
+func (b *DecBounds) Allows(current, incoming sdk.Dec) bool {
+	if b == nil {
+		return true
+	}
+	current, incoming = nilToZeroDec(current), nilToZeroDec(incoming)
+	if !b.Min.IsNil() && incoming.LT(b.Min) {
+		return false
+	}
+	if !b.Max.IsNil() && b.Max.IsPositive() && incoming.GT(b.Max) {
+		return false
+	}
+	if limit, limited := b.changeLimit(current); limited && incoming.Sub(current).Abs().GT(limit) {
+		return false
+	}
+	return true
+}
+
+// changeLimit returns the largest allowed change from the current value, and false if changes are not limited.
+// The limit is the larger of MaxChange relative to the current value and MaxAbsoluteChange.
+func (b *DecBounds) changeLimit(current sdk.Dec) (sdk.Dec, bool) {
+	limit, limited := sdk.ZeroDec(), false
+	if !b.MaxChange.IsNil() && b.MaxChange.IsPositive() {
+		limit, limited = current.Abs().Mul(b.MaxChange), true
+	}
+	if !b.MaxAbsoluteChange.IsNil() && b.MaxAbsoluteChange.IsPositive() {
+		limit, limited = sdk.MaxDec(limit, b.MaxAbsoluteChange), true
+	}
+	return limit, limited
+}
+
+func (b *DecBounds) window() time.Duration {
+	if b == nil {
+		return 0
+	}
+	return b.Window
+}
+
+// IntBounds limits the values an integer param can be changed to.
+// Zero values mean no limit, except Min which always applies.
+type IntBounds struct {
+	Min       sdk.Int       `json:"min" yaml:"min"`               // smallest allowed value
+	Max       sdk.Int       `json:"max" yaml:"max"`               // largest allowed value
+	MaxChange sdk.Dec       `json:"max_change" yaml:"max_change"` // largest allowed change, as a fraction of the current value
+	Window    time.Duration `json:"window" yaml:"window"`         // minimum time between committee changes to the param
+	// largest allowed change in absolute terms, so params at or near zero can still be changed when MaxChange is set
+	MaxAbsoluteChange sdk.Int `json:"max_absolute_change" yaml:"max_absolute_change"`
+}
+
+// Allows returns whether an integer param can be changed from current to incoming. Nil bounds allow any change.
+func (b *IntBounds) Allows(current, incoming sdk.Int) bool {
+	if b == nil {
+		return true
+	}
+	current, incoming = nilToZeroInt(current), nilToZeroInt(incoming)
+	if b.Min != (sdk.Int{}) && incoming.LT(b.Min) {
+		return false
+	}
+	if b.Max != (sdk.Int{}) && b.Max.IsPositive() && incoming.GT(b.Max) {
+		return false
+	}
+	if limit, limited := b.changeLimit(current); limited && incoming.Sub(current).ToDec().Abs().GT(limit) {
+		return false
+	}
+	return true
+}
+
+// changeLimit returns the largest allowed change from the current value, and false if changes are not limited.
+// The limit is the larger of MaxChange relative to the current value and MaxAbsoluteChange.
+func (b *IntBounds) changeLimit(current sdk.Int) (sdk.Dec, bool) {
+	limit, limited := sdk.ZeroDec(), false
+	if !b.MaxChange.IsNil() && b.MaxChange.IsPositive() {
+		limit, limited = current.ToDec().Abs().Mul(b.MaxChange), true
+	}
+	if b.MaxAbsoluteChange != (sdk.Int{}) && b.MaxAbsoluteChange.IsPositive() {
+		limit, limited = sdk.MaxDec(limit, b.MaxAbsoluteChange.ToDec()), true
+	}
+	return limit, limited
+}
+
+// allowsCoin applies the bounds to a coin's amount. Denoms cannot be changed when there are bounds.
+func (b *IntBounds) allowsCoin(current, incoming sdk.Coin) bool {
+	if b == nil {
+		return true
+	}
+	return current.Denom == incoming.Denom && b.Allows(current.Amount, incoming.Amount)
+}
+
+func (b *IntBounds) window() time.Duration {
+	if b == nil {
+		return 0
+	}
+	return b.Window
+}
+
+// changedWithin returns whether committees changed a param within a window before the current block time.
+// If the param keeper has no record of changes, the param is treated as recently changed so windows are never bypassed.
+func changedWithin(ctx sdk.Context, pk ParamKeeper, subspace, key string, window time.Duration) bool {
+	if window <= 0 {
+		return false
+	}
+	history, ok := pk.(ParamChangeHistory)
+	if !ok {
+		return true
+	}
+	record, found := history.GetParamChangeRecord(ctx, subspace, key)
+	if !found {
+		return false
+	}
+	return ctx.BlockTime().Before(record.Time.Add(window))
+}
+
+// ParamChangeRecordKeys returns the keys a committee param change is recorded under. Besides the param key, changes to
+// bounded cdp fields are recorded per denom and field, so their change windows apply to each field separately.
+// It compares against the current param value, so must be called before the change is made.
+func ParamChangeRecordKeys(ctx sdk.Context, appCdc *codec.Codec, pk ParamKeeper, change paramstypes.ParamChange) []string {
+	keys := []string{change.Key}
+	if change.Subspace != cdptypes.ModuleName {
+		return keys
+	}
+	cdpSubspace, found := pk.GetSubspace(cdptypes.ModuleName)
+	if !found {
+		return keys
+	}
+	switch change.Key {
+	case string(cdptypes.KeyCollateralParams):
+		var incoming cdptypes.CollateralParams
+		if err := appCdc.UnmarshalJSON([]byte(change.Value), &incoming); err != nil {
+			return keys
+		}
+		var current cdptypes.CollateralParams
+		cdpSubspace.Get(ctx, cdptypes.KeyCollateralParams, &current)
+		for _, incomingCP := range incoming {
+			for _, currentCP := range current {
+				if currentCP.Denom != incomingCP.Denom {
+					continue
+				}
+				for _, field := range changedCollateralParamFields(currentCP, incomingCP) {
+					keys = append(keys, collateralParamFieldKey(incomingCP.Denom, field))
+				}
+			}
+		}
+	case string(cdptypes.KeyDebtParam):
+		var incoming cdptypes.DebtParam
+		if err := appCdc.UnmarshalJSON([]byte(change.Value), &incoming); err != nil {
+			return keys
+		}
+		var current cdptypes.DebtParam
+		cdpSubspace.Get(ctx, cdptypes.KeyDebtParam, &current)
+		for _, field := range changedDebtParamFields(current, incoming) {
+			keys = append(keys, debtParamFieldKey(field))
+		}
+	}
+	return keys
+}
+
 // addressesEqual check if slices of addresses are equal, the order matters
 func addressesEqual(addrs1, addrs2 []sdk.AccAddress) bool {
 	if len(addrs1) != len(addrs2) {
@@ -556,3 +1332,37 @@ func addressesEqual(addrs1, addrs2 []sdk.AccAddress) bool {
 	}
 	return areEqual
 }
+
+// decsEqual check if two decimals are equal, treating nil decimals as zero
+func decsEqual(d1, d2 sdk.Dec) bool {
+	return nilToZeroDec(d1).Equal(nilToZeroDec(d2))
+}
+
+// intsEqual check if two integers are equal, treating nil integers as zero
+func intsEqual(i1, i2 sdk.Int) bool {
+	return nilToZeroInt(i1).Equal(nilToZeroInt(i2))
+}
+
+func nilToZeroDec(d sdk.Dec) sdk.Dec {
+	if d.IsNil() {
+		return sdk.ZeroDec()
+	}
+	return d
+}
+
+func nilToZeroInt(i sdk.Int) sdk.Int {
+	if i == (sdk.Int{}) {
+		return sdk.ZeroInt()
+	}
+	return i
+}
+
+// coinsEqual check if two coins are equal, without panicking if the denoms differ
+func coinsEqual(c1, c2 sdk.Coin) bool {
+	return c1.Denom == c2.Denom && intsEqual(c1.Amount, c2.Amount)
+}
+
+// assetFeesEqual check if two bep3 asset fee schedules are equal
+func assetFeesEqual(f1, f2 bep3types.AssetFee) bool {
+	return intsEqual(f1.FixedFee, f2.FixedFee) && f1.FeeRate == f2.FeeRate && intsEqual(f1.MinFee, f2.MinFee)
+}
@@ -10,6 +10,8 @@ import (
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
 	upgrade "github.com/cosmos/cosmos-sdk/x/upgrade"
+
+	pricefeedtypes "github.com/kava-labs/kava/x/pricefeed/types"
 )
 
 type PermissionsTestSuite struct {
@@ -34,7 +36,7 @@ func (suite *PermissionsTestSuite) SetupTest() {
 		},
 		{
 			Subspace: "auction",
-			Key:      "BidDuration",
+			Key:      "BidDurationCollateral",
 		},
 	}
 }
@@ -342,6 +344,89 @@ func (suite *PermissionsTestSuite) TestSoftwareUpgradePermission_Allows() {
 	}
 }
 
+func (suite *PermissionsTestSuite) TestConfirmPricePermission_Allows() {
+	testcases := []struct {
+		name          string
+		pubProposal   PubProposal
+		expectAllowed bool
+	}{
+		{
+			name: "normal",
+			pubProposal: pricefeedtypes.NewConfirmPriceProposal(
+				"A Title",
+				"A description for this proposal.",
+				"bnb:usd",
+			),
+			expectAllowed: true,
+		},
+		{
+			name: "not allowed (wrong pubproposal type)",
+			pubProposal: govtypes.NewTextProposal(
+				"A Title",
+				"A description for this proposal.",
+			),
+			expectAllowed: false,
+		},
+		{
+			name:          "not allowed (nil pubproposal)",
+			pubProposal:   nil,
+			expectAllowed: false,
+		},
+	}
+
+	for _, tc := range testcases {
+		suite.Run(tc.name, func() {
+			permission := ConfirmPricePermission{}
+			suite.Equal(
+				tc.expectAllowed,
+				permission.Allows(sdk.Context{}, nil, nil, tc.pubProposal),
+			)
+		})
+	}
+}
+
+func (suite *PermissionsTestSuite) TestCancelPermission_Allows() {
+	testcases := []struct {
+		name          string
+		pubProposal   PubProposal
+		expectAllowed bool
+	}{
+		{
+			name: "normal",
+			pubProposal: NewCommitteeCancelProposal(
+				"A Title",
+				"A description for this proposal.",
+				1,
+			),
+			expectAllowed: true,
+		},
+		{
+			name: "not allowed (wrong pubproposal type)",
+			pubProposal: NewCommitteeDeleteProposal(
+				"A Title",
+				"A description for this proposal.",
+				1,
+			),
+			expectAllowed: false,
+		},
+		{
+			name:          "not allowed (nil pubproposal)",
+			pubProposal:   nil,
+			expectAllowed: false,
+		},
+	}
+
+	for _, tc := range testcases {
+		suite.Run(tc.name, func() {
+			permission := CancelPermission{}
+			suite.Equal(
+				tc.expectAllowed,
+				permission.Allows(sdk.Context{}, nil, nil, tc.pubProposal),
+			)
+		})
+	}
+}
+
 func TestPermissionsTestSuite(t *testing.T) {
 	suite.Run(t, new(PermissionsTestSuite))
 }
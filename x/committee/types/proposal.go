@@ -10,11 +10,12 @@ import (
 const (
 	ProposalTypeCommitteeChange = "CommitteeChange"
 	ProposalTypeCommitteeDelete = "CommitteeDelete"
+	ProposalTypeCommitteeCancel = "CommitteeCancel"
 )
 
 // ensure proposal types fulfill the PubProposal interface and the gov Content interface.
-var _, _ govtypes.Content = CommitteeChangeProposal{}, CommitteeDeleteProposal{}
-var _, _ PubProposal = CommitteeChangeProposal{}, CommitteeDeleteProposal{}
+var _, _, _ govtypes.Content = CommitteeChangeProposal{}, CommitteeDeleteProposal{}, CommitteeCancelProposal{}
+var _, _, _ PubProposal = CommitteeChangeProposal{}, CommitteeDeleteProposal{}, CommitteeCancelProposal{}
 
 func init() {
 	// Gov proposals need to be registered on gov's ModuleCdc so MsgSubmitProposal can be encoded.
@@ -23,6 +24,9 @@ func init() {
 
 	govtypes.RegisterProposalType(ProposalTypeCommitteeDelete)
 	govtypes.RegisterProposalTypeCodec(CommitteeDeleteProposal{}, "kava/CommitteeDeleteProposal")
+
+	govtypes.RegisterProposalType(ProposalTypeCommitteeCancel)
+	govtypes.RegisterProposalTypeCodec(CommitteeCancelProposal{}, "kava/CommitteeCancelProposal")
 }
 
 // CommitteeChangeProposal is a gov proposal for creating a new committee or modifying an existing one.
@@ -106,3 +110,42 @@ func (cdp CommitteeDeleteProposal) String() string {
 	bz, _ := yaml.Marshal(cdp)
 	return string(bz)
 }
+
+// CommitteeCancelProposal is a proposal for cancelling a committee proposal that is queued for enactment.
+// It can be submitted to gov, or to any committee with a CancelPermission.
+type CommitteeCancelProposal struct {
+	Title       string `json:"title" yaml:"title"`
+	Description string `json:"description" yaml:"description"`
+	ProposalID  uint64 `json:"proposal_id" yaml:"proposal_id"`
+}
+
+func NewCommitteeCancelProposal(title string, description string, proposalID uint64) CommitteeCancelProposal {
+	return CommitteeCancelProposal{
+		Title:       title,
+		Description: description,
+		ProposalID:  proposalID,
+	}
+}
+
+// GetTitle returns the title of the proposal.
+func (ccp CommitteeCancelProposal) GetTitle() string { return ccp.Title }
+
+// GetDescription returns the description of the proposal.
+func (ccp CommitteeCancelProposal) GetDescription() string { return ccp.Description }
+
+// ProposalRoute returns the routing key of the proposal.
+func (ccp CommitteeCancelProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of the proposal.
+func (ccp CommitteeCancelProposal) ProposalType() string { return ProposalTypeCommitteeCancel }
+
+// ValidateBasic runs basic stateless validity checks
+func (ccp CommitteeCancelProposal) ValidateBasic() error {
+	return govtypes.ValidateAbstract(ccp)
+}
+
+// String implements the Stringer interface.
+func (ccp CommitteeCancelProposal) String() string {
+	bz, _ := yaml.Marshal(ccp)
+	return string(bz)
+}
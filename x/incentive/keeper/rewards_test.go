@@ -6,6 +6,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto"
 	tmtime "github.com/tendermint/tendermint/types/time"
 
 	"github.com/kava-labs/kava/app"
@@ -14,6 +15,9 @@ import (
 	"github.com/kava-labs/kava/x/pricefeed"
 )
 
+// pricefeedOracle is the oracle listed for the markets in the test pricefeed genesis states
+var pricefeedOracle = sdk.AccAddress(crypto.AddressHash([]byte("PricefeedOracle")))
+
 func (suite *KeeperTestSuite) TestExpireRewardPeriod() {
 	rp := types.NewRewardPeriod("bnb", suite.ctx.BlockTime(), suite.ctx.BlockTime().Add(time.Hour*168), c("ukava", 100000000), suite.ctx.BlockTime().Add(time.Hour*168*2), time.Hour*8766)
 	suite.keeper.SetRewardPeriod(suite.ctx, rp)
@@ -162,13 +166,13 @@ func (suite *KeeperTestSuite) setupCdpChain() {
 	pricefeedGS := pricefeed.GenesisState{
 		Params: pricefeed.Params{
 			Markets: []pricefeed.Market{
-				{MarketID: "bnb:usd", BaseAsset: "bnb", QuoteAsset: "usd", Oracles: []sdk.AccAddress{}, Active: true},
+				{MarketID: "bnb:usd", BaseAsset: "bnb", QuoteAsset: "usd", Oracles: []sdk.AccAddress{pricefeedOracle}, Active: true},
 			},
 		},
 		PostedPrices: []pricefeed.PostedPrice{
 			{
 				MarketID:      "bnb:usd",
-				OracleAddress: sdk.AccAddress{},
+				OracleAddress: pricefeedOracle,
 				Price:         d("12.29"),
 				Expiry:        time.Now().Add(100000 * time.Hour),
 			},
@@ -8,21 +8,37 @@ import (
 
 // EndBlocker updates the current pricefeed
 func EndBlocker(ctx sdk.Context, k Keeper) {
-	// Update the current price of each asset.
-	for _, market := range k.GetMarkets(ctx) {
-		if market.Active {
-			err := k.SetCurrentPrices(ctx, market.MarketID)
-			if err != nil {
-				// In the event of failure, emit an event.
-				ctx.EventManager().EmitEvent(
-					sdk.NewEvent(
-						EventTypeNoValidPrices,
-						sdk.NewAttribute(AttributeMarketID, fmt.Sprintf("%s", market.MarketID)),
-					),
-				)
-				continue
-			}
+	// Params can be changed by governance without going through the keeper, so remove prices they have made stale.
+	if k.MarketsModified(ctx) {
+		k.PruneStalePrices(ctx)
+	}
+
+	markets := k.GetMarkets(ctx)
+	// Update the current price of each asset priced by oracles.
+	for _, market := range markets {
+		if market.Active && !market.IsDerived() {
+			k.UpdateOracleMetrics(ctx, market.MarketID)
+			setCurrentPrices(ctx, k, market)
+		}
+	}
+	// Update the current price of each derived asset once its input markets have been updated.
+	for _, market := range markets {
+		if market.Active && market.IsDerived() {
+			setCurrentPrices(ctx, k, market)
 		}
 	}
 	return
 }
+
+func setCurrentPrices(ctx sdk.Context, k Keeper, market Market) {
+	err := k.SetCurrentPrices(ctx, market.MarketID)
+	if err != nil {
+		// In the event of failure, emit an event.
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				EventTypeNoValidPrices,
+				sdk.NewAttribute(AttributeMarketID, fmt.Sprintf("%s", market.MarketID)),
+			),
+		)
+	}
+}
@@ -12,69 +12,147 @@ import (
 
 // nolint
 const (
-	EventTypeMarketPriceUpdated = types.EventTypeMarketPriceUpdated
-	EventTypeOracleUpdatedPrice = types.EventTypeOracleUpdatedPrice
-	EventTypeNoValidPrices      = types.EventTypeNoValidPrices
-	AttributeValueCategory      = types.AttributeValueCategory
-	AttributeMarketID           = types.AttributeMarketID
-	AttributeMarketPrice        = types.AttributeMarketPrice
-	AttributeOracle             = types.AttributeOracle
-	AttributeExpiry             = types.AttributeExpiry
-	ModuleName                  = types.ModuleName
-	StoreKey                    = types.StoreKey
-	RouterKey                   = types.RouterKey
-	QuerierRoute                = types.QuerierRoute
-	DefaultParamspace           = types.DefaultParamspace
-	TypeMsgPostPrice            = types.TypeMsgPostPrice
-	QueryGetParams              = types.QueryGetParams
-	QueryMarkets                = types.QueryMarkets
-	QueryOracles                = types.QueryOracles
-	QueryRawPrices              = types.QueryRawPrices
-	QueryPrice                  = types.QueryPrice
+	EventTypeMarketPriceUpdated  = types.EventTypeMarketPriceUpdated
+	EventTypeOracleUpdatedPrice  = types.EventTypeOracleUpdatedPrice
+	EventTypeNoValidPrices       = types.EventTypeNoValidPrices
+	EventTypeMarketUnstable      = types.EventTypeMarketUnstable
+	EventTypeMarketStable        = types.EventTypeMarketStable
+	EventTypeOracleDeactivated   = types.EventTypeOracleDeactivated
+	EventTypeOracleReactivated   = types.EventTypeOracleReactivated
+	AttributeValueCategory       = types.AttributeValueCategory
+	AttributeMarketID            = types.AttributeMarketID
+	AttributeMarketPrice         = types.AttributeMarketPrice
+	AttributeOracle              = types.AttributeOracle
+	AttributeExpiry              = types.AttributeExpiry
+	AttributeRejectedPrice       = types.AttributeRejectedPrice
+	AttributeReason              = types.AttributeReason
+	AttributeValueMissedPrices   = types.AttributeValueMissedPrices
+	AttributeValueOutlierPrices  = types.AttributeValueOutlierPrices
+	DerivationProduct            = types.DerivationProduct
+	DerivationRatio              = types.DerivationRatio
+	AggregationMedian            = types.AggregationMedian
+	AggregationWeightedMedian    = types.AggregationWeightedMedian
+	AggregationTrimmedMean       = types.AggregationTrimmedMean
+	ModuleName                   = types.ModuleName
+	StoreKey                     = types.StoreKey
+	RouterKey                    = types.RouterKey
+	QuerierRoute                 = types.QuerierRoute
+	DefaultParamspace            = types.DefaultParamspace
+	TypeMsgPostPrice             = types.TypeMsgPostPrice
+	TypeMsgPostPrices            = types.TypeMsgPostPrices
+	ProposalTypeConfirmPrice     = types.ProposalTypeConfirmPrice
+	ProposalTypeReactivateOracle = types.ProposalTypeReactivateOracle
+	QueryGetParams               = types.QueryGetParams
+	QueryMarkets                 = types.QueryMarkets
+	QueryOracles                 = types.QueryOracles
+	QueryRawPrices               = types.QueryRawPrices
+	QueryPrice                   = types.QueryPrice
+	QueryTWAPPrice               = types.QueryTWAPPrice
+	QueryPriceHistory            = types.QueryPriceHistory
+	QueryOracleMetrics           = types.QueryOracleMetrics
+	QueryPriceUpdates            = types.QueryPriceUpdates
 )
 
 // nolint
 var (
 	// functions aliases
-	NewKeeper                  = keeper.NewKeeper
-	NewQuerier                 = keeper.NewQuerier
-	RegisterCodec              = types.RegisterCodec
-	ErrEmptyInput              = types.ErrEmptyInput
-	ErrExpired                 = types.ErrExpired
-	ErrNoValidPrice            = types.ErrNoValidPrice
-	ErrInvalidMarket           = types.ErrInvalidMarket
-	ErrInvalidOracle           = types.ErrInvalidOracle
-	NewGenesisState            = types.NewGenesisState
-	DefaultGenesisState        = types.DefaultGenesisState
-	CurrentPriceKey            = types.CurrentPriceKey
-	RawPriceKey                = types.RawPriceKey
-	NewCurrentPrice            = types.NewCurrentPrice
-	NewPostedPrice             = types.NewPostedPrice
-	NewMsgPostPrice            = types.NewMsgPostPrice
-	NewParams                  = types.NewParams
-	DefaultParams              = types.DefaultParams
-	ParamKeyTable              = types.ParamKeyTable
-	NewQueryWithMarketIDParams = types.NewQueryWithMarketIDParams
+	NewKeeper                    = keeper.NewKeeper
+	NewQuerier                   = keeper.NewQuerier
+	RegisterInvariants           = keeper.RegisterInvariants
+	ListedOraclesInvariant       = keeper.ListedOraclesInvariant
+	ActiveCurrentPricesInvariant = keeper.ActiveCurrentPricesInvariant
+	KnownRawPricesInvariant      = keeper.KnownRawPricesInvariant
+	RegisterCodec                = types.RegisterCodec
+	ErrEmptyInput                = types.ErrEmptyInput
+	ErrExpired                   = types.ErrExpired
+	ErrNoValidPrice              = types.ErrNoValidPrice
+	ErrInvalidMarket             = types.ErrInvalidMarket
+	ErrInvalidOracle             = types.ErrInvalidOracle
+	ErrAssetNotFound             = types.ErrAssetNotFound
+	ErrMarketNotUnstable         = types.ErrMarketNotUnstable
+	ErrOracleInactive            = types.ErrOracleInactive
+	ErrInvalidAggregationMode    = types.ErrInvalidAggregationMode
+	ErrInsufficientOracles       = types.ErrInsufficientOracles
+	ErrNoOracleStake             = types.ErrNoOracleStake
+	ErrOracleActive              = types.ErrOracleActive
+	NewGenesisState              = types.NewGenesisState
+	DefaultGenesisState          = types.DefaultGenesisState
+	CurrentPriceKey              = types.CurrentPriceKey
+	RawPriceKey                  = types.RawPriceKey
+	PriceHistoryKey              = types.PriceHistoryKey
+	UnstableMarketKey            = types.UnstableMarketKey
+	OracleMetricsKey             = types.OracleMetricsKey
+	PriceUpdatesKeyPrefix        = types.PriceUpdatesKeyPrefix
+	PriceUpdateKey               = types.PriceUpdateKey
+	ExceedsDeviation             = types.ExceedsDeviation
+	NewUnstableMarket            = types.NewUnstableMarket
+	NewMarketDerivation          = types.NewMarketDerivation
+	ValidateAggregationMode      = types.ValidateAggregationMode
+	WeightedMedian               = types.WeightedMedian
+	TrimmedMean                  = types.TrimmedMean
+	NewOracleMetrics             = types.NewOracleMetrics
+	MedianDistance               = types.MedianDistance
+	NewPriceRecord               = types.NewPriceRecord
+	NewPriceHistory              = types.NewPriceHistory
+	NewPriceUpdate               = types.NewPriceUpdate
+	NewCurrentPrice              = types.NewCurrentPrice
+	NewPostedPrice               = types.NewPostedPrice
+	NewMsgPostPrice              = types.NewMsgPostPrice
+	NewMsgPostPrices             = types.NewMsgPostPrices
+	NewPriceEntry                = types.NewPriceEntry
+	NewConfirmPriceProposal      = types.NewConfirmPriceProposal
+	NewReactivateOracleProposal  = types.NewReactivateOracleProposal
+	NewParams                    = types.NewParams
+	DefaultParams                = types.DefaultParams
+	ParamKeyTable                = types.ParamKeyTable
+	NewQueryWithMarketIDParams   = types.NewQueryWithMarketIDParams
+	NewQueryPriceUpdatesParams   = types.NewQueryPriceUpdatesParams
 
 	// variable aliases
-	ModuleCdc          = types.ModuleCdc
-	CurrentPricePrefix = types.CurrentPricePrefix
-	RawPriceFeedPrefix = types.RawPriceFeedPrefix
-	KeyMarkets         = types.KeyMarkets
-	DefaultMarkets     = types.DefaultMarkets
+	ModuleCdc                   = types.ModuleCdc
+	CurrentPricePrefix          = types.CurrentPricePrefix
+	RawPriceFeedPrefix          = types.RawPriceFeedPrefix
+	PriceHistoryPrefix          = types.PriceHistoryPrefix
+	UnstableMarketPrefix        = types.UnstableMarketPrefix
+	OracleMetricsPrefix         = types.OracleMetricsPrefix
+	PriceUpdatePrefix           = types.PriceUpdatePrefix
+	KeyMarkets                  = types.KeyMarkets
+	KeyTWAPWindow               = types.KeyTWAPWindow
+	KeyPriceHistoryLength       = types.KeyPriceHistoryLength
+	KeyPriceUpdateRetention     = types.KeyPriceUpdateRetention
+	DefaultMarkets              = types.DefaultMarkets
+	DefaultTWAPWindow           = types.DefaultTWAPWindow
+	DefaultPriceHistoryLength   = types.DefaultPriceHistoryLength
+	MaxPriceHistoryLength       = types.MaxPriceHistoryLength
+	DefaultPriceUpdateRetention = types.DefaultPriceUpdateRetention
 )
 
 // nolint
 type (
-	Keeper                  = keeper.Keeper
-	GenesisState            = types.GenesisState
-	Market                  = types.Market
-	Markets                 = types.Markets
-	CurrentPrice            = types.CurrentPrice
-	PostedPrice             = types.PostedPrice
-	PostedPrices            = types.PostedPrices
-	SortDecs                = types.SortDecs
-	MsgPostPrice            = types.MsgPostPrice
-	Params                  = types.Params
-	QueryWithMarketIDParams = types.QueryWithMarketIDParams
+	Keeper                   = keeper.Keeper
+	GenesisState             = types.GenesisState
+	Market                   = types.Market
+	MarketDerivation         = types.MarketDerivation
+	WeightedPrice            = types.WeightedPrice
+	Markets                  = types.Markets
+	CurrentPrice             = types.CurrentPrice
+	UnstableMarket           = types.UnstableMarket
+	OracleMetrics            = types.OracleMetrics
+	OracleMetricsList        = types.OracleMetricsList
+	PostedPrice              = types.PostedPrice
+	PostedPrices             = types.PostedPrices
+	SortDecs                 = types.SortDecs
+	PriceRecord              = types.PriceRecord
+	PriceRecords             = types.PriceRecords
+	PriceHistory             = types.PriceHistory
+	PriceUpdate              = types.PriceUpdate
+	PriceUpdates             = types.PriceUpdates
+	MsgPostPrice             = types.MsgPostPrice
+	MsgPostPrices            = types.MsgPostPrices
+	PriceEntry               = types.PriceEntry
+	ConfirmPriceProposal     = types.ConfirmPriceProposal
+	ReactivateOracleProposal = types.ReactivateOracleProposal
+	Params                   = types.Params
+	QueryWithMarketIDParams  = types.QueryWithMarketIDParams
+	QueryPriceUpdatesParams  = types.QueryPriceUpdatesParams
 )
@@ -2,6 +2,8 @@ package cli
 
 import (
 	"fmt"
+	"strings"
+	"time"
 
 	"github.com/spf13/cobra"
 
@@ -10,6 +12,9 @@ import (
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+
+	tmtime "github.com/tendermint/tendermint/types/time"
 
 	"github.com/kava-labs/kava/x/pricefeed/types"
 )
@@ -27,6 +32,10 @@ func GetQueryCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
 
 	pricefeedQueryCmd.AddCommand(flags.GetCommands(
 		GetCmdPrice(queryRoute, cdc),
+		GetCmdTWAPPrice(queryRoute, cdc),
+		GetCmdPriceHistory(queryRoute, cdc),
+		GetCmdPriceUpdates(queryRoute, cdc),
+		GetCmdOracleMetrics(queryRoute, cdc),
 		GetCmdRawPrices(queryRoute, cdc),
 		GetCmdOracles(queryRoute, cdc),
 		GetCmdMarkets(queryRoute, cdc),
@@ -94,6 +103,134 @@ func GetCmdPrice(queryRoute string, cdc *codec.Codec) *cobra.Command {
 	}
 }
 
+// GetCmdTWAPPrice queries the time-weighted average price of an asset
+func GetCmdTWAPPrice(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "twap [marketID]",
+		Short: "get the time-weighted average price for the input market",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			marketID := args[0]
+
+			bz, err := cdc.MarshalJSON(types.QueryWithMarketIDParams{
+				MarketID: marketID,
+			})
+			if err != nil {
+				return err
+			}
+			route := fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryTWAPPrice)
+
+			res, _, err := cliCtx.QueryWithData(route, bz)
+			if err != nil {
+				return err
+			}
+			var price types.CurrentPrice
+			cdc.MustUnmarshalJSON(res, &price)
+			return cliCtx.PrintOutput(price)
+		},
+	}
+}
+
+// GetCmdPriceHistory queries the recorded median prices of an asset
+func GetCmdPriceHistory(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "price-history [marketID]",
+		Short: "get the recorded median prices for the input market",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			marketID := args[0]
+
+			bz, err := cdc.MarshalJSON(types.QueryWithMarketIDParams{
+				MarketID: marketID,
+			})
+			if err != nil {
+				return err
+			}
+			route := fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryPriceHistory)
+
+			res, _, err := cliCtx.QueryWithData(route, bz)
+			if err != nil {
+				return err
+			}
+			var records types.PriceRecords
+			cdc.MustUnmarshalJSON(res, &records)
+			return cliCtx.PrintOutput(records)
+		},
+	}
+}
+
+// GetCmdPriceUpdates queries the recorded updates to the current price of an asset within a time range
+func GetCmdPriceUpdates(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "price-updates [marketID] [start] [end]",
+		Short: "get the recorded updates to the current price of the input market between two unix times",
+		Long: strings.TrimSpace(`Get the recorded updates to the current price of a market between two unix times, inclusive.
+The update in effect at the start time is included. A zero price means the market had no valid price.`),
+		Example: fmt.Sprintf("%s query %s price-updates bnb:usd 1600000000 1600086400", version.ClientName, types.ModuleName),
+		Args:    cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			marketID := args[0]
+
+			startInt, ok := sdk.NewIntFromString(args[1])
+			if !ok {
+				return fmt.Errorf("invalid start time - %s", args[1])
+			}
+			endInt, ok := sdk.NewIntFromString(args[2])
+			if !ok {
+				return fmt.Errorf("invalid end time - %s", args[2])
+			}
+			start := tmtime.Canonical(time.Unix(startInt.Int64(), 0))
+			end := tmtime.Canonical(time.Unix(endInt.Int64(), 0))
+
+			bz, err := cdc.MarshalJSON(types.NewQueryPriceUpdatesParams(marketID, start, end))
+			if err != nil {
+				return err
+			}
+			route := fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryPriceUpdates)
+
+			res, _, err := cliCtx.QueryWithData(route, bz)
+			if err != nil {
+				return err
+			}
+			var updates types.PriceUpdates
+			cdc.MustUnmarshalJSON(res, &updates)
+			return cliCtx.PrintOutput(updates)
+		},
+	}
+}
+
+// GetCmdOracleMetrics queries the performance metrics of the oracles of an asset
+func GetCmdOracleMetrics(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "oracle-metrics [marketID]",
+		Short: "get the performance metrics of the oracles for the input market",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			marketID := args[0]
+
+			bz, err := cdc.MarshalJSON(types.QueryWithMarketIDParams{
+				MarketID: marketID,
+			})
+			if err != nil {
+				return err
+			}
+			route := fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryOracleMetrics)
+
+			res, _, err := cliCtx.QueryWithData(route, bz)
+			if err != nil {
+				return err
+			}
+			var metrics types.OracleMetricsList
+			cdc.MustUnmarshalJSON(res, &metrics)
+			return cliCtx.PrintOutput(metrics)
+		},
+	}
+}
+
 // GetCmdRawPrices queries the current price of an asset
 func GetCmdRawPrices(queryRoute string, cdc *codec.Codec) *cobra.Command {
 	return &cobra.Command{
@@ -3,6 +3,7 @@ package cli
 import (
 	"bufio"
 	"fmt"
+	"io/ioutil"
 	"time"
 
 	"github.com/spf13/cobra"
@@ -12,9 +13,12 @@ import (
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
 	"github.com/cosmos/cosmos-sdk/x/auth"
 	"github.com/cosmos/cosmos-sdk/x/auth/client/utils"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 
+	"github.com/tendermint/tendermint/crypto"
 	tmtime "github.com/tendermint/tendermint/types/time"
 
 	"github.com/kava-labs/kava/x/pricefeed/types"
@@ -32,6 +36,7 @@ func GetTxCmd(storeKey string, cdc *codec.Codec) *cobra.Command {
 
 	pricefeedTxCmd.AddCommand(flags.PostCommands(
 		GetCmdPostPrice(cdc),
+		GetCmdPostPrices(cdc),
 	)...)
 
 	return pricefeedTxCmd
@@ -67,3 +72,179 @@ func GetCmdPostPrice(cdc *codec.Codec) *cobra.Command {
 		},
 	}
 }
+
+// GetCmdPostPrices cli command for posting prices for several markets at once.
+func GetCmdPostPrices(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "postprices [marketID] [price] [expiry] [[marketID] [price] [expiry]...]",
+		Short: "post the latest prices for several markets",
+		Long:  "Post the latest prices for several markets in a single message. Either all of the prices are posted or none are.",
+		Example: fmt.Sprintf(
+			"%s tx %s postprices btc:usd 9000.00 1600000000 xrp:usd 0.25 1600000000 --from oracle",
+			version.ClientName, types.ModuleName,
+		),
+		Args: func(cmd *cobra.Command, args []string) error {
+			if len(args) == 0 || len(args)%3 != 0 {
+				return fmt.Errorf("expected groups of [marketID] [price] [expiry], received %d args", len(args))
+			}
+			return nil
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			var entries []types.PriceEntry
+			for i := 0; i < len(args); i += 3 {
+				price, err := sdk.NewDecFromStr(args[i+1])
+				if err != nil {
+					return err
+				}
+				expiryInt, ok := sdk.NewIntFromString(args[i+2])
+				if !ok {
+					return fmt.Errorf("invalid expiry - %s", args[i+2])
+				}
+				expiry := tmtime.Canonical(time.Unix(expiryInt.Int64(), 0))
+				entries = append(entries, types.NewPriceEntry(args[i], price, expiry))
+			}
+
+			msg := types.NewMsgPostPrices(cliCtx.GetFromAddress(), entries)
+			err := msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
+// GetGovCmdSubmitProposal returns a command to submit a proposal to the gov module. It is passed to the gov module for use on its command subtree.
+func GetGovCmdSubmitProposal(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "confirm-price [proposal-file] [deposit]",
+		Short: "Submit a governance proposal to confirm the price of an unstable market.",
+		Long: fmt.Sprintf(`Submit a governance proposal to accept the median price of a market whose price moved outside its deviation limits.
+
+The proposal file must be the json encoded form of the proposal, for example:
+%s
+`, MustGetExampleConfirmPriceProposal(cdc)),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			// Get proposing address
+			proposer := cliCtx.GetFromAddress()
+
+			// Get the deposit
+			deposit, err := sdk.ParseCoins(args[1])
+			if err != nil {
+				return err
+			}
+
+			// Get the proposal
+			bz, err := ioutil.ReadFile(args[0])
+			if err != nil {
+				return err
+			}
+			var content types.ConfirmPriceProposal
+			if err := cdc.UnmarshalJSON(bz, &content); err != nil {
+				return err
+			}
+			if err = content.ValidateBasic(); err != nil {
+				return err
+			}
+
+			// Build message and run basic validation
+			msg := govtypes.NewMsgSubmitProposal(content, deposit, proposer)
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+
+			// Sign and broadcast message
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
+// GetGovCmdSubmitReactivateOracleProposal returns a command to submit a proposal reactivating a deactivated oracle to the gov module.
+func GetGovCmdSubmitReactivateOracleProposal(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "reactivate-oracle [proposal-file] [deposit]",
+		Short: "Submit a governance proposal to reactivate a deactivated oracle.",
+		Long: fmt.Sprintf(`Submit a governance proposal to reactivate an oracle that was deactivated for a market after missing or misreporting too many prices.
+
+The proposal file must be the json encoded form of the proposal, for example:
+%s
+`, MustGetExampleReactivateOracleProposal(cdc)),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			// Get proposing address
+			proposer := cliCtx.GetFromAddress()
+
+			// Get the deposit
+			deposit, err := sdk.ParseCoins(args[1])
+			if err != nil {
+				return err
+			}
+
+			// Get the proposal
+			bz, err := ioutil.ReadFile(args[0])
+			if err != nil {
+				return err
+			}
+			var content types.ReactivateOracleProposal
+			if err := cdc.UnmarshalJSON(bz, &content); err != nil {
+				return err
+			}
+			if err = content.ValidateBasic(); err != nil {
+				return err
+			}
+
+			// Build message and run basic validation
+			msg := govtypes.NewMsgSubmitProposal(content, deposit, proposer)
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+
+			// Sign and broadcast message
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
+// MustGetExampleConfirmPriceProposal is a helper function to return an example json proposal
+func MustGetExampleConfirmPriceProposal(cdc *codec.Codec) string {
+	exampleProposal := types.NewConfirmPriceProposal(
+		"A Title",
+		"A description of this proposal.",
+		"bnb:usd",
+	)
+	bz, err := cdc.MarshalJSONIndent(exampleProposal, "", "  ")
+	if err != nil {
+		panic(err)
+	}
+	return string(bz)
+}
+
+// MustGetExampleReactivateOracleProposal is a helper function to return an example json proposal
+func MustGetExampleReactivateOracleProposal(cdc *codec.Codec) string {
+	exampleProposal := types.NewReactivateOracleProposal(
+		"A Title",
+		"A description of this proposal.",
+		"bnb:usd",
+		sdk.AccAddress(crypto.AddressHash([]byte("exampleAddress"))),
+	)
+	bz, err := cdc.MarshalJSONIndent(exampleProposal, "", "  ")
+	if err != nil {
+		panic(err)
+	}
+	return string(bz)
+}
@@ -0,0 +1,14 @@
+package client
+
+import (
+	govclient "github.com/cosmos/cosmos-sdk/x/gov/client"
+
+	"github.com/kava-labs/kava/x/pricefeed/client/cli"
+	"github.com/kava-labs/kava/x/pricefeed/client/rest"
+)
+
+// ProposalHandler is a struct containing handler funcs for submiting ConfirmPrice proposal txs to the gov module through the cli or rest.
+var ProposalHandler = govclient.NewProposalHandler(cli.GetGovCmdSubmitProposal, rest.ProposalRESTHandler)
+
+// ReactivateOracleProposalHandler is a struct containing handler funcs for submiting ReactivateOracle proposal txs to the gov module through the cli or rest.
+var ReactivateOracleProposalHandler = govclient.NewProposalHandler(cli.GetGovCmdSubmitReactivateOracleProposal, rest.ReactivateOracleProposalRESTHandler)
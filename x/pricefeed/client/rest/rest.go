@@ -4,11 +4,14 @@ import (
 	"github.com/gorilla/mux"
 
 	"github.com/cosmos/cosmos-sdk/client/context"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/rest"
 )
 
 const (
 	RestMarketID = "market_id"
+	RestStart    = "start"
+	RestEnd      = "end"
 )
 
 // PostPriceReq defines the properties of a PostPrice request's body.
@@ -19,6 +22,40 @@ type PostPriceReq struct {
 	Expiry   string       `json:"expiry"`
 }
 
+// PostPricesReq defines the properties of a PostPrices request's body.
+type PostPricesReq struct {
+	BaseReq rest.BaseReq    `json:"base_req"`
+	Prices  []PriceEntryReq `json:"prices"`
+}
+
+// PriceEntryReq defines the properties of a single market's price within a PostPrices request's body.
+type PriceEntryReq struct {
+	MarketID string `json:"market_id"`
+	Price    string `json:"price"`
+	Expiry   string `json:"expiry"`
+}
+
+// ConfirmPriceProposalReq defines the properties of a ConfirmPrice gov proposal request's body.
+type ConfirmPriceProposalReq struct {
+	BaseReq     rest.BaseReq   `json:"base_req" yaml:"base_req"`
+	Title       string         `json:"title" yaml:"title"`
+	Description string         `json:"description" yaml:"description"`
+	MarketID    string         `json:"market_id" yaml:"market_id"`
+	Proposer    sdk.AccAddress `json:"proposer" yaml:"proposer"`
+	Deposit     sdk.Coins      `json:"deposit" yaml:"deposit"`
+}
+
+// ReactivateOracleProposalReq defines the properties of a ReactivateOracle gov proposal request's body.
+type ReactivateOracleProposalReq struct {
+	BaseReq     rest.BaseReq   `json:"base_req" yaml:"base_req"`
+	Title       string         `json:"title" yaml:"title"`
+	Description string         `json:"description" yaml:"description"`
+	MarketID    string         `json:"market_id" yaml:"market_id"`
+	Oracle      sdk.AccAddress `json:"oracle" yaml:"oracle"`
+	Proposer    sdk.AccAddress `json:"proposer" yaml:"proposer"`
+	Deposit     sdk.Coins      `json:"deposit" yaml:"deposit"`
+}
+
 // RegisterRoutes - Central function to define routes that get registered by the main application
 func RegisterRoutes(cliCtx context.CLIContext, r *mux.Router) {
 	registerQueryRoutes(cliCtx, r)
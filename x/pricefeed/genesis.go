@@ -35,6 +35,14 @@ func InitGenesis(ctx sdk.Context, keeper Keeper, gs GenesisState) {
 			panic(err)
 		}
 	}
+
+	// Set the current price of derived markets from the prices of their inputs.
+	// Inputs without any posted prices have no current price, so errors are not fatal.
+	for _, market := range params.Markets {
+		if market.Active && market.IsDerived() {
+			_ = keeper.SetCurrentPrices(ctx, market.MarketID)
+		}
+	}
 }
 
 // ExportGenesis returns a GenesisState for a given context and keeper.
@@ -1,6 +1,8 @@
 package pricefeed
 
 import (
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
@@ -12,6 +14,8 @@ func NewHandler(k Keeper) sdk.Handler {
 		switch msg := msg.(type) {
 		case MsgPostPrice:
 			return HandleMsgPostPrice(ctx, k, msg)
+		case MsgPostPrices:
+			return HandleMsgPostPrices(ctx, k, msg)
 		default:
 			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized %s message type: %T", ModuleName, msg)
 		}
@@ -27,7 +31,7 @@ func HandleMsgPostPrice(
 	k Keeper,
 	msg MsgPostPrice) (*sdk.Result, error) {
 
-	_, err := k.GetOracle(ctx, msg.MarketID, msg.From)
+	err := validatePostPrice(ctx, k, msg.From, msg.MarketID, msg.Expiry)
 	if err != nil {
 		return nil, err
 	}
@@ -46,3 +50,50 @@ func HandleMsgPostPrice(
 
 	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
 }
+
+// HandleMsgPostPrices handles prices for several markets posted by oracles.
+// If any of the prices is rejected the message fails and none of the prices are set.
+func HandleMsgPostPrices(
+	ctx sdk.Context,
+	k Keeper,
+	msg MsgPostPrices) (*sdk.Result, error) {
+
+	// check every price can be posted before setting any of them
+	for _, entry := range msg.Prices {
+		err := validatePostPrice(ctx, k, msg.From, entry.MarketID, entry.Expiry)
+		if err != nil {
+			return nil, sdkerrors.Wrap(err, entry.MarketID)
+		}
+	}
+	for _, entry := range msg.Prices {
+		_, err := k.SetPrice(ctx, msg.From, entry.MarketID, entry.Price, entry.Expiry)
+		if err != nil {
+			return nil, sdkerrors.Wrap(err, entry.MarketID)
+		}
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.From.String()),
+		),
+	)
+
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
+// validatePostPrice checks the oracle is authorized and active for the market and the price has not expired
+func validatePostPrice(ctx sdk.Context, k Keeper, oracle sdk.AccAddress, marketID string, expiry time.Time) error {
+	_, err := k.GetOracle(ctx, marketID, oracle)
+	if err != nil {
+		return err
+	}
+	if !k.IsOracleActive(ctx, marketID, oracle) {
+		return sdkerrors.Wrap(ErrOracleInactive, oracle.String())
+	}
+	if !expiry.After(ctx.BlockTime()) {
+		return ErrExpired
+	}
+	return nil
+}
@@ -0,0 +1,108 @@
+package pricefeed_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/kava-labs/kava/app"
+	"github.com/kava-labs/kava/x/pricefeed"
+)
+
+type HandlerTestSuite struct {
+	suite.Suite
+
+	handler sdk.Handler
+	keeper  pricefeed.Keeper
+	ctx     sdk.Context
+	addrs   []sdk.AccAddress
+}
+
+func (suite *HandlerTestSuite) SetupTest() {
+	tApp := app.NewTestApp()
+	_, addrs := app.GeneratePrivKeyAddressPairs(2)
+	tApp.InitializeFromGenesisStates(NewPricefeedGenStateWithOracles(addrs[:1]))
+	suite.ctx = tApp.NewContext(true, abci.Header{Height: 1, Time: time.Now().UTC()})
+	suite.keeper = tApp.GetPriceFeedKeeper()
+	suite.handler = pricefeed.NewHandler(suite.keeper)
+	suite.addrs = addrs
+}
+
+func (suite *HandlerTestSuite) TestMsgPostPrices() {
+	expiry := suite.ctx.BlockTime().Add(time.Hour)
+	msg := pricefeed.NewMsgPostPrices(suite.addrs[0], []pricefeed.PriceEntry{
+		pricefeed.NewPriceEntry("btc:usd", sdk.MustNewDecFromStr("9000.00"), expiry),
+		pricefeed.NewPriceEntry("xrp:usd", sdk.MustNewDecFromStr("0.30"), expiry),
+	})
+
+	res, err := suite.handler(suite.ctx, msg)
+	suite.Require().NoError(err)
+
+	var priceEvents int
+	for _, e := range res.Events {
+		if e.Type == pricefeed.EventTypeOracleUpdatedPrice {
+			priceEvents++
+		}
+	}
+	suite.Equal(2, priceEvents)
+
+	for _, entry := range msg.Prices {
+		rawPrices, err := suite.keeper.GetRawPrices(suite.ctx, entry.MarketID)
+		suite.Require().NoError(err)
+		suite.Equal(entry.Price, rawPrices[0].Price)
+	}
+}
+
+func (suite *HandlerTestSuite) TestMsgPostPrices_Atomic() {
+	expiry := suite.ctx.BlockTime().Add(time.Hour)
+	testCases := []struct {
+		name    string
+		from    sdk.AccAddress
+		entries []pricefeed.PriceEntry
+	}{
+		{
+			"unknown market",
+			suite.addrs[0],
+			[]pricefeed.PriceEntry{
+				pricefeed.NewPriceEntry("btc:usd", sdk.MustNewDecFromStr("9000.00"), expiry),
+				pricefeed.NewPriceEntry("bnb:usd", sdk.MustNewDecFromStr("20.00"), expiry),
+			},
+		},
+		{
+			"expired price",
+			suite.addrs[0],
+			[]pricefeed.PriceEntry{
+				pricefeed.NewPriceEntry("btc:usd", sdk.MustNewDecFromStr("9000.00"), expiry),
+				pricefeed.NewPriceEntry("xrp:usd", sdk.MustNewDecFromStr("0.30"), suite.ctx.BlockTime()),
+			},
+		},
+		{
+			"unauthorized oracle",
+			suite.addrs[1],
+			[]pricefeed.PriceEntry{
+				pricefeed.NewPriceEntry("btc:usd", sdk.MustNewDecFromStr("9000.00"), expiry),
+			},
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			_, err := suite.handler(suite.ctx, pricefeed.NewMsgPostPrices(tc.from, tc.entries))
+			suite.Require().Error(err)
+
+			// the price posted at genesis is unchanged
+			rawPrices, err := suite.keeper.GetRawPrices(suite.ctx, "btc:usd")
+			suite.Require().NoError(err)
+			suite.Len(rawPrices, 1)
+			suite.Equal(sdk.MustNewDecFromStr("8000.00"), rawPrices[0].Price)
+		})
+	}
+}
+
+func TestHandlerTestSuite(t *testing.T) {
+	suite.Run(t, new(HandlerTestSuite))
+}
@@ -0,0 +1,74 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto/ed25519"
+
+	"github.com/kava-labs/kava/app"
+	"github.com/kava-labs/kava/x/pricefeed/types"
+)
+
+// TestKeeper_AggregationModes Test combining oracle prices with each of the aggregation modes
+func TestKeeper_AggregationModes(t *testing.T) {
+	_, addrs := app.GeneratePrivKeyAddressPairs(4)
+	tApp := app.NewTestApp()
+	ctx := tApp.NewContext(true, abci.Header{Time: time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)})
+	keeper := tApp.GetPriceFeedKeeper()
+
+	// give the oracle posting the highest price most of the stake
+	sk := tApp.GetStakingKeeper()
+	valAddr := sdk.ValAddress(ed25519.GenPrivKey().PubKey().Address())
+	validator := staking.NewValidator(valAddr, ed25519.GenPrivKey().PubKey(), staking.Description{})
+	for i, amount := range []int64{1, 1, 1, 10} {
+		var shares sdk.Dec
+		validator, shares = validator.AddTokensFromDel(sdk.NewInt(amount))
+		sk.SetDelegation(ctx, staking.NewDelegation(addrs[i], valAddr, shares))
+	}
+	sk.SetValidator(ctx, validator)
+
+	setParams := func(market types.Market) {
+		market.MarketID, market.BaseAsset, market.QuoteAsset, market.Oracles, market.Active = "tstusd", "tst", "usd", addrs, true
+		keeper.SetParams(ctx, types.NewParams(types.Markets{market}, time.Hour, 60, 0))
+	}
+	requireCurrentPrice := func(price string) {
+		currentPrice, err := keeper.GetCurrentPrice(ctx, "tstusd")
+		require.NoError(t, err)
+		require.Equal(t, sdk.MustNewDecFromStr(price), currentPrice.Price)
+	}
+
+	setParams(types.Market{})
+	for i, price := range []string{"1", "10", "11", "100"} {
+		_, err := keeper.SetPrice(ctx, addrs[i], "tstusd", sdk.MustNewDecFromStr(price), ctx.BlockTime().Add(time.Hour))
+		require.NoError(t, err)
+	}
+
+	require.NoError(t, keeper.SetCurrentPrices(ctx, "tstusd"))
+	requireCurrentPrice("10.5")
+
+	setParams(types.Market{AggregationMode: types.AggregationWeightedMedian})
+	require.Equal(t, sdk.NewDec(10), keeper.GetOracleStake(ctx, addrs[3]))
+	require.NoError(t, keeper.SetCurrentPrices(ctx, "tstusd"))
+	requireCurrentPrice("100")
+
+	setParams(types.Market{AggregationMode: types.AggregationTrimmedMean, TrimFraction: sdk.MustNewDecFromStr("0.25")})
+	require.NoError(t, keeper.SetCurrentPrices(ctx, "tstusd"))
+	requireCurrentPrice("10.5")
+
+	setParams(types.Market{AggregationMode: types.AggregationTrimmedMean})
+	require.NoError(t, keeper.SetCurrentPrices(ctx, "tstusd"))
+	requireCurrentPrice("30.5")
+
+	// no current price is set when too few oracles have posted valid prices
+	setParams(types.Market{MinimumOracles: 5})
+	require.Error(t, keeper.SetCurrentPrices(ctx, "tstusd"))
+	_, err := keeper.GetCurrentPrice(ctx, "tstusd")
+	require.Error(t, err)
+}
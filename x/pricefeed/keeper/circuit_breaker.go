@@ -0,0 +1,98 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kava-labs/kava/x/pricefeed/types"
+)
+
+// GetUnstableMarket returns the unstable market record for a market, or false if the market is stable
+func (k Keeper) GetUnstableMarket(ctx sdk.Context, marketID string) (types.UnstableMarket, bool) {
+	store := ctx.KVStore(k.key)
+	bz := store.Get(types.UnstableMarketKey(marketID))
+	if bz == nil {
+		return types.UnstableMarket{}, false
+	}
+	var unstableMarket types.UnstableMarket
+	k.cdc.MustUnmarshalBinaryBare(bz, &unstableMarket)
+	return unstableMarket, true
+}
+
+// SetUnstableMarket stores an unstable market record
+func (k Keeper) SetUnstableMarket(ctx sdk.Context, unstableMarket types.UnstableMarket) {
+	store := ctx.KVStore(k.key)
+	store.Set(types.UnstableMarketKey(unstableMarket.MarketID), k.cdc.MustMarshalBinaryBare(unstableMarket))
+}
+
+// DeleteUnstableMarket removes the unstable market record for a market
+func (k Keeper) DeleteUnstableMarket(ctx sdk.Context, marketID string) {
+	store := ctx.KVStore(k.key)
+	store.Delete(types.UnstableMarketKey(marketID))
+}
+
+// IsMarketUnstable returns true if the median price of the market has breached its deviation limits and the previous price is being held.
+// A derived market is unstable if either of its input markets is unstable.
+func (k Keeper) IsMarketUnstable(ctx sdk.Context, marketID string) bool {
+	market, found := k.GetMarket(ctx, marketID)
+	if found && market.IsDerived() {
+		return k.IsMarketUnstable(ctx, market.Derivation.FirstMarketID) || k.IsMarketUnstable(ctx, market.Derivation.SecondMarketID)
+	}
+	_, found = k.GetUnstableMarket(ctx, marketID)
+	return found
+}
+
+// breachesDeviationLimits checks the new median price of a market against the previous price and the prices recorded within the deviation window
+func (k Keeper) breachesDeviationLimits(ctx sdk.Context, market types.Market, prevPrice, price sdk.Dec) bool {
+	if types.ExceedsDeviation(prevPrice, price, market.MaxBlockDeviation) {
+		return true
+	}
+	if types.ExceedsDeviation(prevPrice, price, market.MaxWindowDeviation) {
+		return true
+	}
+	windowStart := ctx.BlockTime().Add(-market.DeviationWindow)
+	for _, record := range k.GetPriceRecords(ctx, market.MarketID) {
+		if record.Timestamp.Before(windowStart) {
+			continue
+		}
+		if types.ExceedsDeviation(record.Price, price, market.MaxWindowDeviation) {
+			return true
+		}
+	}
+	return false
+}
+
+// setMarketUnstable marks a market as unstable, emitting an event when the market first becomes unstable
+func (k Keeper) setMarketUnstable(ctx sdk.Context, marketID string, heldPrice, rejectedPrice sdk.Dec) {
+	unstableMarket, found := k.GetUnstableMarket(ctx, marketID)
+	if found {
+		unstableMarket.RejectedPrice = rejectedPrice
+		k.SetUnstableMarket(ctx, unstableMarket)
+		return
+	}
+	k.SetUnstableMarket(ctx, types.NewUnstableMarket(marketID, heldPrice, rejectedPrice, ctx.BlockTime()))
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeMarketUnstable,
+			sdk.NewAttribute(types.AttributeMarketID, marketID),
+			sdk.NewAttribute(types.AttributeMarketPrice, heldPrice.String()),
+			sdk.NewAttribute(types.AttributeRejectedPrice, rejectedPrice.String()),
+		),
+	)
+}
+
+// setMarketStable clears the unstable status of a market, emitting an event if the market was unstable
+func (k Keeper) setMarketStable(ctx sdk.Context, marketID string, price sdk.Dec) {
+	if !k.IsMarketUnstable(ctx, marketID) {
+		return
+	}
+	k.DeleteUnstableMarket(ctx, marketID)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeMarketStable,
+			sdk.NewAttribute(types.AttributeMarketID, marketID),
+			sdk.NewAttribute(types.AttributeMarketPrice, price.String()),
+		),
+	)
+}
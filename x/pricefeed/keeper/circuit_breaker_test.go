@@ -0,0 +1,92 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/kava-labs/kava/app"
+	"github.com/kava-labs/kava/x/pricefeed/types"
+)
+
+// TestKeeper_DeviationCircuitBreaker Test holding the current price when the median moves too far
+func TestKeeper_DeviationCircuitBreaker(t *testing.T) {
+	_, addrs := app.GeneratePrivKeyAddressPairs(1)
+	tApp := app.NewTestApp()
+	startTime := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	ctx := tApp.NewContext(true, abci.Header{Time: startTime})
+	keeper := tApp.GetPriceFeedKeeper()
+
+	mp := types.NewParams(
+		types.Markets{
+			types.Market{
+				MarketID: "tstusd", BaseAsset: "tst", QuoteAsset: "usd", Oracles: []sdk.AccAddress{}, Active: true,
+				MaxBlockDeviation:  sdk.MustNewDecFromStr("0.1"),
+				MaxWindowDeviation: sdk.MustNewDecFromStr("0.15"),
+				DeviationWindow:    time.Hour,
+			},
+		},
+		time.Hour,
+		60,
+		0,
+	)
+	keeper.SetParams(ctx, mp)
+
+	setPrice := func(ctx sdk.Context, price string) {
+		_, err := keeper.SetPrice(ctx, addrs[0], "tstusd", sdk.MustNewDecFromStr(price), ctx.BlockTime().Add(time.Hour))
+		require.NoError(t, err)
+		require.NoError(t, keeper.SetCurrentPrices(ctx, "tstusd"))
+	}
+	requireCurrentPrice := func(ctx sdk.Context, price string) {
+		currentPrice, err := keeper.GetCurrentPrice(ctx, "tstusd")
+		require.NoError(t, err)
+		require.Equal(t, sdk.MustNewDecFromStr(price), currentPrice.Price)
+	}
+
+	setPrice(ctx, "100")
+	requireCurrentPrice(ctx, "100")
+	require.False(t, keeper.IsMarketUnstable(ctx, "tstusd"))
+
+	// a move within the block limit is accepted
+	ctx = ctx.WithBlockTime(startTime.Add(1 * time.Minute))
+	setPrice(ctx, "109")
+	requireCurrentPrice(ctx, "109")
+
+	// a move within the block limit that breaches the window limit is held
+	ctx = ctx.WithBlockTime(startTime.Add(2 * time.Minute)).WithEventManager(sdk.NewEventManager())
+	setPrice(ctx, "118")
+	requireCurrentPrice(ctx, "109")
+	require.True(t, keeper.IsMarketUnstable(ctx, "tstusd"))
+	require.Equal(t, types.EventTypeMarketUnstable, ctx.EventManager().Events()[len(ctx.EventManager().Events())-1].Type)
+
+	// the market stays unstable while the median is too far from the held price
+	ctx = ctx.WithBlockTime(startTime.Add(3 * time.Minute))
+	setPrice(ctx, "150")
+	requireCurrentPrice(ctx, "109")
+	unstableMarket, found := keeper.GetUnstableMarket(ctx, "tstusd")
+	require.True(t, found)
+	require.Equal(t, types.NewUnstableMarket("tstusd", sdk.MustNewDecFromStr("109"), sdk.MustNewDecFromStr("150"), startTime.Add(2*time.Minute)), unstableMarket)
+
+	// the market is stable again once the oracles converge on a price within the limits
+	ctx = ctx.WithBlockTime(startTime.Add(4 * time.Minute)).WithEventManager(sdk.NewEventManager())
+	setPrice(ctx, "110")
+	requireCurrentPrice(ctx, "110")
+	require.False(t, keeper.IsMarketUnstable(ctx, "tstusd"))
+	require.Equal(t, types.EventTypeMarketStable, ctx.EventManager().Events()[len(ctx.EventManager().Events())-2].Type)
+
+	// a large move can be confirmed
+	ctx = ctx.WithBlockTime(startTime.Add(5 * time.Minute))
+	setPrice(ctx, "200")
+	requireCurrentPrice(ctx, "110")
+	require.NoError(t, keeper.ConfirmPrice(ctx, "tstusd"))
+	requireCurrentPrice(ctx, "200")
+	require.False(t, keeper.IsMarketUnstable(ctx, "tstusd"))
+
+	// only unstable markets can be confirmed
+	require.Error(t, keeper.ConfirmPrice(ctx, "tstusd"))
+}
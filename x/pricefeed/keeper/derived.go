@@ -0,0 +1,62 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kava-labs/kava/x/pricefeed/types"
+)
+
+// setDerivedPrice updates the price of a derived market from the current prices of its input markets.
+// The price is only valid if both input markets are active and have a valid price.
+func (k Keeper) setDerivedPrice(ctx sdk.Context, market types.Market) error {
+	validPrevPrice := true
+	prevPrice, err := k.GetCurrentPrice(ctx, market.MarketID)
+	if err != nil {
+		validPrevPrice = false
+	}
+
+	firstPrice, err := k.getDerivationInputPrice(ctx, market.Derivation.FirstMarketID)
+	if err != nil {
+		k.clearCurrentPrice(ctx, market.MarketID)
+		return err
+	}
+	secondPrice, err := k.getDerivationInputPrice(ctx, market.Derivation.SecondMarketID)
+	if err != nil {
+		k.clearCurrentPrice(ctx, market.MarketID)
+		return err
+	}
+	price := market.Derivation.Derive(firstPrice, secondPrice)
+
+	// only emit event if price has changed
+	if validPrevPrice && !price.Equal(prevPrice.Price) {
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeMarketPriceUpdated,
+				sdk.NewAttribute(types.AttributeMarketID, market.MarketID),
+				sdk.NewAttribute(types.AttributeMarketPrice, price.String()),
+			),
+		)
+	}
+
+	store := ctx.KVStore(k.key)
+	store.Set(
+		types.CurrentPriceKey(market.MarketID), k.cdc.MustMarshalBinaryBare(types.NewCurrentPrice(market.MarketID, price)),
+	)
+	k.recordPrice(ctx, market.MarketID, price)
+	k.recordPriceUpdate(ctx, market.MarketID, price)
+
+	return nil
+}
+
+// getDerivationInputPrice returns the current price of an input market of a derived market
+func (k Keeper) getDerivationInputPrice(ctx sdk.Context, marketID string) (sdk.Dec, error) {
+	market, found := k.GetMarket(ctx, marketID)
+	if !found || !market.Active {
+		return sdk.Dec{}, types.ErrNoValidPrice
+	}
+	currentPrice, err := k.GetCurrentPrice(ctx, marketID)
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+	return currentPrice.Price, nil
+}
@@ -0,0 +1,79 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/kava-labs/kava/app"
+	"github.com/kava-labs/kava/x/pricefeed/types"
+)
+
+// TestKeeper_DerivedMarkets Test calculating the price of markets derived from other markets
+func TestKeeper_DerivedMarkets(t *testing.T) {
+	_, addrs := app.GeneratePrivKeyAddressPairs(1)
+	tApp := app.NewTestApp()
+	ctx := tApp.NewContext(true, abci.Header{Time: time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)})
+	keeper := tApp.GetPriceFeedKeeper()
+
+	mp := types.NewParams(
+		types.Markets{
+			types.Market{MarketID: "bnb:btc", BaseAsset: "bnb", QuoteAsset: "btc", Oracles: addrs, Active: true},
+			types.Market{MarketID: "btc:usd", BaseAsset: "btc", QuoteAsset: "usd", Oracles: addrs, Active: true},
+			types.Market{
+				MarketID: "bnb:usd", BaseAsset: "bnb", QuoteAsset: "usd", Active: true,
+				Derivation: types.NewMarketDerivation(types.DerivationProduct, "bnb:btc", "btc:usd"),
+			},
+		},
+		time.Hour,
+		60,
+		0,
+	)
+	keeper.SetParams(ctx, mp)
+
+	// the derived market is invalid while an input has no price
+	_, err := keeper.SetPrice(ctx, addrs[0], "bnb:btc", sdk.MustNewDecFromStr("0.002"), ctx.BlockTime().Add(time.Hour))
+	require.NoError(t, err)
+	require.NoError(t, keeper.SetCurrentPrices(ctx, "bnb:btc"))
+	require.Error(t, keeper.SetCurrentPrices(ctx, "btc:usd"))
+	require.Error(t, keeper.SetCurrentPrices(ctx, "bnb:usd"))
+	_, err = keeper.GetCurrentPrice(ctx, "bnb:usd")
+	require.Error(t, err)
+
+	// the derived market is valid once all inputs are valid
+	_, err = keeper.SetPrice(ctx, addrs[0], "btc:usd", sdk.MustNewDecFromStr("10000"), ctx.BlockTime().Add(time.Hour))
+	require.NoError(t, err)
+	require.NoError(t, keeper.SetCurrentPrices(ctx, "btc:usd"))
+	require.NoError(t, keeper.SetCurrentPrices(ctx, "bnb:usd"))
+	price, err := keeper.GetCurrentPrice(ctx, "bnb:usd")
+	require.NoError(t, err)
+	require.Equal(t, sdk.MustNewDecFromStr("20"), price.Price)
+
+	// ratio markets divide the first input by the second
+	mp.Markets = append(mp.Markets, types.Market{
+		MarketID: "usd:btc", BaseAsset: "usd", QuoteAsset: "btc", Active: true,
+		Derivation: types.NewMarketDerivation(types.DerivationRatio, "bnb:btc", "btc:usd"),
+	})
+	keeper.SetParams(ctx, mp)
+	require.NoError(t, keeper.SetCurrentPrices(ctx, "usd:btc"))
+	price, err = keeper.GetCurrentPrice(ctx, "usd:btc")
+	require.NoError(t, err)
+	require.Equal(t, sdk.MustNewDecFromStr("0.0000002"), price.Price)
+
+	// the derived market is invalid once an input is inactive
+	mp.Markets[1].Active = false
+	keeper.SetParams(ctx, mp)
+	require.Error(t, keeper.SetCurrentPrices(ctx, "bnb:usd"))
+	_, err = keeper.GetCurrentPrice(ctx, "bnb:usd")
+	require.Error(t, err)
+
+	// the derived market is unstable while an input is unstable
+	require.False(t, keeper.IsMarketUnstable(ctx, "bnb:usd"))
+	keeper.SetUnstableMarket(ctx, types.NewUnstableMarket("bnb:btc", sdk.OneDec(), sdk.OneDec(), ctx.BlockTime()))
+	require.True(t, keeper.IsMarketUnstable(ctx, "bnb:usd"))
+}
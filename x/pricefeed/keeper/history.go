@@ -0,0 +1,72 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/kava-labs/kava/x/pricefeed/types"
+)
+
+// GetPriceHistory returns the ring buffer of median prices recorded for a market
+func (k Keeper) GetPriceHistory(ctx sdk.Context, marketID string) types.PriceHistory {
+	store := ctx.KVStore(k.key)
+	bz := store.Get(types.PriceHistoryKey(marketID))
+	if bz == nil {
+		return types.NewPriceHistory(marketID)
+	}
+	var history types.PriceHistory
+	k.cdc.MustUnmarshalBinaryBare(bz, &history)
+	return history
+}
+
+// SetPriceHistory stores the price history for a market
+func (k Keeper) SetPriceHistory(ctx sdk.Context, history types.PriceHistory) {
+	store := ctx.KVStore(k.key)
+	store.Set(types.PriceHistoryKey(history.MarketID), k.cdc.MustMarshalBinaryBare(history))
+}
+
+// GetPriceRecords returns the median prices recorded for a market, ordered from oldest to newest
+func (k Keeper) GetPriceRecords(ctx sdk.Context, marketID string) types.PriceRecords {
+	return k.GetPriceHistory(ctx, marketID).Ordered()
+}
+
+// recordPrice adds a median price to the price history of a market.
+// Prices are sampled at most once per sample interval so that the history covers the whole TWAP window.
+func (k Keeper) recordPrice(ctx sdk.Context, marketID string, price sdk.Dec) {
+	params := k.GetParams(ctx)
+	if params.PriceHistoryLength == 0 {
+		return
+	}
+	history := k.GetPriceHistory(ctx, marketID)
+	latest, found := history.Latest()
+	if found && ctx.BlockTime().Sub(latest.Timestamp) < params.PriceSampleInterval() {
+		return
+	}
+	history = history.Add(types.NewPriceRecord(price, ctx.BlockTime()), params.PriceHistoryLength)
+	k.SetPriceHistory(ctx, history)
+}
+
+// GetTWAPPrice returns the time-weighted average of the recorded median prices for a market over the TWAP window.
+// The spot price must be valid for the TWAP to be valid. If no history is kept, the spot price is returned.
+// An error is returned if the history doesn't cover the TWAP window, allowing for the gap of one sample interval
+// before the oldest record, as happens when a market is new or its history has just been enabled.
+func (k Keeper) GetTWAPPrice(ctx sdk.Context, marketID string) (types.CurrentPrice, error) {
+	spotPrice, err := k.GetCurrentPrice(ctx, marketID)
+	if err != nil {
+		return types.CurrentPrice{}, err
+	}
+	params := k.GetParams(ctx)
+	if params.PriceHistoryLength == 0 {
+		return spotPrice, nil
+	}
+	start := ctx.BlockTime().Add(-params.TWAPWindow)
+	records := k.GetPriceRecords(ctx, marketID)
+	if len(records) == 0 || records[0].Timestamp.After(start.Add(params.PriceSampleInterval())) {
+		return types.CurrentPrice{}, sdkerrors.Wrapf(types.ErrInsufficientPriceHistory, "market %s", marketID)
+	}
+	twap, found := records.TWAP(start, ctx.BlockTime())
+	if !found {
+		return types.CurrentPrice{}, sdkerrors.Wrapf(types.ErrInsufficientPriceHistory, "market %s", marketID)
+	}
+	return types.NewCurrentPrice(marketID, twap), nil
+}
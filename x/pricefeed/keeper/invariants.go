@@ -0,0 +1,106 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kava-labs/kava/x/pricefeed/types"
+)
+
+// RegisterInvariants registers all pricefeed invariants
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+
+	ir.RegisterRoute(types.ModuleName, "listed-oracles",
+		ListedOraclesInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "active-current-prices",
+		ActiveCurrentPricesInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "known-raw-prices",
+		KnownRawPricesInvariant(k))
+}
+
+// ListedOraclesInvariant verifies that every posted price in the store is from an oracle listed for its market
+func ListedOraclesInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		markets := k.GetMarkets(ctx)
+
+		var invalidPrice types.PostedPrice
+		broken := false
+		k.IterateRawPrices(ctx, func(marketID string, prices types.PostedPrices) bool {
+			market, found := markets.Get(marketID)
+			if !found {
+				// covered by KnownRawPricesInvariant
+				return false
+			}
+			for _, pp := range prices {
+				if !market.HasOracle(pp.OracleAddress) {
+					invalidPrice = pp
+					broken = true
+					return true
+				}
+			}
+			return false
+		})
+
+		invariantMessage := sdk.FormatInvariant(
+			types.ModuleName,
+			"listed oracles",
+			fmt.Sprintf(
+				"\tfound posted price from an oracle not listed for the market\n"+
+					"\tposted price:\n\t%s\n",
+				invalidPrice),
+		)
+		return invariantMessage, broken
+	}
+}
+
+// ActiveCurrentPricesInvariant verifies that current prices are only stored for active markets
+func ActiveCurrentPricesInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		markets := k.GetMarkets(ctx)
+
+		var invalidMarketID string
+		broken := false
+		k.IterateCurrentPrices(ctx, func(marketID string, _ types.CurrentPrice) bool {
+			market, found := markets.Get(marketID)
+			if !found || !market.Active {
+				invalidMarketID = marketID
+				broken = true
+				return true
+			}
+			return false
+		})
+
+		invariantMessage := sdk.FormatInvariant(
+			types.ModuleName,
+			"active current prices",
+			fmt.Sprintf("\tfound current price for missing or inactive market %s\n", invalidMarketID),
+		)
+		return invariantMessage, broken
+	}
+}
+
+// KnownRawPricesInvariant verifies that posted prices are only stored for markets in params
+func KnownRawPricesInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		markets := k.GetMarkets(ctx)
+
+		var invalidMarketID string
+		broken := false
+		k.IterateRawPrices(ctx, func(marketID string, _ types.PostedPrices) bool {
+			if _, found := markets.Get(marketID); !found {
+				invalidMarketID = marketID
+				broken = true
+				return true
+			}
+			return false
+		})
+
+		invariantMessage := sdk.FormatInvariant(
+			types.ModuleName,
+			"known raw prices",
+			fmt.Sprintf("\tfound posted prices for market %s, which is not in params\n", invalidMarketID),
+		)
+		return invariantMessage, broken
+	}
+}
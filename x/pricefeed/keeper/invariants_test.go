@@ -0,0 +1,100 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/kava-labs/kava/app"
+	"github.com/kava-labs/kava/x/pricefeed"
+	"github.com/kava-labs/kava/x/pricefeed/keeper"
+	"github.com/kava-labs/kava/x/pricefeed/types"
+)
+
+// TestKeeper_Invariants Test that the invariants catch stale prices and that pruning restores them
+func TestKeeper_Invariants(t *testing.T) {
+	_, addrs := app.GeneratePrivKeyAddressPairs(3)
+	tApp := app.NewTestApp()
+	ctx := tApp.NewContext(true, abci.Header{Time: time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)})
+	k := tApp.GetPriceFeedKeeper()
+	subspace, found := tApp.GetParamsKeeper().GetSubspace(types.DefaultParamspace)
+	require.True(t, found)
+
+	requireInvariants := func(listedOracles, activeCurrentPrices, knownRawPrices bool) {
+		_, broken := keeper.ListedOraclesInvariant(k)(ctx)
+		require.Equal(t, listedOracles, !broken, "listed oracles")
+		_, broken = keeper.ActiveCurrentPricesInvariant(k)(ctx)
+		require.Equal(t, activeCurrentPrices, !broken, "active current prices")
+		_, broken = keeper.KnownRawPricesInvariant(k)(ctx)
+		require.Equal(t, knownRawPrices, !broken, "known raw prices")
+	}
+
+	mp := types.NewParams(
+		types.Markets{
+			types.Market{MarketID: "tstusd", BaseAsset: "tst", QuoteAsset: "usd", Oracles: addrs[:2], Active: true},
+			types.Market{MarketID: "tst2usd", BaseAsset: "tst2", QuoteAsset: "usd", Oracles: addrs[:1], Active: true},
+		},
+		time.Hour,
+		60,
+		0,
+	)
+	k.SetParams(ctx, mp)
+	for _, marketID := range []string{"tstusd", "tst2usd"} {
+		_, err := k.SetPrice(ctx, addrs[0], marketID, sdk.MustNewDecFromStr("1.0"), ctx.BlockTime().Add(time.Hour))
+		require.NoError(t, err)
+	}
+	_, err := k.SetPrice(ctx, addrs[1], "tstusd", sdk.MustNewDecFromStr("1.2"), ctx.BlockTime().Add(time.Hour))
+	require.NoError(t, err)
+	require.NoError(t, k.SetCurrentPrices(ctx, "tstusd"))
+	require.NoError(t, k.SetCurrentPrices(ctx, "tst2usd"))
+	requireInvariants(true, true, true)
+
+	// a price from an unlisted oracle breaks the listed oracles invariant until pruned
+	_, err = k.SetPrice(ctx, addrs[2], "tstusd", sdk.MustNewDecFromStr("1.1"), ctx.BlockTime().Add(time.Hour))
+	require.NoError(t, err)
+	requireInvariants(false, true, true)
+	k.PruneStalePrices(ctx)
+	requireInvariants(true, true, true)
+	rps, err := k.GetRawPrices(ctx, "tstusd")
+	require.NoError(t, err)
+	require.Len(t, rps, 2)
+
+	// params changed outside the keeper break the invariants until the end blocker runs
+	changedParams := types.NewParams(
+		types.Markets{
+			types.Market{MarketID: "tstusd", BaseAsset: "tst", QuoteAsset: "usd", Oracles: addrs[:1], Active: false},
+		},
+		time.Hour,
+		60,
+		0,
+	)
+	subspace.SetParamSet(ctx, &changedParams)
+	requireInvariants(false, false, false)
+	pricefeed.EndBlocker(ctx, k)
+	requireInvariants(true, true, true)
+
+	rps, err = k.GetRawPrices(ctx, "tstusd")
+	require.NoError(t, err)
+	require.Len(t, rps, 1)
+	require.Equal(t, addrs[0], rps[0].OracleAddress)
+	rps, err = k.GetRawPrices(ctx, "tst2usd")
+	require.NoError(t, err)
+	require.Len(t, rps, 0)
+	_, err = k.GetCurrentPrice(ctx, "tstusd")
+	require.Error(t, err)
+	_, err = k.GetCurrentPrice(ctx, "tst2usd")
+	require.Error(t, err)
+
+	// removing oracles through the keeper prunes their prices immediately
+	mp.Markets[0].Oracles = addrs[1:2]
+	k.SetParams(ctx, mp)
+	requireInvariants(true, true, true)
+	rps, err = k.GetRawPrices(ctx, "tstusd")
+	require.NoError(t, err)
+	require.Len(t, rps, 0)
+}
@@ -8,6 +8,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/x/params/subspace"
+	stakingexported "github.com/cosmos/cosmos-sdk/x/staking/exported"
 
 	"github.com/kava-labs/kava/x/pricefeed/types"
 )
@@ -20,11 +21,13 @@ type Keeper struct {
 	cdc *codec.Codec
 	// The reference to the Paramstore to get and set pricefeed specific params
 	paramSubspace subspace.Subspace
+	// Used to weight oracle prices by stake
+	stakingKeeper types.StakingKeeper
 }
 
 // NewKeeper returns a new keeper for the pricefeed module.
 func NewKeeper(
-	cdc *codec.Codec, key sdk.StoreKey, paramstore subspace.Subspace,
+	cdc *codec.Codec, key sdk.StoreKey, paramstore subspace.Subspace, sk types.StakingKeeper,
 ) Keeper {
 	if !paramstore.HasKeyTable() {
 		paramstore = paramstore.WithKeyTable(types.ParamKeyTable())
@@ -34,6 +37,7 @@ func NewKeeper(
 		cdc:           cdc,
 		key:           key,
 		paramSubspace: paramstore,
+		stakingKeeper: sk,
 	}
 }
 
@@ -84,12 +88,33 @@ func (k Keeper) SetPrice(
 	)
 
 	store.Set(types.RawPriceKey(marketID), k.cdc.MustMarshalBinaryBare(prices))
+	k.recordOraclePost(ctx, marketID, oracle)
 	return prices[index], nil
 }
 
-// SetCurrentPrices updates the price of an asset to the median of all valid oracle inputs
+// SetCurrentPrices updates the price of an asset to the median of all valid oracle inputs.
+// If the median breaches the market's deviation limits the previous price is held and the market is marked unstable.
+// The price of a derived market is instead calculated from the current prices of its input markets.
 func (k Keeper) SetCurrentPrices(ctx sdk.Context, marketID string) error {
-	_, ok := k.GetMarket(ctx, marketID)
+	market, ok := k.GetMarket(ctx, marketID)
+	if ok && market.IsDerived() {
+		return k.setDerivedPrice(ctx, market)
+	}
+	return k.setCurrentPrices(ctx, marketID, true)
+}
+
+// ConfirmPrice accepts the median of all valid oracle inputs as the price of an unstable market,
+// regardless of the market's deviation limits, and marks the market as stable.
+func (k Keeper) ConfirmPrice(ctx sdk.Context, marketID string) error {
+	_, found := k.GetUnstableMarket(ctx, marketID)
+	if !found {
+		return sdkerrors.Wrap(types.ErrMarketNotUnstable, marketID)
+	}
+	return k.setCurrentPrices(ctx, marketID, false)
+}
+
+func (k Keeper) setCurrentPrices(ctx sdk.Context, marketID string, enforceDeviationLimits bool) error {
+	market, ok := k.GetMarket(ctx, marketID)
 	if !ok {
 		return sdkerrors.Wrap(types.ErrInvalidMarket, marketID)
 	}
@@ -100,26 +125,31 @@ func (k Keeper) SetCurrentPrices(ctx sdk.Context, marketID string) error {
 		validPrevPrice = false
 	}
 
-	prices, err := k.GetRawPrices(ctx, marketID)
+	prices, err := k.getValidPrices(ctx, marketID)
 	if err != nil {
 		return err
 	}
-	var notExpiredPrices types.CurrentPrices
-	// filter out expired prices
-	for _, v := range prices {
-		if v.Expiry.After(ctx.BlockTime()) {
-			notExpiredPrices = append(notExpiredPrices, types.NewCurrentPrice(v.MarketID, v.Price))
-		}
-	}
-	if len(notExpiredPrices) == 0 {
-		store := ctx.KVStore(k.key)
-		store.Set(
-			types.CurrentPriceKey(marketID), k.cdc.MustMarshalBinaryBare(types.CurrentPrice{}),
-		)
+	if len(prices) == 0 {
+		k.clearCurrentPrice(ctx, marketID)
 		return types.ErrNoValidPrice
 	}
+	if uint64(len(prices)) < market.MinimumOracles {
+		k.clearCurrentPrice(ctx, marketID)
+		return sdkerrors.Wrapf(types.ErrInsufficientOracles, "%s: %d < %d", marketID, len(prices), market.MinimumOracles)
+	}
+
+	medianPrice, err := k.aggregatePrices(ctx, market, prices)
+	if err != nil {
+		k.clearCurrentPrice(ctx, marketID)
+		return err
+	}
 
-	medianPrice := k.CalculateMedianPrice(ctx, notExpiredPrices)
+	// hold the previous price if the median moved too far
+	if validPrevPrice && enforceDeviationLimits && k.breachesDeviationLimits(ctx, market, prevPrice.Price, medianPrice) {
+		k.setMarketUnstable(ctx, marketID, prevPrice.Price, medianPrice)
+		return nil
+	}
+	k.setMarketStable(ctx, marketID, medianPrice)
 
 	// check case that market price was not set in genesis
 	if validPrevPrice {
@@ -141,10 +171,84 @@ func (k Keeper) SetCurrentPrices(ctx sdk.Context, marketID string) error {
 	store.Set(
 		types.CurrentPriceKey(marketID), k.cdc.MustMarshalBinaryBare(currentPrice),
 	)
+	k.recordPrice(ctx, marketID, medianPrice)
+	k.recordPriceUpdate(ctx, marketID, medianPrice)
 
 	return nil
 }
 
+// getValidPrices returns the unexpired prices posted by active oracles for a market
+func (k Keeper) getValidPrices(ctx sdk.Context, marketID string) (types.PostedPrices, error) {
+	prices, err := k.GetRawPrices(ctx, marketID)
+	if err != nil {
+		return nil, err
+	}
+	metrics := k.GetOracleMetrics(ctx, marketID)
+	var validPrices types.PostedPrices
+	for _, v := range prices {
+		// filter out expired prices
+		if !v.Expiry.After(ctx.BlockTime()) {
+			continue
+		}
+		// filter out prices from deactivated oracles
+		if om, found := metrics.Get(v.OracleAddress); found && !om.Active {
+			continue
+		}
+		validPrices = append(validPrices, v)
+	}
+	return validPrices, nil
+}
+
+// clearCurrentPrice sets the current price of a market to an invalid empty price
+func (k Keeper) clearCurrentPrice(ctx sdk.Context, marketID string) {
+	store := ctx.KVStore(k.key)
+	store.Set(
+		types.CurrentPriceKey(marketID), k.cdc.MustMarshalBinaryBare(types.CurrentPrice{}),
+	)
+	k.recordPriceUpdate(ctx, marketID, sdk.ZeroDec())
+}
+
+// aggregatePrices combines the valid oracle prices of a market into a single price using the market's aggregation mode
+func (k Keeper) aggregatePrices(ctx sdk.Context, market types.Market, prices types.PostedPrices) (sdk.Dec, error) {
+	switch market.AggregationMode {
+	case types.AggregationWeightedMedian:
+		var weightedPrices []types.WeightedPrice
+		for _, p := range prices {
+			weightedPrices = append(weightedPrices, types.WeightedPrice{Price: p.Price, Weight: k.GetOracleStake(ctx, p.OracleAddress)})
+		}
+		price, ok := types.WeightedMedian(weightedPrices)
+		if !ok {
+			return sdk.Dec{}, sdkerrors.Wrap(types.ErrNoOracleStake, market.MarketID)
+		}
+		return price, nil
+	case types.AggregationTrimmedMean:
+		var decs []sdk.Dec
+		for _, p := range prices {
+			decs = append(decs, p.Price)
+		}
+		return types.TrimmedMean(decs, market.TrimFraction), nil
+	default:
+		var currentPrices types.CurrentPrices
+		for _, p := range prices {
+			currentPrices = append(currentPrices, types.NewCurrentPrice(p.MarketID, p.Price))
+		}
+		return k.CalculateMedianPrice(ctx, currentPrices), nil
+	}
+}
+
+// GetOracleStake returns the value in tokens of all of an oracle's delegations
+func (k Keeper) GetOracleStake(ctx sdk.Context, oracle sdk.AccAddress) sdk.Dec {
+	stake := sdk.ZeroDec()
+	k.stakingKeeper.IterateDelegations(ctx, oracle, func(_ int64, delegation stakingexported.DelegationI) (stop bool) {
+		validator := k.stakingKeeper.Validator(ctx, delegation.GetValidatorAddr())
+		if validator != nil {
+			stake = stake.Add(validator.TokensFromShares(delegation.GetShares()))
+		}
+		return false
+	})
+	return stake
+}
+
 // CalculateMedianPrice calculates the median prices for the input prices.
 func (k Keeper) CalculateMedianPrice(ctx sdk.Context, prices types.CurrentPrices) sdk.Dec {
 	l := len(prices)
@@ -206,3 +310,111 @@ func (k Keeper) GetRawPrices(ctx sdk.Context, marketID string) (types.PostedPric
 	}
 	return prices, nil
 }
+
+// IterateRawPrices iterates over the posted prices of every market in the store and performs a callback function
+func (k Keeper) IterateRawPrices(ctx sdk.Context, cb func(marketID string, prices types.PostedPrices) (stop bool)) {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.key), types.RawPriceFeedPrefix)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var prices types.PostedPrices
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &prices)
+		if cb(string(iterator.Key()[len(types.RawPriceFeedPrefix):]), prices) {
+			break
+		}
+	}
+}
+
+// IterateCurrentPrices iterates over the current price of every market in the store and performs a callback function
+func (k Keeper) IterateCurrentPrices(ctx sdk.Context, cb func(marketID string, price types.CurrentPrice) (stop bool)) {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.key), types.CurrentPricePrefix)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var price types.CurrentPrice
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &price)
+		if cb(string(iterator.Key()[len(types.CurrentPricePrefix):]), price) {
+			break
+		}
+	}
+}
+
+// PruneStalePrices removes the posted prices of markets missing from params and of oracles no longer listed for a market,
+// the current prices of markets that are missing from params or inactive, and the price history, unstable status,
+// oracle metrics and price updates of markets missing from params.
+func (k Keeper) PruneStalePrices(ctx sdk.Context) {
+	markets := k.GetMarkets(ctx)
+	store := ctx.KVStore(k.key)
+
+	// collect keys before writing to avoid modifying the store while iterating over it
+	var staleMarkets []string
+	prunedPrices := make(map[string]types.PostedPrices)
+	k.IterateRawPrices(ctx, func(marketID string, prices types.PostedPrices) bool {
+		market, found := markets.Get(marketID)
+		if !found {
+			staleMarkets = append(staleMarkets, marketID)
+			return false
+		}
+		var listed types.PostedPrices
+		for _, pp := range prices {
+			if market.HasOracle(pp.OracleAddress) {
+				listed = append(listed, pp)
+			}
+		}
+		if len(listed) != len(prices) {
+			prunedPrices[marketID] = listed
+		}
+		return false
+	})
+	for _, marketID := range staleMarkets {
+		store.Delete(types.RawPriceKey(marketID))
+	}
+	for _, market := range markets {
+		prices, found := prunedPrices[market.MarketID]
+		if !found {
+			continue
+		}
+		if len(prices) == 0 {
+			store.Delete(types.RawPriceKey(market.MarketID))
+			continue
+		}
+		store.Set(types.RawPriceKey(market.MarketID), k.cdc.MustMarshalBinaryBare(prices))
+	}
+
+	var inactiveMarkets []string
+	k.IterateCurrentPrices(ctx, func(marketID string, _ types.CurrentPrice) bool {
+		market, found := markets.Get(marketID)
+		if !found || !market.Active {
+			inactiveMarkets = append(inactiveMarkets, marketID)
+		}
+		return false
+	})
+	for _, marketID := range inactiveMarkets {
+		store.Delete(types.CurrentPriceKey(marketID))
+	}
+
+	marketIDFromKey := func(prefix []byte) func(key []byte) string {
+		return func(key []byte) string { return string(key[len(prefix):]) }
+	}
+	k.pruneRemovedMarkets(ctx, markets, types.PriceHistoryPrefix, marketIDFromKey(types.PriceHistoryPrefix))
+	k.pruneRemovedMarkets(ctx, markets, types.UnstableMarketPrefix, marketIDFromKey(types.UnstableMarketPrefix))
+	k.pruneRemovedMarkets(ctx, markets, types.OracleMetricsPrefix, marketIDFromKey(types.OracleMetricsPrefix))
+	k.pruneRemovedMarkets(ctx, markets, types.PriceUpdatePrefix, types.PriceUpdateMarketID)
+}
+
+// pruneRemovedMarkets deletes the entries under a store prefix that belong to markets missing from params
+func (k Keeper) pruneRemovedMarkets(ctx sdk.Context, markets types.Markets, prefix []byte, marketID func(key []byte) string) {
+	store := ctx.KVStore(k.key)
+
+	// collect keys before deleting to avoid modifying the store while iterating over it
+	var staleKeys [][]byte
+	iterator := sdk.KVStorePrefixIterator(store, prefix)
+	for ; iterator.Valid(); iterator.Next() {
+		if _, found := markets.Get(marketID(iterator.Key())); !found {
+			staleKeys = append(staleKeys, iterator.Key())
+		}
+	}
+	iterator.Close()
+
+	for _, key := range staleKeys {
+		store.Delete(key)
+	}
+}
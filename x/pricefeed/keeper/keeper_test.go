@@ -1,6 +1,7 @@
 package keeper_test
 
 import (
+	"errors"
 	"testing"
 	"time"
 
@@ -140,3 +141,120 @@ func TestKeeper_GetSetCurrentPrice(t *testing.T) {
 	require.Nil(t, err)
 	require.Equal(t, price.Price.Equal(sdk.MustNewDecFromStr("0.345")), true)
 }
+
+// TestKeeper_GetTWAPPrice Test the time-weighted average of recorded median prices
+func TestKeeper_GetTWAPPrice(t *testing.T) {
+	_, addrs := app.GeneratePrivKeyAddressPairs(1)
+	tApp := app.NewTestApp()
+	startTime := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	ctx := tApp.NewContext(true, abci.Header{Time: startTime})
+	keeper := tApp.GetPriceFeedKeeper()
+
+	mp := types.NewParams(
+		types.Markets{
+			types.Market{MarketID: "tstusd", BaseAsset: "tst", QuoteAsset: "usd", Oracles: []sdk.AccAddress{}, Active: true},
+		},
+		time.Hour,
+		6, // record at most every 10 minutes
+		0,
+	)
+	keeper.SetParams(ctx, mp)
+
+	setPrice := func(ctx sdk.Context, price string) {
+		_, err := keeper.SetPrice(ctx, addrs[0], "tstusd", sdk.MustNewDecFromStr(price), ctx.BlockTime().Add(time.Hour))
+		require.NoError(t, err)
+		require.NoError(t, keeper.SetCurrentPrices(ctx, "tstusd"))
+	}
+
+	// twap is not valid until the history covers the twap window
+	setPrice(ctx, "10")
+	_, err := keeper.GetTWAPPrice(ctx, "tstusd")
+	require.True(t, errors.Is(err, types.ErrInsufficientPriceHistory))
+
+	// price changes within the sample interval are not recorded
+	ctx = ctx.WithBlockTime(startTime.Add(5 * time.Minute))
+	setPrice(ctx, "1000")
+	require.Len(t, keeper.GetPriceRecords(ctx, "tstusd"), 1)
+
+	ctx = ctx.WithBlockTime(startTime.Add(30 * time.Minute))
+	setPrice(ctx, "20")
+	require.Len(t, keeper.GetPriceRecords(ctx, "tstusd"), 2)
+
+	// the history may start up to one sample interval after the start of the twap window
+	ctx = ctx.WithBlockTime(startTime.Add(49 * time.Minute))
+	_, err = keeper.GetTWAPPrice(ctx, "tstusd")
+	require.True(t, errors.Is(err, types.ErrInsufficientPriceHistory))
+	ctx = ctx.WithBlockTime(startTime.Add(50 * time.Minute))
+	twap, err := keeper.GetTWAPPrice(ctx, "tstusd")
+	require.NoError(t, err)
+	require.Equal(t, sdk.MustNewDecFromStr("14"), twap.Price)
+
+	ctx = ctx.WithBlockTime(startTime.Add(1 * time.Hour))
+	twap, err = keeper.GetTWAPPrice(ctx, "tstusd")
+	require.NoError(t, err)
+	require.Equal(t, sdk.MustNewDecFromStr("15"), twap.Price)
+	spot, err := keeper.GetCurrentPrice(ctx, "tstusd")
+	require.NoError(t, err)
+	require.Equal(t, sdk.MustNewDecFromStr("20"), spot.Price)
+
+	// the history is a ring buffer bounded by the price history length
+	for i := 1; i <= 10; i++ {
+		ctx = ctx.WithBlockTime(startTime.Add(1*time.Hour + time.Duration(i)*10*time.Minute))
+		setPrice(ctx, "20")
+	}
+	records := keeper.GetPriceRecords(ctx, "tstusd")
+	require.Len(t, records, 6)
+	require.Equal(t, ctx.BlockTime(), records[5].Timestamp)
+	twap, err = keeper.GetTWAPPrice(ctx, "tstusd")
+	require.NoError(t, err)
+	require.Equal(t, sdk.MustNewDecFromStr("20"), twap.Price)
+
+	// twap is not valid when the spot price is not valid
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(2 * time.Hour))
+	require.Error(t, keeper.SetCurrentPrices(ctx, "tstusd"))
+	_, err = keeper.GetTWAPPrice(ctx, "tstusd")
+	require.Error(t, err)
+}
+
+// TestKeeper_PruneRemovedMarkets Test the state of a market is removed along with the market
+func TestKeeper_PruneRemovedMarkets(t *testing.T) {
+	_, addrs := app.GeneratePrivKeyAddressPairs(1)
+	tApp := app.NewTestApp()
+	ctx := tApp.NewContext(true, abci.Header{Time: time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)})
+	keeper := tApp.GetPriceFeedKeeper()
+
+	mp := types.NewParams(
+		types.Markets{
+			types.Market{MarketID: "tstusd", BaseAsset: "tst", QuoteAsset: "usd", Oracles: addrs, Active: true},
+			types.Market{MarketID: "tst2usd", BaseAsset: "tst2", QuoteAsset: "usd", Oracles: addrs, Active: true},
+		},
+		time.Hour,
+		60,
+		time.Hour,
+	)
+	keeper.SetParams(ctx, mp)
+	for _, market := range mp.Markets {
+		_, err := keeper.SetPrice(ctx, addrs[0], market.MarketID, sdk.OneDec(), ctx.BlockTime().Add(time.Hour))
+		require.NoError(t, err)
+		keeper.UpdateOracleMetrics(ctx, market.MarketID)
+		require.NoError(t, keeper.SetCurrentPrices(ctx, market.MarketID))
+		keeper.SetUnstableMarket(ctx, types.UnstableMarket{
+			MarketID: market.MarketID, HeldPrice: sdk.OneDec(), RejectedPrice: sdk.OneDec(), Since: ctx.BlockTime(),
+		})
+	}
+
+	requireMarketState := func(marketID string, exists bool) {
+		require.Equal(t, exists, len(keeper.GetPriceHistory(ctx, marketID).Records) > 0)
+		_, found := keeper.GetUnstableMarket(ctx, marketID)
+		require.Equal(t, exists, found)
+		require.Equal(t, exists, len(keeper.GetOracleMetrics(ctx, marketID)) > 0)
+		require.Equal(t, exists, len(keeper.GetPriceUpdates(ctx, marketID, ctx.BlockTime(), ctx.BlockTime())) > 0)
+	}
+	requireMarketState("tstusd", true)
+	requireMarketState("tst2usd", true)
+
+	mp.Markets = mp.Markets[:1]
+	keeper.SetParams(ctx, mp)
+	requireMarketState("tstusd", true)
+	requireMarketState("tst2usd", false)
+}
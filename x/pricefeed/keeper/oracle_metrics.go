@@ -0,0 +1,157 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/kava-labs/kava/x/pricefeed/types"
+)
+
+// GetOracleMetrics returns the metrics of all oracles that have been tracked for a market
+func (k Keeper) GetOracleMetrics(ctx sdk.Context, marketID string) types.OracleMetricsList {
+	store := ctx.KVStore(k.key)
+	bz := store.Get(types.OracleMetricsKey(marketID))
+	if bz == nil {
+		return types.OracleMetricsList{}
+	}
+	var metrics types.OracleMetricsList
+	k.cdc.MustUnmarshalBinaryBare(bz, &metrics)
+	return metrics
+}
+
+// SetOracleMetrics stores the metrics of all oracles tracked for a market
+func (k Keeper) SetOracleMetrics(ctx sdk.Context, marketID string, metrics types.OracleMetricsList) {
+	store := ctx.KVStore(k.key)
+	store.Set(types.OracleMetricsKey(marketID), k.cdc.MustMarshalBinaryBare(metrics))
+}
+
+// IsOracleActive returns false if the oracle has been deactivated for the market
+func (k Keeper) IsOracleActive(ctx sdk.Context, marketID string, oracle sdk.AccAddress) bool {
+	metrics, found := k.GetOracleMetrics(ctx, marketID).Get(oracle)
+	if !found {
+		return true
+	}
+	return metrics.Active
+}
+
+// recordOraclePost updates the last post time of an oracle
+func (k Keeper) recordOraclePost(ctx sdk.Context, marketID string, oracle sdk.AccAddress) {
+	metrics := k.GetOracleMetrics(ctx, marketID)
+	for i := range metrics {
+		if metrics[i].OracleAddress.Equals(oracle) {
+			metrics[i].LastPostTime = ctx.BlockTime()
+			k.SetOracleMetrics(ctx, marketID, metrics)
+			return
+		}
+	}
+	om := types.NewOracleMetrics(marketID, oracle)
+	om.LastPostTime = ctx.BlockTime()
+	k.SetOracleMetrics(ctx, marketID, append(metrics, om))
+}
+
+// UpdateOracleMetrics compares the valid price of each of a market's oracles to the median of all valid prices,
+// updating their miss and outlier streaks and deactivating oracles that have exceeded the market's limits.
+// Metrics of oracles that are no longer listed on the market are discarded.
+func (k Keeper) UpdateOracleMetrics(ctx sdk.Context, marketID string) {
+	market, found := k.GetMarket(ctx, marketID)
+	if !found {
+		return
+	}
+	prices, err := k.getValidPrices(ctx, marketID)
+	if err != nil {
+		return
+	}
+	median := sdk.ZeroDec()
+	if len(prices) > 0 {
+		var currentPrices types.CurrentPrices
+		for _, p := range prices {
+			currentPrices = append(currentPrices, types.NewCurrentPrice(p.MarketID, p.Price))
+		}
+		median = k.CalculateMedianPrice(ctx, currentPrices)
+	}
+
+	previous := k.GetOracleMetrics(ctx, marketID)
+	var updated types.OracleMetricsList
+	for _, oracle := range market.Oracles {
+		metrics, found := previous.Get(oracle)
+		if !found {
+			metrics = types.NewOracleMetrics(marketID, oracle)
+		}
+		if !metrics.Active {
+			updated = append(updated, metrics)
+			continue
+		}
+
+		posted, found := prices.Get(oracle)
+		if !found {
+			// oracles are only expected to post once they have started posting
+			if !metrics.LastPostTime.IsZero() {
+				metrics.MissStreak++
+			}
+		} else {
+			metrics.MissStreak = 0
+			metrics.MedianDistance = types.MedianDistance(median, posted.Price)
+			if types.ExceedsDeviation(median, posted.Price, market.OracleOutlierDeviation) {
+				metrics.OutlierStreak++
+			} else {
+				metrics.OutlierStreak = 0
+			}
+		}
+
+		switch {
+		case market.MaxOracleMisses > 0 && metrics.MissStreak >= market.MaxOracleMisses:
+			k.deactivateOracle(ctx, &metrics, types.AttributeValueMissedPrices)
+		case market.MaxOracleOutliers > 0 && metrics.OutlierStreak >= market.MaxOracleOutliers:
+			k.deactivateOracle(ctx, &metrics, types.AttributeValueOutlierPrices)
+		}
+		updated = append(updated, metrics)
+	}
+	k.SetOracleMetrics(ctx, marketID, updated)
+}
+
+// ReactivateOracle marks a deactivated oracle as active again, resetting its metrics.
+// Misses are not counted against the oracle until it posts again.
+func (k Keeper) ReactivateOracle(ctx sdk.Context, marketID string, oracle sdk.AccAddress) error {
+	market, found := k.GetMarket(ctx, marketID)
+	if !found {
+		return sdkerrors.Wrap(types.ErrInvalidMarket, marketID)
+	}
+	if !market.HasOracle(oracle) {
+		return sdkerrors.Wrap(types.ErrInvalidOracle, oracle.String())
+	}
+	metrics := k.GetOracleMetrics(ctx, marketID)
+	for i := range metrics {
+		if !metrics[i].OracleAddress.Equals(oracle) {
+			continue
+		}
+		if metrics[i].Active {
+			return sdkerrors.Wrap(types.ErrOracleActive, oracle.String())
+		}
+		metrics[i] = types.NewOracleMetrics(marketID, oracle)
+		k.SetOracleMetrics(ctx, marketID, metrics)
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeOracleReactivated,
+				sdk.NewAttribute(types.AttributeMarketID, marketID),
+				sdk.NewAttribute(types.AttributeOracle, oracle.String()),
+			),
+		)
+		return nil
+	}
+	return sdkerrors.Wrap(types.ErrOracleActive, oracle.String())
+}
+
+// deactivateOracle marks an oracle as inactive and emits an event
+func (k Keeper) deactivateOracle(ctx sdk.Context, metrics *types.OracleMetrics, reason string) {
+	metrics.Active = false
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeOracleDeactivated,
+			sdk.NewAttribute(types.AttributeMarketID, metrics.MarketID),
+			sdk.NewAttribute(types.AttributeOracle, metrics.OracleAddress.String()),
+			sdk.NewAttribute(types.AttributeReason, reason),
+		),
+	)
+}
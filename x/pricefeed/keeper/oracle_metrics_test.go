@@ -0,0 +1,159 @@
+package keeper_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/kava-labs/kava/app"
+	"github.com/kava-labs/kava/x/pricefeed/types"
+)
+
+// TestKeeper_OracleMetrics Test tracking oracle performance and deactivating oracles that exceed the market's limits
+func TestKeeper_OracleMetrics(t *testing.T) {
+	_, addrs := app.GeneratePrivKeyAddressPairs(4)
+	tApp := app.NewTestApp()
+	startTime := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	ctx := tApp.NewContext(true, abci.Header{Time: startTime})
+	keeper := tApp.GetPriceFeedKeeper()
+
+	mp := types.NewParams(
+		types.Markets{
+			types.Market{
+				MarketID: "tstusd", BaseAsset: "tst", QuoteAsset: "usd", Oracles: addrs, Active: true,
+				MaxOracleMisses:        3,
+				MaxOracleOutliers:      2,
+				OracleOutlierDeviation: sdk.MustNewDecFromStr("0.1"),
+			},
+		},
+		time.Hour,
+		60,
+		0,
+	)
+	keeper.SetParams(ctx, mp)
+
+	// oracles 0 and 1 post in line with each other, oracle 2 posts an outlier, oracle 3 only posts once
+	postPrices := func(ctx sdk.Context) {
+		for i, price := range []string{"100", "102", "150"} {
+			_, err := keeper.SetPrice(ctx, addrs[i], "tstusd", sdk.MustNewDecFromStr(price), ctx.BlockTime().Add(time.Second))
+			require.NoError(t, err)
+		}
+		keeper.UpdateOracleMetrics(ctx, "tstusd")
+		require.NoError(t, keeper.SetCurrentPrices(ctx, "tstusd"))
+	}
+	requireMetrics := func(ctx sdk.Context, oracle sdk.AccAddress, active bool, missStreak, outlierStreak uint64) types.OracleMetrics {
+		metrics, found := keeper.GetOracleMetrics(ctx, "tstusd").Get(oracle)
+		require.True(t, found)
+		require.Equal(t, active, metrics.Active)
+		require.Equal(t, missStreak, metrics.MissStreak)
+		require.Equal(t, outlierStreak, metrics.OutlierStreak)
+		return metrics
+	}
+
+	postPrices(ctx)
+	metrics := requireMetrics(ctx, addrs[0], true, 0, 0)
+	require.Equal(t, startTime, metrics.LastPostTime)
+	require.Equal(t, sdk.MustNewDecFromStr("0.019607843137254902"), metrics.MedianDistance)
+	requireMetrics(ctx, addrs[2], true, 0, 1)
+	// misses are not counted before an oracle's first post
+	metrics = requireMetrics(ctx, addrs[3], true, 0, 0)
+	require.True(t, metrics.LastPostTime.IsZero())
+	price, err := keeper.GetCurrentPrice(ctx, "tstusd")
+	require.NoError(t, err)
+	require.Equal(t, sdk.MustNewDecFromStr("102"), price.Price)
+
+	// the outlier is deactivated after two consecutive outliers and excluded from the median
+	ctx = ctx.WithBlockTime(startTime.Add(time.Minute)).WithEventManager(sdk.NewEventManager())
+	_, err = keeper.SetPrice(ctx, addrs[3], "tstusd", sdk.MustNewDecFromStr("101"), ctx.BlockTime().Add(time.Second))
+	require.NoError(t, err)
+	postPrices(ctx)
+	requireMetrics(ctx, addrs[2], false, 0, 2)
+	requireMetrics(ctx, addrs[3], true, 0, 0)
+	require.False(t, keeper.IsOracleActive(ctx, "tstusd", addrs[2]))
+	require.True(t, keeper.IsOracleActive(ctx, "tstusd", addrs[0]))
+	price, err = keeper.GetCurrentPrice(ctx, "tstusd")
+	require.NoError(t, err)
+	require.Equal(t, sdk.MustNewDecFromStr("101"), price.Price)
+	events := ctx.EventManager().Events()
+	var deactivated bool
+	for _, e := range events {
+		if e.Type == types.EventTypeOracleDeactivated {
+			deactivated = true
+			require.Contains(t, e.Attributes, sdk.NewAttribute(types.AttributeReason, types.AttributeValueOutlierPrices).ToKVPair())
+		}
+	}
+	require.True(t, deactivated)
+
+	// the missing oracle is deactivated after three consecutive misses
+	for i := 2; i <= 4; i++ {
+		ctx = ctx.WithBlockTime(startTime.Add(time.Duration(i) * time.Minute))
+		postPrices(ctx)
+	}
+	requireMetrics(ctx, addrs[3], false, 3, 0)
+
+	// a deactivated oracle can be reactivated, and misses are not counted again until it posts
+	require.True(t, errors.Is(keeper.ReactivateOracle(ctx, "nonexistent", addrs[3]), types.ErrInvalidMarket))
+	require.True(t, errors.Is(keeper.ReactivateOracle(ctx, "tstusd", addrs[0]), types.ErrOracleActive))
+	_, nonOracles := app.GeneratePrivKeyAddressPairs(5)
+	require.True(t, errors.Is(keeper.ReactivateOracle(ctx, "tstusd", nonOracles[4]), types.ErrInvalidOracle))
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	require.NoError(t, keeper.ReactivateOracle(ctx, "tstusd", addrs[3]))
+	require.True(t, keeper.IsOracleActive(ctx, "tstusd", addrs[3]))
+	metrics = requireMetrics(ctx, addrs[3], true, 0, 0)
+	require.True(t, metrics.LastPostTime.IsZero())
+	var reactivated bool
+	for _, e := range ctx.EventManager().Events() {
+		if e.Type == types.EventTypeOracleReactivated {
+			reactivated = true
+		}
+	}
+	require.True(t, reactivated)
+	ctx = ctx.WithBlockTime(startTime.Add(5 * time.Minute))
+	postPrices(ctx)
+	requireMetrics(ctx, addrs[3], true, 0, 0)
+
+	// removing an oracle from the market discards its metrics
+	mp.Markets[0].Oracles = addrs[:3]
+	keeper.SetParams(ctx, mp)
+	keeper.UpdateOracleMetrics(ctx, "tstusd")
+	_, found := keeper.GetOracleMetrics(ctx, "tstusd").Get(addrs[3])
+	require.False(t, found)
+}
+
+// TestKeeper_OracleMetricsDisabled Test oracles are never deactivated when the market has no limits
+func TestKeeper_OracleMetricsDisabled(t *testing.T) {
+	_, addrs := app.GeneratePrivKeyAddressPairs(2)
+	tApp := app.NewTestApp()
+	ctx := tApp.NewContext(true, abci.Header{Time: time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)})
+	keeper := tApp.GetPriceFeedKeeper()
+
+	mp := types.NewParams(
+		types.Markets{
+			types.Market{MarketID: "tstusd", BaseAsset: "tst", QuoteAsset: "usd", Oracles: addrs, Active: true},
+		},
+		time.Hour,
+		60,
+		0,
+	)
+	keeper.SetParams(ctx, mp)
+
+	// oracle 1 posts once then stops posting
+	_, err := keeper.SetPrice(ctx, addrs[1], "tstusd", sdk.OneDec(), ctx.BlockTime().Add(time.Second))
+	require.NoError(t, err)
+	for i := 0; i < 10; i++ {
+		_, err := keeper.SetPrice(ctx, addrs[0], "tstusd", sdk.OneDec(), ctx.BlockTime().Add(time.Second))
+		require.NoError(t, err)
+		keeper.UpdateOracleMetrics(ctx, "tstusd")
+		ctx = ctx.WithBlockTime(ctx.BlockTime().Add(time.Minute))
+	}
+	metrics, found := keeper.GetOracleMetrics(ctx, "tstusd").Get(addrs[1])
+	require.True(t, found)
+	require.True(t, metrics.Active)
+	require.Equal(t, uint64(9), metrics.MissStreak)
+}
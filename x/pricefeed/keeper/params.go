@@ -14,9 +14,15 @@ func (k Keeper) GetParams(ctx sdk.Context) types.Params {
 	return p
 }
 
-// SetParams sets params on the store
+// SetParams sets params on the store and prunes prices made stale by the new markets
 func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
 	k.paramSubspace.SetParamSet(ctx, &params)
+	k.PruneStalePrices(ctx)
+}
+
+// MarketsModified returns true if the markets param has been set in the current block
+func (k Keeper) MarketsModified(ctx sdk.Context) bool {
+	return k.paramSubspace.Modified(ctx, types.KeyMarkets)
 }
 
 // GetMarkets returns the markets from params
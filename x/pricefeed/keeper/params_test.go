@@ -37,7 +37,7 @@ func (suite *KeeperTestSuite) SetupTest() {
 
 func (suite *KeeperTestSuite) TestGetSetOracles() {
 	params := suite.keeper.GetParams(suite.ctx)
-	suite.Equal([]sdk.AccAddress(nil), params.Markets[0].Oracles)
+	suite.Equal([]sdk.AccAddress{pricefeedOracle}, params.Markets[0].Oracles)
 	params.Markets[0].Oracles = suite.addrs
 	suite.NotPanics(func() { suite.keeper.SetParams(suite.ctx, params) })
 	params = suite.keeper.GetParams(suite.ctx)
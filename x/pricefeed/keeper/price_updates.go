@@ -0,0 +1,84 @@
+package keeper
+
+import (
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kava-labs/kava/x/pricefeed/types"
+)
+
+// GetPriceUpdates returns the recorded updates to the current price of a market between start and end, inclusive.
+// The update in effect at the start time is included, even if it was recorded before the start time.
+func (k Keeper) GetPriceUpdates(ctx sdk.Context, marketID string, start, end time.Time) types.PriceUpdates {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.PriceUpdatesKeyPrefix(marketID))
+	updates := types.PriceUpdates{}
+
+	// find the update in effect at the start time, unless one was recorded exactly at the start time
+	if !store.Has(sdk.FormatTimeBytes(start)) {
+		previous := store.ReverseIterator(nil, sdk.FormatTimeBytes(start))
+		if previous.Valid() {
+			var update types.PriceUpdate
+			k.cdc.MustUnmarshalBinaryBare(previous.Value(), &update)
+			updates = append(updates, update)
+		}
+		previous.Close()
+	}
+
+	iterator := store.Iterator(sdk.FormatTimeBytes(start), sdk.PrefixEndBytes(sdk.FormatTimeBytes(end)))
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var update types.PriceUpdate
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &update)
+		updates = append(updates, update)
+	}
+	return updates
+}
+
+// getLatestPriceUpdate returns the most recently recorded update to the current price of a market
+func (k Keeper) getLatestPriceUpdate(ctx sdk.Context, marketID string) (types.PriceUpdate, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.PriceUpdatesKeyPrefix(marketID))
+	iterator := store.ReverseIterator(nil, nil)
+	defer iterator.Close()
+	if !iterator.Valid() {
+		return types.PriceUpdate{}, false
+	}
+	var update types.PriceUpdate
+	k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &update)
+	return update, true
+}
+
+// recordPriceUpdate records a change to the current price of a market and prunes updates older than the retention period.
+// The latest update is never pruned, so that the price in effect is always known.
+func (k Keeper) recordPriceUpdate(ctx sdk.Context, marketID string, price sdk.Dec) {
+	retention := k.GetParams(ctx).PriceUpdateRetention
+	if retention == 0 {
+		return
+	}
+
+	latest, found := k.getLatestPriceUpdate(ctx, marketID)
+	if !found || !latest.Price.Equal(price) {
+		store := ctx.KVStore(k.key)
+		update := types.NewPriceUpdate(marketID, price, ctx.BlockTime(), ctx.BlockHeight())
+		store.Set(types.PriceUpdateKey(marketID, ctx.BlockTime()), k.cdc.MustMarshalBinaryBare(update))
+	}
+
+	store := prefix.NewStore(ctx.KVStore(k.key), types.PriceUpdatesKeyPrefix(marketID))
+	cutoff := sdk.FormatTimeBytes(ctx.BlockTime().Add(-retention))
+	iterator := store.Iterator(nil, cutoff)
+	var expiredKeys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		expiredKeys = append(expiredKeys, iterator.Key())
+	}
+	iterator.Close()
+	// keep the latest update if every update has expired
+	remaining := store.Iterator(cutoff, nil)
+	if !remaining.Valid() && len(expiredKeys) > 0 {
+		expiredKeys = expiredKeys[:len(expiredKeys)-1]
+	}
+	remaining.Close()
+	for _, key := range expiredKeys {
+		store.Delete(key)
+	}
+}
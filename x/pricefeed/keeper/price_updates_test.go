@@ -0,0 +1,75 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/kava-labs/kava/app"
+	"github.com/kava-labs/kava/x/pricefeed/types"
+)
+
+// TestKeeper_PriceUpdates Test recording, querying and pruning updates to the current price
+func TestKeeper_PriceUpdates(t *testing.T) {
+	_, addrs := app.GeneratePrivKeyAddressPairs(1)
+	tApp := app.NewTestApp()
+	startTime := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	ctx := tApp.NewContext(true, abci.Header{Height: 1, Time: startTime})
+	keeper := tApp.GetPriceFeedKeeper()
+
+	mp := types.NewParams(
+		types.Markets{
+			types.Market{MarketID: "tstusd", BaseAsset: "tst", QuoteAsset: "usd", Oracles: addrs, Active: true},
+		},
+		time.Hour,
+		60,
+		3*time.Hour,
+	)
+	keeper.SetParams(ctx, mp)
+
+	// post a price each hour, the price only changes in some of the hours
+	prices := []string{"1", "1", "2", "2", "3"}
+	for i, price := range prices {
+		ctx = ctx.WithBlockHeight(int64(i + 1)).WithBlockTime(startTime.Add(time.Duration(i) * time.Hour))
+		_, err := keeper.SetPrice(ctx, addrs[0], "tstusd", sdk.MustNewDecFromStr(price), ctx.BlockTime().Add(10*time.Minute))
+		require.NoError(t, err)
+		require.NoError(t, keeper.SetCurrentPrices(ctx, "tstusd"))
+	}
+
+	// the update in effect at the start time is included
+	updates := keeper.GetPriceUpdates(ctx, "tstusd", startTime.Add(3*time.Hour), startTime.Add(4*time.Hour))
+	require.Equal(t, types.PriceUpdates{
+		types.NewPriceUpdate("tstusd", sdk.NewDec(2), startTime.Add(2*time.Hour), 3),
+		types.NewPriceUpdate("tstusd", sdk.NewDec(3), startTime.Add(4*time.Hour), 5),
+	}, updates)
+
+	// the update before the start time is not included when an update was recorded at the start time
+	updates = keeper.GetPriceUpdates(ctx, "tstusd", startTime.Add(4*time.Hour), startTime.Add(4*time.Hour))
+	require.Equal(t, types.PriceUpdates{
+		types.NewPriceUpdate("tstusd", sdk.NewDec(3), startTime.Add(4*time.Hour), 5),
+	}, updates)
+
+	// updates older than the retention period are pruned
+	updates = keeper.GetPriceUpdates(ctx, "tstusd", startTime, startTime.Add(4*time.Hour))
+	require.Equal(t, types.PriceUpdates{
+		types.NewPriceUpdate("tstusd", sdk.NewDec(2), startTime.Add(2*time.Hour), 3),
+		types.NewPriceUpdate("tstusd", sdk.NewDec(3), startTime.Add(4*time.Hour), 5),
+	}, updates)
+
+	// once the prices expire an update with a zero price is recorded
+	ctx = ctx.WithBlockHeight(6).WithBlockTime(startTime.Add(5 * time.Hour))
+	require.Error(t, keeper.SetCurrentPrices(ctx, "tstusd"))
+
+	// the latest update is kept after the retention period
+	ctx = ctx.WithBlockHeight(7).WithBlockTime(startTime.Add(10 * time.Hour))
+	require.Error(t, keeper.SetCurrentPrices(ctx, "tstusd"))
+	updates = keeper.GetPriceUpdates(ctx, "tstusd", startTime.Add(10*time.Hour), startTime.Add(10*time.Hour))
+	require.Equal(t, types.PriceUpdates{
+		types.NewPriceUpdate("tstusd", sdk.ZeroDec(), startTime.Add(5*time.Hour), 6),
+	}, updates)
+}
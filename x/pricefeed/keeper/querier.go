@@ -16,6 +16,14 @@ func NewQuerier(keeper Keeper) sdk.Querier {
 		switch path[0] {
 		case types.QueryPrice:
 			return queryPrice(ctx, req, keeper)
+		case types.QueryTWAPPrice:
+			return queryTWAPPrice(ctx, req, keeper)
+		case types.QueryPriceHistory:
+			return queryPriceHistory(ctx, req, keeper)
+		case types.QueryPriceUpdates:
+			return queryPriceUpdates(ctx, req, keeper)
+		case types.QueryOracleMetrics:
+			return queryOracleMetrics(ctx, req, keeper)
 		case types.QueryRawPrices:
 			return queryRawPrices(ctx, req, keeper)
 		case types.QueryOracles:
@@ -53,6 +61,91 @@ func queryPrice(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) (res []by
 	return bz, nil
 }
 
+func queryTWAPPrice(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) (res []byte, sdkErr error) {
+	var requestParams types.QueryWithMarketIDParams
+	err := types.ModuleCdc.UnmarshalJSON(req.Data, &requestParams)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+	_, found := keeper.GetMarket(ctx, requestParams.MarketID)
+	if !found {
+		return []byte{}, sdkerrors.Wrap(types.ErrAssetNotFound, requestParams.MarketID)
+	}
+	twapPrice, sdkErr := keeper.GetTWAPPrice(ctx, requestParams.MarketID)
+	if sdkErr != nil {
+		return nil, sdkErr
+	}
+	bz, err := codec.MarshalJSONIndent(types.ModuleCdc, twapPrice)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return bz, nil
+}
+
+func queryPriceHistory(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) (res []byte, sdkErr error) {
+	var requestParams types.QueryWithMarketIDParams
+	err := types.ModuleCdc.UnmarshalJSON(req.Data, &requestParams)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+	_, found := keeper.GetMarket(ctx, requestParams.MarketID)
+	if !found {
+		return []byte{}, sdkerrors.Wrap(types.ErrAssetNotFound, requestParams.MarketID)
+	}
+
+	records := keeper.GetPriceRecords(ctx, requestParams.MarketID)
+
+	bz, err := codec.MarshalJSONIndent(types.ModuleCdc, records)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return bz, nil
+}
+
+func queryPriceUpdates(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) (res []byte, sdkErr error) {
+	var requestParams types.QueryPriceUpdatesParams
+	err := types.ModuleCdc.UnmarshalJSON(req.Data, &requestParams)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+	_, found := keeper.GetMarket(ctx, requestParams.MarketID)
+	if !found {
+		return []byte{}, sdkerrors.Wrap(types.ErrAssetNotFound, requestParams.MarketID)
+	}
+
+	updates := keeper.GetPriceUpdates(ctx, requestParams.MarketID, requestParams.Start, requestParams.End)
+
+	bz, err := codec.MarshalJSONIndent(types.ModuleCdc, updates)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return bz, nil
+}
+
+func queryOracleMetrics(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) (res []byte, sdkErr error) {
+	var requestParams types.QueryWithMarketIDParams
+	err := types.ModuleCdc.UnmarshalJSON(req.Data, &requestParams)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+	_, found := keeper.GetMarket(ctx, requestParams.MarketID)
+	if !found {
+		return []byte{}, sdkerrors.Wrap(types.ErrAssetNotFound, requestParams.MarketID)
+	}
+
+	metrics := keeper.GetOracleMetrics(ctx, requestParams.MarketID)
+
+	bz, err := codec.MarshalJSONIndent(types.ModuleCdc, metrics)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return bz, nil
+}
+
 func queryRawPrices(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) (res []byte, sdkErr error) {
 	var requestParams types.QueryWithMarketIDParams
 	err := types.ModuleCdc.UnmarshalJSON(req.Data, &requestParams)
@@ -0,0 +1,36 @@
+package pricefeed
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+// NewProposalHandler handles pricefeed gov proposals
+func NewProposalHandler(k Keeper) govtypes.Handler {
+	return func(ctx sdk.Context, content govtypes.Content) error {
+		switch c := content.(type) {
+		case ConfirmPriceProposal:
+			return handleConfirmPriceProposal(ctx, k, c)
+		case ReactivateOracleProposal:
+			return handleReactivateOracleProposal(ctx, k, c)
+
+		default:
+			return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized %s proposal content type: %T", ModuleName, c)
+		}
+	}
+}
+
+func handleConfirmPriceProposal(ctx sdk.Context, k Keeper, proposal ConfirmPriceProposal) error {
+	if err := proposal.ValidateBasic(); err != nil {
+		return err
+	}
+	return k.ConfirmPrice(ctx, proposal.MarketID)
+}
+
+func handleReactivateOracleProposal(ctx sdk.Context, k Keeper, proposal ReactivateOracleProposal) error {
+	if err := proposal.ValidateBasic(); err != nil {
+		return err
+	}
+	return k.ReactivateOracle(ctx, proposal.MarketID, proposal.Oracle)
+}
@@ -26,6 +26,30 @@ func DecodeStore(cdc *codec.Codec, kvA, kvB kv.Pair) string {
 		cdc.MustUnmarshalBinaryBare(kvB.Value, &postedPriceB)
 		return fmt.Sprintf("%s\n%s", postedPriceA, postedPriceB)
 
+	case bytes.Contains(kvA.Key, []byte(types.PriceHistoryPrefix)):
+		var historyA, historyB types.PriceHistory
+		cdc.MustUnmarshalBinaryBare(kvA.Value, &historyA)
+		cdc.MustUnmarshalBinaryBare(kvB.Value, &historyB)
+		return fmt.Sprintf("%s\n%s", historyA, historyB)
+
+	case bytes.Contains(kvA.Key, []byte(types.UnstableMarketPrefix)):
+		var unstableMarketA, unstableMarketB types.UnstableMarket
+		cdc.MustUnmarshalBinaryBare(kvA.Value, &unstableMarketA)
+		cdc.MustUnmarshalBinaryBare(kvB.Value, &unstableMarketB)
+		return fmt.Sprintf("%s\n%s", unstableMarketA, unstableMarketB)
+
+	case bytes.Contains(kvA.Key, []byte(types.OracleMetricsPrefix)):
+		var metricsA, metricsB types.OracleMetricsList
+		cdc.MustUnmarshalBinaryBare(kvA.Value, &metricsA)
+		cdc.MustUnmarshalBinaryBare(kvB.Value, &metricsB)
+		return fmt.Sprintf("%s\n%s", metricsA, metricsB)
+
+	case bytes.Contains(kvA.Key, []byte(types.PriceUpdatePrefix)):
+		var updateA, updateB types.PriceUpdate
+		cdc.MustUnmarshalBinaryBare(kvA.Value, &updateA)
+		cdc.MustUnmarshalBinaryBare(kvB.Value, &updateB)
+		return fmt.Sprintf("%s\n%s", updateA, updateB)
+
 	default:
 		panic(fmt.Sprintf("invalid %s key prefix %X", types.ModuleName, kvA.Key[:1]))
 	}
@@ -28,10 +28,18 @@ func TestDecodeDistributionStore(t *testing.T) {
 
 	currentPrice := types.CurrentPrice{MarketID: "current", Price: sdk.OneDec()}
 	postedPrice := []types.PostedPrice{{MarketID: "posted", Price: sdk.OneDec(), Expiry: time.Now().UTC()}}
+	priceHistory := types.NewPriceHistory("history").Add(types.NewPriceRecord(sdk.OneDec(), time.Now().UTC()), 10)
+	unstableMarket := types.NewUnstableMarket("unstable", sdk.OneDec(), sdk.NewDec(2), time.Now().UTC())
+	oracleMetrics := types.OracleMetricsList{types.NewOracleMetrics("metrics", nil)}
+	priceUpdate := types.NewPriceUpdate("update", sdk.OneDec(), time.Now().UTC(), 10)
 
 	kvPairs := kv.Pairs{
 		kv.Pair{Key: []byte(types.CurrentPricePrefix), Value: cdc.MustMarshalBinaryBare(currentPrice)},
 		kv.Pair{Key: []byte(types.RawPriceFeedPrefix), Value: cdc.MustMarshalBinaryBare(postedPrice)},
+		kv.Pair{Key: []byte(types.PriceHistoryPrefix), Value: cdc.MustMarshalBinaryBare(priceHistory)},
+		kv.Pair{Key: []byte(types.UnstableMarketPrefix), Value: cdc.MustMarshalBinaryBare(unstableMarket)},
+		kv.Pair{Key: []byte(types.OracleMetricsPrefix), Value: cdc.MustMarshalBinaryBare(oracleMetrics)},
+		kv.Pair{Key: []byte(types.PriceUpdatePrefix), Value: cdc.MustMarshalBinaryBare(priceUpdate)},
 		kv.Pair{Key: []byte{0x99}, Value: []byte{0x99}},
 	}
 
@@ -41,6 +49,10 @@ func TestDecodeDistributionStore(t *testing.T) {
 	}{
 		{"CurrentPrice", fmt.Sprintf("%v\n%v", currentPrice, currentPrice)},
 		{"PostedPrice", fmt.Sprintf("%s\n%s", postedPrice, postedPrice)},
+		{"PriceHistory", fmt.Sprintf("%s\n%s", priceHistory, priceHistory)},
+		{"UnstableMarket", fmt.Sprintf("%s\n%s", unstableMarket, unstableMarket)},
+		{"OracleMetrics", fmt.Sprintf("%s\n%s", oracleMetrics, oracleMetrics)},
+		{"PriceUpdate", fmt.Sprintf("%s\n%s", priceUpdate, priceUpdate)},
 		{"other", ""},
 	}
 	for i, tt := range tests {
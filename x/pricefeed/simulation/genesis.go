@@ -54,7 +54,10 @@ func loadPricefeedGenState(simState *module.SimulationState) pricefeed.GenesisSt
 		markets = append(markets, market)
 		postedPrices = append(postedPrices, postedPrice)
 	}
-	params := pricefeed.NewParams(markets)
+	twapWindow := time.Duration(simulation.RandIntBetween(simState.Rand, 1, 24*60)) * time.Minute
+	priceHistoryLength := uint64(simulation.RandIntBetween(simState.Rand, 0, 500))
+	priceUpdateRetention := time.Duration(simulation.RandIntBetween(simState.Rand, 0, 7*24)) * time.Hour
+	params := pricefeed.NewParams(markets, twapWindow, priceHistoryLength, priceUpdateRetention)
 	return pricefeed.NewGenesisState(params, postedPrices)
 }
 
@@ -0,0 +1,90 @@
+package types
+
+import (
+	"sort"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Aggregation modes for combining the prices posted by a market's oracles into a current price
+const (
+	AggregationMedian         = "median"          // median of all valid prices
+	AggregationWeightedMedian = "weighted_median" // median of all valid prices, weighted by the stake of each oracle
+	AggregationTrimmedMean    = "trimmed_mean"    // mean of all valid prices after removing a fraction of the highest and lowest prices
+)
+
+// ValidateAggregationMode returns an error if the aggregation mode is unknown. An empty mode defaults to the median.
+func ValidateAggregationMode(mode string) error {
+	switch mode {
+	case "", AggregationMedian, AggregationWeightedMedian, AggregationTrimmedMean:
+		return nil
+	default:
+		return ErrInvalidAggregationMode
+	}
+}
+
+// WeightedPrice is a price weighted by the stake of the oracle that posted it
+type WeightedPrice struct {
+	Price  sdk.Dec
+	Weight sdk.Dec
+}
+
+// WeightedMedian calculates the price at which half of the total weight is on either side.
+// If the cumulative weight is exactly half the total at a price, the mean of that price and the next is returned.
+// Returns false if the total weight is zero.
+func WeightedMedian(prices []WeightedPrice) (sdk.Dec, bool) {
+	sorted := make([]WeightedPrice, len(prices))
+	copy(sorted, prices)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].Price.LT(sorted[j].Price)
+	})
+	total := sdk.ZeroDec()
+	for _, p := range sorted {
+		total = total.Add(p.Weight)
+	}
+	if !total.IsPositive() {
+		return sdk.Dec{}, false
+	}
+	half := total.QuoInt64(2)
+	cumulative := sdk.ZeroDec()
+	for i, p := range sorted {
+		if !p.Weight.IsPositive() {
+			continue
+		}
+		cumulative = cumulative.Add(p.Weight)
+		if cumulative.Equal(half) {
+			for _, next := range sorted[i+1:] {
+				if next.Weight.IsPositive() {
+					return p.Price.Add(next.Price).QuoInt64(2), true
+				}
+			}
+			return p.Price, true
+		}
+		if cumulative.GT(half) {
+			return p.Price, true
+		}
+	}
+	return sorted[len(sorted)-1].Price, true
+}
+
+// TrimmedMean calculates the mean of the prices after removing the given fraction of prices from each end.
+// The number of prices removed from each end is rounded down.
+func TrimmedMean(prices []sdk.Dec, trimFraction sdk.Dec) sdk.Dec {
+	sorted := make([]sdk.Dec, len(prices))
+	copy(sorted, prices)
+	sort.Sort(SortDecs(sorted))
+
+	trim := 0
+	if !trimFraction.IsNil() {
+		trim = int(trimFraction.MulInt64(int64(len(sorted))).TruncateInt64())
+	}
+	if 2*trim >= len(sorted) {
+		trim = (len(sorted) - 1) / 2
+	}
+	kept := sorted[trim : len(sorted)-trim]
+	sum := sdk.ZeroDec()
+	for _, p := range kept {
+		sum = sum.Add(p)
+	}
+	return sum.QuoInt64(int64(len(kept)))
+}
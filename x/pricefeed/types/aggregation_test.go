@@ -0,0 +1,49 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestWeightedMedian(t *testing.T) {
+	d := sdk.MustNewDecFromStr
+	wp := func(price, weight string) WeightedPrice { return WeightedPrice{Price: d(price), Weight: d(weight)} }
+
+	testCases := []struct {
+		msg      string
+		prices   []WeightedPrice
+		expPrice sdk.Dec
+		expOk    bool
+	}{
+		{"single price", []WeightedPrice{wp("10", "1")}, d("10"), true},
+		{"equal weights odd", []WeightedPrice{wp("30", "1"), wp("10", "1"), wp("20", "1")}, d("20"), true},
+		{"equal weights even", []WeightedPrice{wp("30", "1"), wp("10", "1"), wp("20", "1"), wp("40", "1")}, d("25"), true},
+		{"heavy outlier", []WeightedPrice{wp("10", "1"), wp("11", "1"), wp("100", "5")}, d("100"), true},
+		{"heavy low price", []WeightedPrice{wp("10", "5"), wp("11", "1"), wp("100", "1")}, d("10"), true},
+		{"zero weights ignored", []WeightedPrice{wp("10", "1"), wp("20", "0"), wp("30", "1")}, d("20"), true},
+		{"no weight", []WeightedPrice{wp("10", "0"), wp("20", "0")}, sdk.Dec{}, false},
+	}
+
+	for _, tc := range testCases {
+		price, ok := WeightedMedian(tc.prices)
+		require.Equal(t, tc.expOk, ok, tc.msg)
+		if tc.expOk {
+			require.Equal(t, tc.expPrice, price, tc.msg)
+		}
+	}
+}
+
+func TestTrimmedMean(t *testing.T) {
+	d := sdk.MustNewDecFromStr
+	prices := []sdk.Dec{d("100"), d("1"), d("10"), d("11"), d("12")}
+
+	require.Equal(t, d("26.8"), TrimmedMean(prices, sdk.ZeroDec()))
+	require.Equal(t, d("26.8"), TrimmedMean(prices, sdk.Dec{}))
+	require.Equal(t, d("26.8"), TrimmedMean(prices, d("0.1")))
+	require.Equal(t, d("11"), TrimmedMean(prices, d("0.2")))
+	require.Equal(t, d("11"), TrimmedMean(prices, d("0.49")))
+	require.Equal(t, d("10.5"), TrimmedMean([]sdk.Dec{d("10"), d("11")}, d("0.49")))
+}
@@ -13,4 +13,7 @@ func init() {
 // RegisterCodec registers concrete types on the Amino code
 func RegisterCodec(cdc *codec.Codec) {
 	cdc.RegisterConcrete(MsgPostPrice{}, "pricefeed/MsgPostPrice", nil)
+	cdc.RegisterConcrete(MsgPostPrices{}, "pricefeed/MsgPostPrices", nil)
+	cdc.RegisterConcrete(ConfirmPriceProposal{}, "kava/ConfirmPriceProposal", nil)
+	cdc.RegisterConcrete(ReactivateOracleProposal{}, "kava/ReactivateOracleProposal", nil)
 }
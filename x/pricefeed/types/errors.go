@@ -19,4 +19,18 @@ var (
 	ErrInvalidOracle = sdkerrors.Register(ModuleName, 6, "oracle does not exist or not authorized")
 	// ErrAssetNotFound error for not found asset
 	ErrAssetNotFound = sdkerrors.Register(ModuleName, 7, "asset not found")
+	// ErrMarketNotUnstable error for confirming the price of a market that has not breached its deviation limits
+	ErrMarketNotUnstable = sdkerrors.Register(ModuleName, 8, "market is not unstable")
+	// ErrOracleInactive error for posted price messages from oracles that have been deactivated
+	ErrOracleInactive = sdkerrors.Register(ModuleName, 9, "oracle has been deactivated")
+	// ErrInvalidAggregationMode error for markets with an unknown price aggregation mode
+	ErrInvalidAggregationMode = sdkerrors.Register(ModuleName, 10, "invalid aggregation mode")
+	// ErrInsufficientOracles error for markets with fewer valid prices than the minimum number of oracles
+	ErrInsufficientOracles = sdkerrors.Register(ModuleName, 11, "not enough oracles have posted valid prices")
+	// ErrNoOracleStake error for stake weighted markets where none of the oracles with valid prices have any stake
+	ErrNoOracleStake = sdkerrors.Register(ModuleName, 12, "oracles with valid prices have no stake")
+	// ErrOracleActive error for reactivating an oracle that has not been deactivated
+	ErrOracleActive = sdkerrors.Register(ModuleName, 13, "oracle is active")
+	// ErrInsufficientPriceHistory error for time-weighted average prices of markets whose price history doesn't cover the TWAP window
+	ErrInsufficientPriceHistory = sdkerrors.Register(ModuleName, 14, "price history does not cover the twap window")
 )
@@ -5,10 +5,19 @@ const (
 	EventTypeMarketPriceUpdated = "market_price_updated"
 	EventTypeOracleUpdatedPrice = "oracle_updated_price"
 	EventTypeNoValidPrices      = "no_valid_prices"
+	EventTypeMarketUnstable     = "market_unstable"
+	EventTypeMarketStable       = "market_stable"
+	EventTypeOracleDeactivated  = "oracle_deactivated"
+	EventTypeOracleReactivated  = "oracle_reactivated"
 
 	AttributeValueCategory = ModuleName
 	AttributeMarketID      = "market_id"
 	AttributeMarketPrice   = "market_price"
 	AttributeOracle        = "oracle"
 	AttributeExpiry        = "expiry"
+	AttributeRejectedPrice = "rejected_price"
+	AttributeReason        = "reason"
+
+	AttributeValueMissedPrices  = "missed_prices"
+	AttributeValueOutlierPrices = "outlier_prices"
 )
@@ -0,0 +1,13 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingexported "github.com/cosmos/cosmos-sdk/x/staking/exported"
+)
+
+// StakingKeeper defines the expected staking keeper (noalias)
+type StakingKeeper interface {
+	IterateDelegations(ctx sdk.Context, delegator sdk.AccAddress,
+		fn func(index int64, delegation stakingexported.DelegationI) (stop bool))
+	Validator(ctx sdk.Context, address sdk.ValAddress) stakingexported.ValidatorI
+}
@@ -2,6 +2,7 @@ package types
 
 import (
 	"bytes"
+	"fmt"
 )
 
 // GenesisState - pricefeed state that must be provided at genesis
@@ -44,5 +45,17 @@ func (gs GenesisState) Validate() error {
 	if err := gs.Params.Validate(); err != nil {
 		return err
 	}
-	return gs.PostedPrices.Validate()
+	if err := gs.PostedPrices.Validate(); err != nil {
+		return err
+	}
+	for _, pp := range gs.PostedPrices {
+		market, found := gs.Params.Markets.Get(pp.MarketID)
+		if !found {
+			return fmt.Errorf("posted price for market %s not in params", pp.MarketID)
+		}
+		if !market.HasOracle(pp.OracleAddress) {
+			return fmt.Errorf("posted price for market %s from %s, which is not an oracle for the market", pp.MarketID, pp.OracleAddress)
+		}
+	}
+	return nil
 }
@@ -29,9 +29,9 @@ func TestGenesisStateValidate(t *testing.T) {
 			msg: "valid genesis",
 			genesisState: NewGenesisState(
 				NewParams(Markets{
-					{"market", "xrp", "bnb", []sdk.AccAddress{addr}, true},
-				}),
-				[]PostedPrice{NewPostedPrice("xrp", addr, sdk.OneDec(), now)},
+					{MarketID: "market", BaseAsset: "xrp", QuoteAsset: "bnb", Oracles: []sdk.AccAddress{addr}, Active: true},
+				}, DefaultTWAPWindow, DefaultPriceHistoryLength, DefaultPriceUpdateRetention),
+				[]PostedPrice{NewPostedPrice("market", addr, sdk.OneDec(), now)},
 			),
 			expPass: true,
 		},
@@ -39,8 +39,8 @@ func TestGenesisStateValidate(t *testing.T) {
 			msg: "invalid param",
 			genesisState: NewGenesisState(
 				NewParams(Markets{
-					{"", "xrp", "bnb", []sdk.AccAddress{addr}, true},
-				}),
+					{MarketID: "", BaseAsset: "xrp", QuoteAsset: "bnb", Oracles: []sdk.AccAddress{addr}, Active: true},
+				}, DefaultTWAPWindow, DefaultPriceHistoryLength, DefaultPriceUpdateRetention),
 				[]PostedPrice{NewPostedPrice("xrp", addr, sdk.OneDec(), now)},
 			),
 			expPass: false,
@@ -49,17 +49,67 @@ func TestGenesisStateValidate(t *testing.T) {
 			msg: "dup market param",
 			genesisState: NewGenesisState(
 				NewParams(Markets{
-					{"market", "xrp", "bnb", []sdk.AccAddress{addr}, true},
-					{"market", "xrp", "bnb", []sdk.AccAddress{addr}, true},
-				}),
+					{MarketID: "market", BaseAsset: "xrp", QuoteAsset: "bnb", Oracles: []sdk.AccAddress{addr}, Active: true},
+					{MarketID: "market", BaseAsset: "xrp", QuoteAsset: "bnb", Oracles: []sdk.AccAddress{addr}, Active: true},
+				}, DefaultTWAPWindow, DefaultPriceHistoryLength, DefaultPriceUpdateRetention),
+				[]PostedPrice{NewPostedPrice("xrp", addr, sdk.OneDec(), now)},
+			),
+			expPass: false,
+		},
+		{
+			msg: "negative twap window",
+			genesisState: NewGenesisState(
+				NewParams(Markets{
+					{MarketID: "market", BaseAsset: "xrp", QuoteAsset: "bnb", Oracles: []sdk.AccAddress{addr}, Active: true},
+				}, -1*time.Hour, DefaultPriceHistoryLength, DefaultPriceUpdateRetention),
+				[]PostedPrice{NewPostedPrice("xrp", addr, sdk.OneDec(), now)},
+			),
+			expPass: false,
+		},
+		{
+			msg: "price history length above max",
+			genesisState: NewGenesisState(
+				NewParams(Markets{
+					{MarketID: "market", BaseAsset: "xrp", QuoteAsset: "bnb", Oracles: []sdk.AccAddress{addr}, Active: true},
+				}, DefaultTWAPWindow, MaxPriceHistoryLength+1, DefaultPriceUpdateRetention),
+				[]PostedPrice{NewPostedPrice("market", addr, sdk.OneDec(), now)},
+			),
+			expPass: false,
+		},
+		{
+			msg: "negative price update retention",
+			genesisState: NewGenesisState(
+				NewParams(Markets{
+					{MarketID: "market", BaseAsset: "xrp", QuoteAsset: "bnb", Oracles: []sdk.AccAddress{addr}, Active: true},
+				}, DefaultTWAPWindow, DefaultPriceHistoryLength, -1*time.Hour),
 				[]PostedPrice{NewPostedPrice("xrp", addr, sdk.OneDec(), now)},
 			),
 			expPass: false,
 		},
+		{
+			msg: "posted price for missing market",
+			genesisState: NewGenesisState(
+				NewParams(Markets{
+					{MarketID: "market", BaseAsset: "xrp", QuoteAsset: "bnb", Oracles: []sdk.AccAddress{addr}, Active: true},
+				}, DefaultTWAPWindow, DefaultPriceHistoryLength, DefaultPriceUpdateRetention),
+				[]PostedPrice{NewPostedPrice("xrp", addr, sdk.OneDec(), now)},
+			),
+			expPass: false,
+		},
+		{
+			msg: "posted price from unlisted oracle",
+			genesisState: NewGenesisState(
+				NewParams(Markets{
+					{MarketID: "market", BaseAsset: "xrp", QuoteAsset: "bnb", Oracles: []sdk.AccAddress{}, Active: true},
+				}, DefaultTWAPWindow, DefaultPriceHistoryLength, DefaultPriceUpdateRetention),
+				[]PostedPrice{NewPostedPrice("market", addr, sdk.OneDec(), now)},
+			),
+			expPass: false,
+		},
 		{
 			msg: "invalid posted price",
 			genesisState: NewGenesisState(
-				NewParams(Markets{}),
+				NewParams(Markets{}, DefaultTWAPWindow, DefaultPriceHistoryLength, DefaultPriceUpdateRetention),
 				[]PostedPrice{NewPostedPrice("xrp", nil, sdk.OneDec(), now)},
 			),
 			expPass: false,
@@ -67,7 +117,7 @@ func TestGenesisStateValidate(t *testing.T) {
 		{
 			msg: "duplicated posted price",
 			genesisState: NewGenesisState(
-				NewParams(Markets{}),
+				NewParams(Markets{}, DefaultTWAPWindow, DefaultPriceHistoryLength, DefaultPriceUpdateRetention),
 				[]PostedPrice{
 					NewPostedPrice("xrp", addr, sdk.OneDec(), now),
 					NewPostedPrice("xrp", addr, sdk.OneDec(), now),
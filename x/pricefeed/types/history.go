@@ -0,0 +1,192 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// PriceRecord is a median price for a market recorded at a particular block time
+type PriceRecord struct {
+	Price     sdk.Dec   `json:"price" yaml:"price"`
+	Timestamp time.Time `json:"timestamp" yaml:"timestamp"`
+}
+
+// NewPriceRecord returns a new PriceRecord
+func NewPriceRecord(price sdk.Dec, timestamp time.Time) PriceRecord {
+	return PriceRecord{
+		Price:     price,
+		Timestamp: timestamp,
+	}
+}
+
+// String implements fmt.Stringer
+func (pr PriceRecord) String() string {
+	return strings.TrimSpace(fmt.Sprintf(`Price: %s
+Timestamp: %s`, pr.Price, pr.Timestamp))
+}
+
+// PriceRecords array of PriceRecord, ordered from oldest to newest
+type PriceRecords []PriceRecord
+
+// String implements fmt.Stringer
+func (prs PriceRecords) String() string {
+	out := "Price Records:\n"
+	for _, pr := range prs {
+		out += fmt.Sprintf("%s\n", pr.String())
+	}
+	return strings.TrimSpace(out)
+}
+
+// TWAP returns the time-weighted average of the prices between start and end.
+// Each price is weighted by the length of time it was in effect, which is until the next record, or end for the newest record.
+// The price in effect at start is the newest record before start, if there is one.
+// Returns false if there are no records at or before end.
+func (prs PriceRecords) TWAP(start, end time.Time) (sdk.Dec, bool) {
+	weightedSum := sdk.ZeroDec()
+	totalWeight := sdk.ZeroDec()
+	var lastPrice sdk.Dec
+	found := false
+	for i, pr := range prs {
+		if pr.Timestamp.After(end) {
+			break
+		}
+		lastPrice = pr.Price
+		found = true
+
+		periodStart := pr.Timestamp
+		if periodStart.Before(start) {
+			periodStart = start
+		}
+		periodEnd := end
+		if i+1 < len(prs) && prs[i+1].Timestamp.Before(end) {
+			periodEnd = prs[i+1].Timestamp
+		}
+		if !periodEnd.After(periodStart) {
+			continue
+		}
+		weight := sdk.NewDec(int64(periodEnd.Sub(periodStart)))
+		weightedSum = weightedSum.Add(pr.Price.Mul(weight))
+		totalWeight = totalWeight.Add(weight)
+	}
+	if !found {
+		return sdk.Dec{}, false
+	}
+	if totalWeight.IsZero() {
+		// all records were recorded at end, so there is nothing to average over
+		return lastPrice, true
+	}
+	return weightedSum.Quo(totalWeight), true
+}
+
+// PriceHistory is a fixed capacity ring buffer of the median prices recorded for a market
+type PriceHistory struct {
+	MarketID string       `json:"market_id" yaml:"market_id"`
+	Next     uint64       `json:"next" yaml:"next"` // index of the oldest record, which is overwritten next once the buffer is full
+	Records  PriceRecords `json:"records" yaml:"records"`
+}
+
+// NewPriceHistory returns an empty PriceHistory for a market
+func NewPriceHistory(marketID string) PriceHistory {
+	return PriceHistory{
+		MarketID: marketID,
+		Next:     0,
+		Records:  PriceRecords{},
+	}
+}
+
+// Add returns the price history with the record added, dropping the oldest records if the history holds more than capacity records.
+func (ph PriceHistory) Add(record PriceRecord, capacity uint64) PriceHistory {
+	if capacity == 0 {
+		return NewPriceHistory(ph.MarketID)
+	}
+	if uint64(len(ph.Records)) == capacity {
+		// the buffer is full, overwrite the oldest record
+		records := make(PriceRecords, len(ph.Records))
+		copy(records, ph.Records)
+		records[ph.Next] = record
+		return PriceHistory{
+			MarketID: ph.MarketID,
+			Next:     (ph.Next + 1) % capacity,
+			Records:  records,
+		}
+	}
+	// the buffer is not full, or the capacity has changed since the last record was added
+	records := ph.Ordered()
+	if uint64(len(records)) >= capacity {
+		records = records[uint64(len(records))-capacity+1:]
+	}
+	records = append(records, record)
+	return PriceHistory{
+		MarketID: ph.MarketID,
+		Next:     0,
+		Records:  records,
+	}
+}
+
+// Ordered returns the records in the price history ordered from oldest to newest
+func (ph PriceHistory) Ordered() PriceRecords {
+	records := make(PriceRecords, 0, len(ph.Records))
+	if ph.Next >= uint64(len(ph.Records)) {
+		return append(records, ph.Records...)
+	}
+	records = append(records, ph.Records[ph.Next:]...)
+	return append(records, ph.Records[:ph.Next]...)
+}
+
+// Latest returns the newest record in the price history, or false if the history is empty
+func (ph PriceHistory) Latest() (PriceRecord, bool) {
+	if len(ph.Records) == 0 {
+		return PriceRecord{}, false
+	}
+	if ph.Next == 0 || ph.Next > uint64(len(ph.Records)) {
+		return ph.Records[len(ph.Records)-1], true
+	}
+	return ph.Records[ph.Next-1], true
+}
+
+// String implements fmt.Stringer
+func (ph PriceHistory) String() string {
+	return strings.TrimSpace(fmt.Sprintf(`Market ID: %s
+%s`, ph.MarketID, ph.Ordered()))
+}
+
+// PriceUpdate is an update to the current price of a market. A zero price means the market had no valid price.
+type PriceUpdate struct {
+	MarketID  string    `json:"market_id" yaml:"market_id"`
+	Price     sdk.Dec   `json:"price" yaml:"price"`
+	Timestamp time.Time `json:"timestamp" yaml:"timestamp"`
+	Height    int64     `json:"height" yaml:"height"`
+}
+
+// NewPriceUpdate returns a new PriceUpdate
+func NewPriceUpdate(marketID string, price sdk.Dec, timestamp time.Time, height int64) PriceUpdate {
+	return PriceUpdate{
+		MarketID:  marketID,
+		Price:     price,
+		Timestamp: timestamp,
+		Height:    height,
+	}
+}
+
+// String implements fmt.Stringer
+func (pu PriceUpdate) String() string {
+	return strings.TrimSpace(fmt.Sprintf(`Market ID: %s
+Price: %s
+Timestamp: %s
+Height: %d`, pu.MarketID, pu.Price, pu.Timestamp, pu.Height))
+}
+
+// PriceUpdates array of PriceUpdate
+type PriceUpdates []PriceUpdate
+
+// String implements fmt.Stringer
+func (pus PriceUpdates) String() string {
+	out := "Price Updates:\n"
+	for _, pu := range pus {
+		out += fmt.Sprintf("%s\n", pu.String())
+	}
+	return strings.TrimSpace(out)
+}
@@ -0,0 +1,136 @@
+package types
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestPriceHistoryAdd(t *testing.T) {
+	start := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	record := func(i int) PriceRecord {
+		return NewPriceRecord(sdk.NewDec(int64(i)), start.Add(time.Duration(i)*time.Minute))
+	}
+
+	history := NewPriceHistory("bnb:usd")
+	for i := 0; i < 3; i++ {
+		history = history.Add(record(i), 3)
+	}
+	require.Equal(t, PriceRecords{record(0), record(1), record(2)}, history.Ordered())
+
+	// once full, the oldest record is overwritten
+	history = history.Add(record(3), 3)
+	history = history.Add(record(4), 3)
+	require.Equal(t, PriceRecords{record(2), record(3), record(4)}, history.Ordered())
+	require.Len(t, history.Records, 3)
+	latest, found := history.Latest()
+	require.True(t, found)
+	require.Equal(t, record(4), latest)
+
+	// shrinking the capacity drops the oldest records
+	history = history.Add(record(5), 2)
+	require.Equal(t, PriceRecords{record(4), record(5)}, history.Ordered())
+
+	// growing the capacity keeps all records
+	history = history.Add(record(6), 4)
+	history = history.Add(record(7), 4)
+	require.Equal(t, PriceRecords{record(4), record(5), record(6), record(7)}, history.Ordered())
+
+	// zero capacity clears the history
+	history = history.Add(record(8), 0)
+	_, found = history.Latest()
+	require.False(t, found)
+}
+
+func TestPriceRecordsTWAP(t *testing.T) {
+	start := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	d := sdk.MustNewDecFromStr
+
+	testCases := []struct {
+		name       string
+		records    PriceRecords
+		start      time.Time
+		end        time.Time
+		expFound   bool
+		expectTWAP sdk.Dec
+	}{
+		{
+			"empty",
+			PriceRecords{},
+			start,
+			start.Add(time.Hour),
+			false,
+			sdk.Dec{},
+		},
+		{
+			"single record",
+			PriceRecords{NewPriceRecord(d("10"), start)},
+			start,
+			start.Add(time.Hour),
+			true,
+			d("10"),
+		},
+		{
+			"single record at end",
+			PriceRecords{NewPriceRecord(d("10"), start.Add(time.Hour))},
+			start,
+			start.Add(time.Hour),
+			true,
+			d("10"),
+		},
+		{
+			"weighted by time",
+			PriceRecords{
+				NewPriceRecord(d("10"), start),
+				NewPriceRecord(d("20"), start.Add(45*time.Minute)),
+			},
+			start,
+			start.Add(time.Hour),
+			true,
+			d("12.5"),
+		},
+		{
+			"record before window start",
+			PriceRecords{
+				NewPriceRecord(d("100"), start.Add(-2*time.Hour)),
+				NewPriceRecord(d("10"), start.Add(-30*time.Minute)),
+				NewPriceRecord(d("20"), start.Add(30*time.Minute)),
+			},
+			start,
+			start.Add(time.Hour),
+			true,
+			d("15"),
+		},
+		{
+			"records after end ignored",
+			PriceRecords{
+				NewPriceRecord(d("10"), start),
+				NewPriceRecord(d("20"), start.Add(2*time.Hour)),
+			},
+			start,
+			start.Add(time.Hour),
+			true,
+			d("10"),
+		},
+		{
+			"only records after end",
+			PriceRecords{NewPriceRecord(d("10"), start.Add(2*time.Hour))},
+			start,
+			start.Add(time.Hour),
+			false,
+			sdk.Dec{},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			twap, found := tc.records.TWAP(tc.start, tc.end)
+			require.Equal(t, tc.expFound, found)
+			if tc.expFound {
+				require.Equal(t, tc.expectTWAP, twap)
+			}
+		})
+	}
+}
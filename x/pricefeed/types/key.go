@@ -1,5 +1,11 @@
 package types
 
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
 const (
 	// ModuleName The name that will be used throughout the module
 	ModuleName = "pricefeed"
@@ -23,6 +29,18 @@ var (
 
 	// RawPriceFeedPrefix prefix for the raw pricefeed of an asset
 	RawPriceFeedPrefix = []byte{0x01}
+
+	// PriceHistoryPrefix prefix for the price history of an asset
+	PriceHistoryPrefix = []byte{0x02}
+
+	// UnstableMarketPrefix prefix for markets that have breached their deviation limits
+	UnstableMarketPrefix = []byte{0x03}
+
+	// OracleMetricsPrefix prefix for the oracle metrics of an asset
+	OracleMetricsPrefix = []byte{0x04}
+
+	// PriceUpdatePrefix prefix for the recorded updates to the current price of an asset
+	PriceUpdatePrefix = []byte{0x05}
 )
 
 // CurrentPriceKey returns the prefix for the current price
@@ -34,3 +52,35 @@ func CurrentPriceKey(marketID string) []byte {
 func RawPriceKey(marketID string) []byte {
 	return append(RawPriceFeedPrefix, []byte(marketID)...)
 }
+
+// PriceHistoryKey returns the prefix for the price history
+func PriceHistoryKey(marketID string) []byte {
+	return append(PriceHistoryPrefix, []byte(marketID)...)
+}
+
+// UnstableMarketKey returns the prefix for an unstable market
+func UnstableMarketKey(marketID string) []byte {
+	return append(UnstableMarketPrefix, []byte(marketID)...)
+}
+
+// OracleMetricsKey returns the prefix for the oracle metrics
+func OracleMetricsKey(marketID string) []byte {
+	return append(OracleMetricsPrefix, []byte(marketID)...)
+}
+
+// PriceUpdatesKeyPrefix returns the prefix for all the recorded price updates of a market
+func PriceUpdatesKeyPrefix(marketID string) []byte {
+	return append(append(PriceUpdatePrefix, byte(len(marketID))), []byte(marketID)...)
+}
+
+// PriceUpdateMarketID returns the market ID of a price update key
+func PriceUpdateMarketID(key []byte) string {
+	length := int(key[len(PriceUpdatePrefix)])
+	start := len(PriceUpdatePrefix) + 1
+	return string(key[start : start+length])
+}
+
+// PriceUpdateKey returns the key for a price update of a market at a particular block time
+func PriceUpdateKey(marketID string, timestamp time.Time) []byte {
+	return append(PriceUpdatesKeyPrefix(marketID), sdk.FormatTimeBytes(timestamp)...)
+}
@@ -17,6 +17,35 @@ type Market struct {
 	QuoteAsset string           `json:"quote_asset" yaml:"quote_asset"`
 	Oracles    []sdk.AccAddress `json:"oracles" yaml:"oracles"`
 	Active     bool             `json:"active" yaml:"active"`
+	// Circuit breaker limits on changes to the median price, a zero value disables the limit
+	MaxBlockDeviation  sdk.Dec       `json:"max_block_deviation" yaml:"max_block_deviation"`   // maximum fractional change of the median price from one block to the next
+	MaxWindowDeviation sdk.Dec       `json:"max_window_deviation" yaml:"max_window_deviation"` // maximum fractional change of the median price from any price recorded within the deviation window
+	DeviationWindow    time.Duration `json:"deviation_window" yaml:"deviation_window"`         // length of time over which MaxWindowDeviation applies
+	// Oracle deactivation limits, a zero value disables the limit
+	MaxOracleMisses        uint64  `json:"max_oracle_misses" yaml:"max_oracle_misses"`               // number of consecutive blocks an oracle can go without a valid price before it is deactivated
+	MaxOracleOutliers      uint64  `json:"max_oracle_outliers" yaml:"max_oracle_outliers"`           // number of consecutive blocks an oracle can post an outlier before it is deactivated
+	OracleOutlierDeviation sdk.Dec `json:"oracle_outlier_deviation" yaml:"oracle_outlier_deviation"` // fractional distance from the median above which an oracle's price is an outlier
+	// Derivation of the price from other markets, empty for markets priced by oracles
+	Derivation MarketDerivation `json:"derivation" yaml:"derivation"`
+	// Aggregation of oracle prices into the current price
+	AggregationMode string  `json:"aggregation_mode" yaml:"aggregation_mode"` // one of "median", "weighted_median" or "trimmed_mean", defaults to "median" when empty
+	TrimFraction    sdk.Dec `json:"trim_fraction" yaml:"trim_fraction"`       // fraction of prices removed from each end before taking a trimmed mean
+	MinimumOracles  uint64  `json:"minimum_oracles" yaml:"minimum_oracles"`   // minimum number of valid oracle prices required to set a current price
+}
+
+// IsDerived returns true if the price of the market is derived from other markets rather than posted by oracles
+func (m Market) IsDerived() bool {
+	return m.Derivation.Operation != ""
+}
+
+// HasOracle returns true if the address is listed as an oracle for the market
+func (m Market) HasOracle(addr sdk.AccAddress) bool {
+	for _, oracle := range m.Oracles {
+		if oracle.Equals(addr) {
+			return true
+		}
+	}
+	return false
 }
 
 // String implement fmt.Stringer
@@ -26,8 +55,20 @@ func (m Market) String() string {
 	Base Asset: %s
 	Quote Asset: %s
 	Oracles: %s
-	Active: %t`,
-		m.MarketID, m.BaseAsset, m.QuoteAsset, m.Oracles, m.Active)
+	Active: %t
+	Max Block Deviation: %s
+	Max Window Deviation: %s
+	Deviation Window: %s
+	Max Oracle Misses: %d
+	Max Oracle Outliers: %d
+	Oracle Outlier Deviation: %s
+	Derivation: %s
+	Aggregation Mode: %s
+	Trim Fraction: %s
+	Minimum Oracles: %d`,
+		m.MarketID, m.BaseAsset, m.QuoteAsset, m.Oracles, m.Active, m.MaxBlockDeviation, m.MaxWindowDeviation, m.DeviationWindow,
+		m.MaxOracleMisses, m.MaxOracleOutliers, m.OracleOutlierDeviation, m.Derivation,
+		m.AggregationMode, m.TrimFraction, m.MinimumOracles)
 }
 
 // Validate performs a basic validation of the market params
@@ -51,14 +92,98 @@ func (m Market) Validate() error {
 		}
 		seenOracles[oracle.String()] = true
 	}
+	if !m.MaxBlockDeviation.IsNil() && m.MaxBlockDeviation.IsNegative() {
+		return fmt.Errorf("max block deviation cannot be negative %s", m.MaxBlockDeviation)
+	}
+	if !m.MaxWindowDeviation.IsNil() && m.MaxWindowDeviation.IsNegative() {
+		return fmt.Errorf("max window deviation cannot be negative %s", m.MaxWindowDeviation)
+	}
+	if m.DeviationWindow < 0 {
+		return fmt.Errorf("deviation window cannot be negative %s", m.DeviationWindow)
+	}
+	if !m.OracleOutlierDeviation.IsNil() && m.OracleOutlierDeviation.IsNegative() {
+		return fmt.Errorf("oracle outlier deviation cannot be negative %s", m.OracleOutlierDeviation)
+	}
+	if err := ValidateAggregationMode(m.AggregationMode); err != nil {
+		return fmt.Errorf("%w %s", err, m.AggregationMode)
+	}
+	if !m.TrimFraction.IsNil() && (m.TrimFraction.IsNegative() || m.TrimFraction.GTE(sdk.NewDecWithPrec(5, 1))) {
+		return fmt.Errorf("trim fraction must be at least 0 and less than 0.5 %s", m.TrimFraction)
+	}
+	if m.IsDerived() {
+		if err := m.Derivation.Validate(); err != nil {
+			return fmt.Errorf("invalid derivation for market %s: %w", m.MarketID, err)
+		}
+		if len(m.Oracles) > 0 {
+			return fmt.Errorf("derived market %s cannot have oracles", m.MarketID)
+		}
+	}
+	return nil
+}
+
+// Derivation operations
+const (
+	DerivationProduct = "product" // price is the product of the input prices
+	DerivationRatio   = "ratio"   // price is the first input price divided by the second
+)
+
+// MarketDerivation defines the price of a market as the product or ratio of the current prices of two other markets
+type MarketDerivation struct {
+	Operation      string `json:"operation" yaml:"operation"`
+	FirstMarketID  string `json:"first_market_id" yaml:"first_market_id"`
+	SecondMarketID string `json:"second_market_id" yaml:"second_market_id"`
+}
+
+// NewMarketDerivation returns a new MarketDerivation
+func NewMarketDerivation(operation, firstMarketID, secondMarketID string) MarketDerivation {
+	return MarketDerivation{
+		Operation:      operation,
+		FirstMarketID:  firstMarketID,
+		SecondMarketID: secondMarketID,
+	}
+}
+
+// String implements fmt.Stringer
+func (md MarketDerivation) String() string {
+	if md.Operation == "" {
+		return "none"
+	}
+	return fmt.Sprintf("%s(%s, %s)", md.Operation, md.FirstMarketID, md.SecondMarketID)
+}
+
+// Validate performs a basic validation of a market derivation
+func (md MarketDerivation) Validate() error {
+	if md.Operation != DerivationProduct && md.Operation != DerivationRatio {
+		return fmt.Errorf("invalid derivation operation %s", md.Operation)
+	}
+	if strings.TrimSpace(md.FirstMarketID) == "" || strings.TrimSpace(md.SecondMarketID) == "" {
+		return errors.New("derivation input market id cannot be blank")
+	}
 	return nil
 }
 
+// Derive calculates the derived price from the prices of the two input markets
+func (md MarketDerivation) Derive(firstPrice, secondPrice sdk.Dec) sdk.Dec {
+	if md.Operation == DerivationRatio {
+		return firstPrice.Quo(secondPrice)
+	}
+	return firstPrice.Mul(secondPrice)
+}
+
+// ExceedsDeviation returns true if price differs from the reference price by more than the max deviation, as a fraction of the reference price.
+// A nil or zero max deviation is treated as no limit.
+func ExceedsDeviation(reference, price, maxDeviation sdk.Dec) bool {
+	if maxDeviation.IsNil() || maxDeviation.IsZero() || reference.IsNil() || reference.IsZero() {
+		return false
+	}
+	return price.Sub(reference).Abs().Quo(reference).GT(maxDeviation)
+}
+
 // Markets array type for oracle
 type Markets []Market
 
 // Validate checks if all the markets are valid and there are no duplicated
-// entries.
+// entries. Derived markets must be derived from markets priced by oracles.
 func (ms Markets) Validate() error {
 	seenMarkets := make(map[string]bool)
 	for _, m := range ms {
@@ -70,9 +195,33 @@ func (ms Markets) Validate() error {
 		}
 		seenMarkets[m.MarketID] = true
 	}
+	for _, m := range ms {
+		if !m.IsDerived() {
+			continue
+		}
+		for _, inputID := range []string{m.Derivation.FirstMarketID, m.Derivation.SecondMarketID} {
+			input, found := ms.Get(inputID)
+			if !found {
+				return fmt.Errorf("derived market %s has unknown input market %s", m.MarketID, inputID)
+			}
+			if input.IsDerived() {
+				return fmt.Errorf("derived market %s cannot have derived input market %s", m.MarketID, inputID)
+			}
+		}
+	}
 	return nil
 }
 
+// Get returns the market with the given id, or false if it is not found
+func (ms Markets) Get(marketID string) (Market, bool) {
+	for _, m := range ms {
+		if m.MarketID == marketID {
+			return m, true
+		}
+	}
+	return Market{}, false
+}
+
 // String implements fmt.Stringer
 func (ms Markets) String() string {
 	out := "Markets:\n"
@@ -96,6 +245,33 @@ func NewCurrentPrice(marketID string, price sdk.Dec) CurrentPrice {
 // CurrentPrices type for an array of CurrentPrice
 type CurrentPrices []CurrentPrice
 
+// UnstableMarket records a market whose median price breached its deviation limits.
+// The current price of the market is held until the median converges or the move is confirmed.
+type UnstableMarket struct {
+	MarketID      string    `json:"market_id" yaml:"market_id"`
+	HeldPrice     sdk.Dec   `json:"held_price" yaml:"held_price"`         // price held as the current price while the market is unstable
+	RejectedPrice sdk.Dec   `json:"rejected_price" yaml:"rejected_price"` // latest median price that breached the deviation limits
+	Since         time.Time `json:"since" yaml:"since"`                   // time the market became unstable
+}
+
+// NewUnstableMarket returns a new UnstableMarket
+func NewUnstableMarket(marketID string, heldPrice, rejectedPrice sdk.Dec, since time.Time) UnstableMarket {
+	return UnstableMarket{
+		MarketID:      marketID,
+		HeldPrice:     heldPrice,
+		RejectedPrice: rejectedPrice,
+		Since:         since,
+	}
+}
+
+// String implements fmt.Stringer
+func (um UnstableMarket) String() string {
+	return strings.TrimSpace(fmt.Sprintf(`Market ID: %s
+Held Price: %s
+Rejected Price: %s
+Since: %s`, um.MarketID, um.HeldPrice, um.RejectedPrice, um.Since))
+}
+
 // PostedPrice price for market posted by a specific oracle
 type PostedPrice struct {
 	MarketID      string         `json:"market_id" yaml:"market_id"`
@@ -134,6 +310,16 @@ func (pp PostedPrice) Validate() error {
 // PostedPrices type for an array of PostedPrice
 type PostedPrices []PostedPrice
 
+// Get returns the price posted by an oracle, or false if there is none
+func (pps PostedPrices) Get(oracle sdk.AccAddress) (PostedPrice, bool) {
+	for _, pp := range pps {
+		if pp.OracleAddress.Equals(oracle) {
+			return pp, true
+		}
+	}
+	return PostedPrice{}, false
+}
+
 // Validate checks if all the posted prices are valid and there are no duplicated
 // entries.
 func (pps PostedPrices) Validate() error {
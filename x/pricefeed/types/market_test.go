@@ -74,6 +74,75 @@ func TestMarketValidate(t *testing.T) {
 			},
 			false,
 		},
+		{
+			"valid deviation limits",
+			Market{
+				MarketID:           "market",
+				BaseAsset:          "xrp",
+				QuoteAsset:         "bnb",
+				Oracles:            []sdk.AccAddress{addr},
+				MaxBlockDeviation:  sdk.MustNewDecFromStr("0.1"),
+				MaxWindowDeviation: sdk.MustNewDecFromStr("0.2"),
+				DeviationWindow:    time.Hour,
+			},
+			true,
+		},
+		{
+			"negative max block deviation",
+			Market{
+				MarketID:          "market",
+				BaseAsset:         "xrp",
+				QuoteAsset:        "bnb",
+				Oracles:           []sdk.AccAddress{addr},
+				MaxBlockDeviation: sdk.MustNewDecFromStr("-0.1"),
+			},
+			false,
+		},
+		{
+			"negative deviation window",
+			Market{
+				MarketID:        "market",
+				BaseAsset:       "xrp",
+				QuoteAsset:      "bnb",
+				Oracles:         []sdk.AccAddress{addr},
+				DeviationWindow: -time.Hour,
+			},
+			false,
+		},
+		{
+			"negative oracle outlier deviation",
+			Market{
+				MarketID:               "market",
+				BaseAsset:              "xrp",
+				QuoteAsset:             "bnb",
+				Oracles:                []sdk.AccAddress{addr},
+				OracleOutlierDeviation: sdk.MustNewDecFromStr("-0.1"),
+			},
+			false,
+		},
+		{
+			"invalid aggregation mode",
+			Market{
+				MarketID:        "market",
+				BaseAsset:       "xrp",
+				QuoteAsset:      "bnb",
+				Oracles:         []sdk.AccAddress{addr},
+				AggregationMode: "mode",
+			},
+			false,
+		},
+		{
+			"trim fraction too large",
+			Market{
+				MarketID:        "market",
+				BaseAsset:       "xrp",
+				QuoteAsset:      "bnb",
+				Oracles:         []sdk.AccAddress{addr},
+				AggregationMode: AggregationTrimmedMean,
+				TrimFraction:    sdk.MustNewDecFromStr("0.5"),
+			},
+			false,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -150,3 +219,50 @@ func TestPostedPriceValidate(t *testing.T) {
 		}
 	}
 }
+
+func TestExceedsDeviation(t *testing.T) {
+	d := sdk.MustNewDecFromStr
+	require.False(t, ExceedsDeviation(d("100"), d("110"), d("0.1")))
+	require.True(t, ExceedsDeviation(d("100"), d("110.1"), d("0.1")))
+	require.True(t, ExceedsDeviation(d("100"), d("89"), d("0.1")))
+	require.False(t, ExceedsDeviation(d("100"), d("1000"), sdk.ZeroDec()))
+	require.False(t, ExceedsDeviation(d("100"), d("1000"), sdk.Dec{}))
+	require.False(t, ExceedsDeviation(sdk.ZeroDec(), d("1000"), d("0.1")))
+}
+
+func TestMarketsValidateDerived(t *testing.T) {
+	addr := sdk.AccAddress(tmtypes.NewMockPV().GetPubKey().Address())
+	bnbBtc := Market{MarketID: "bnb:btc", BaseAsset: "bnb", QuoteAsset: "btc", Oracles: []sdk.AccAddress{addr}, Active: true}
+	btcUsd := Market{MarketID: "btc:usd", BaseAsset: "btc", QuoteAsset: "usd", Oracles: []sdk.AccAddress{addr}, Active: true}
+	derived := func(operation, first, second string) Market {
+		return Market{
+			MarketID: "bnb:usd", BaseAsset: "bnb", QuoteAsset: "usd", Active: true,
+			Derivation: NewMarketDerivation(operation, first, second),
+		}
+	}
+	withOracles := derived(DerivationProduct, "bnb:btc", "btc:usd")
+	withOracles.Oracles = []sdk.AccAddress{addr}
+
+	testCases := []struct {
+		msg     string
+		markets Markets
+		expPass bool
+	}{
+		{"valid product", Markets{bnbBtc, btcUsd, derived(DerivationProduct, "bnb:btc", "btc:usd")}, true},
+		{"valid ratio", Markets{bnbBtc, btcUsd, derived(DerivationRatio, "btc:usd", "bnb:btc")}, true},
+		{"invalid operation", Markets{bnbBtc, btcUsd, derived("sum", "bnb:btc", "btc:usd")}, false},
+		{"blank input", Markets{bnbBtc, btcUsd, derived(DerivationProduct, "bnb:btc", "")}, false},
+		{"unknown input", Markets{bnbBtc, derived(DerivationProduct, "bnb:btc", "btc:usd")}, false},
+		{"derived input", Markets{bnbBtc, btcUsd, derived(DerivationProduct, "bnb:btc", "bnb:usd")}, false},
+		{"derived with oracles", Markets{bnbBtc, btcUsd, withOracles}, false},
+	}
+
+	for _, tc := range testCases {
+		err := tc.markets.Validate()
+		if tc.expPass {
+			require.NoError(t, err, tc.msg)
+		} else {
+			require.Error(t, err, tc.msg)
+		}
+	}
+}
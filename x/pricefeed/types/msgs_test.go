@@ -2,6 +2,7 @@ package types
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
@@ -36,3 +37,33 @@ func TestMsgPlaceBid_ValidateBasic(t *testing.T) {
 		})
 	}
 }
+
+func TestMsgPostPrices_ValidateBasic(t *testing.T) {
+	addr := sdk.AccAddress([]byte("someName"))
+	price, _ := sdk.NewDecFromStr("0.3005")
+	expiry := tmtime.Now()
+	negativePrice, _ := sdk.NewDecFromStr("-3.05")
+
+	tests := []struct {
+		name       string
+		msg        MsgPostPrices
+		expectPass bool
+	}{
+		{"normal", NewMsgPostPrices(addr, []PriceEntry{NewPriceEntry("xrp", price, expiry), NewPriceEntry("bnb", price, expiry)}), true},
+		{"emptyAddr", NewMsgPostPrices(sdk.AccAddress{}, []PriceEntry{NewPriceEntry("xrp", price, expiry)}), false},
+		{"noPrices", NewMsgPostPrices(addr, []PriceEntry{}), false},
+		{"emptyAsset", NewMsgPostPrices(addr, []PriceEntry{NewPriceEntry("", price, expiry)}), false},
+		{"negativePrice", NewMsgPostPrices(addr, []PriceEntry{NewPriceEntry("xrp", negativePrice, expiry)}), false},
+		{"noExpiry", NewMsgPostPrices(addr, []PriceEntry{NewPriceEntry("xrp", price, time.Time{})}), false},
+		{"duplicateMarket", NewMsgPostPrices(addr, []PriceEntry{NewPriceEntry("xrp", price, expiry), NewPriceEntry("xrp", price, expiry)}), false},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.expectPass {
+				require.Nil(t, tc.msg.ValidateBasic())
+			} else {
+				require.NotNil(t, tc.msg.ValidateBasic())
+			}
+		})
+	}
+}
@@ -0,0 +1,71 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// OracleMetrics tracks the performance of an oracle in a particular market
+type OracleMetrics struct {
+	MarketID       string         `json:"market_id" yaml:"market_id"`
+	OracleAddress  sdk.AccAddress `json:"oracle_address" yaml:"oracle_address"`
+	Active         bool           `json:"active" yaml:"active"`                   // false once the oracle has been deactivated for the market
+	LastPostTime   time.Time      `json:"last_post_time" yaml:"last_post_time"`   // block time of the oracle's most recent price post
+	MissStreak     uint64         `json:"miss_streak" yaml:"miss_streak"`         // number of consecutive blocks without a valid price from the oracle
+	OutlierStreak  uint64         `json:"outlier_streak" yaml:"outlier_streak"`   // number of consecutive blocks in which the oracle's price was an outlier
+	MedianDistance sdk.Dec        `json:"median_distance" yaml:"median_distance"` // fractional distance of the oracle's price from the median at the last update
+}
+
+// NewOracleMetrics returns the metrics of an active oracle with no recorded history
+func NewOracleMetrics(marketID string, oracle sdk.AccAddress) OracleMetrics {
+	return OracleMetrics{
+		MarketID:       marketID,
+		OracleAddress:  oracle,
+		Active:         true,
+		MedianDistance: sdk.ZeroDec(),
+	}
+}
+
+// String implements fmt.Stringer
+func (om OracleMetrics) String() string {
+	return strings.TrimSpace(fmt.Sprintf(`Market ID: %s
+Oracle Address: %s
+Active: %t
+Last Post Time: %s
+Miss Streak: %d
+Outlier Streak: %d
+Median Distance: %s`, om.MarketID, om.OracleAddress, om.Active, om.LastPostTime, om.MissStreak, om.OutlierStreak, om.MedianDistance))
+}
+
+// OracleMetricsList array of OracleMetrics
+type OracleMetricsList []OracleMetrics
+
+// Get returns the metrics of an oracle, or false if there are none
+func (oml OracleMetricsList) Get(oracle sdk.AccAddress) (OracleMetrics, bool) {
+	for _, om := range oml {
+		if om.OracleAddress.Equals(oracle) {
+			return om, true
+		}
+	}
+	return OracleMetrics{}, false
+}
+
+// String implements fmt.Stringer
+func (oml OracleMetricsList) String() string {
+	out := "Oracle Metrics:\n"
+	for _, om := range oml {
+		out += fmt.Sprintf("%s\n", om.String())
+	}
+	return strings.TrimSpace(out)
+}
+
+// MedianDistance returns the distance of price from the median as a fraction of the median
+func MedianDistance(median, price sdk.Dec) sdk.Dec {
+	if median.IsZero() {
+		return sdk.ZeroDec()
+	}
+	return price.Sub(median).Abs().Quo(median)
+}
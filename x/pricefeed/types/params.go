@@ -3,31 +3,46 @@ package types
 import (
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/cosmos/cosmos-sdk/x/params"
 )
 
 // Parameter keys
 var (
-	KeyMarkets     = []byte("Markets")
-	DefaultMarkets = Markets{}
+	KeyMarkets                  = []byte("Markets")
+	KeyTWAPWindow               = []byte("TWAPWindow")
+	KeyPriceHistoryLength       = []byte("PriceHistoryLength")
+	KeyPriceUpdateRetention     = []byte("PriceUpdateRetention")
+	DefaultMarkets              = Markets{}
+	DefaultTWAPWindow           = 1 * time.Hour
+	DefaultPriceHistoryLength   = uint64(120)
+	MaxPriceHistoryLength       = uint64(1000) // a market's whole price history is read and written each time its price is updated
+	DefaultPriceUpdateRetention = 7 * 24 * time.Hour
 )
 
 // Params params for pricefeed. Can be altered via governance
 type Params struct {
-	Markets Markets `json:"markets" yaml:"markets"` //  Array containing the markets supported by the pricefeed
+	Markets            Markets       `json:"markets" yaml:"markets"`                           //  Array containing the markets supported by the pricefeed
+	TWAPWindow         time.Duration `json:"twap_window" yaml:"twap_window"`                   // length of time over which the time-weighted average price is calculated
+	PriceHistoryLength uint64        `json:"price_history_length" yaml:"price_history_length"` // number of median prices kept in the price history of each market
+	// length of time for which updates to the current price of each market are kept, zero disables recording updates
+	PriceUpdateRetention time.Duration `json:"price_update_retention" yaml:"price_update_retention"`
 }
 
 // NewParams creates a new AssetParams object
-func NewParams(markets Markets) Params {
+func NewParams(markets Markets, twapWindow time.Duration, priceHistoryLength uint64, priceUpdateRetention time.Duration) Params {
 	return Params{
-		Markets: markets,
+		Markets:              markets,
+		TWAPWindow:           twapWindow,
+		PriceHistoryLength:   priceHistoryLength,
+		PriceUpdateRetention: priceUpdateRetention,
 	}
 }
 
 // DefaultParams default params for pricefeed
 func DefaultParams() Params {
-	return NewParams(DefaultMarkets)
+	return NewParams(DefaultMarkets, DefaultTWAPWindow, DefaultPriceHistoryLength, DefaultPriceUpdateRetention)
 }
 
 // ParamKeyTable Key declaration for parameters
@@ -40,6 +55,9 @@ func ParamKeyTable() params.KeyTable {
 func (p *Params) ParamSetPairs() params.ParamSetPairs {
 	return params.ParamSetPairs{
 		params.NewParamSetPair(KeyMarkets, &p.Markets, validateMarketParams),
+		params.NewParamSetPair(KeyTWAPWindow, &p.TWAPWindow, validateTWAPWindowParam),
+		params.NewParamSetPair(KeyPriceHistoryLength, &p.PriceHistoryLength, validatePriceHistoryLengthParam),
+		params.NewParamSetPair(KeyPriceUpdateRetention, &p.PriceUpdateRetention, validatePriceUpdateRetentionParam),
 	}
 }
 
@@ -49,12 +67,32 @@ func (p Params) String() string {
 	for _, a := range p.Markets {
 		out += fmt.Sprintf("%s\n", a.String())
 	}
+	out += fmt.Sprintf("TWAP Window: %s\n", p.TWAPWindow)
+	out += fmt.Sprintf("Price History Length: %d\n", p.PriceHistoryLength)
+	out += fmt.Sprintf("Price Update Retention: %s\n", p.PriceUpdateRetention)
 	return strings.TrimSpace(out)
 }
 
+// PriceSampleInterval returns the minimum time between two records in the price history of a market
+func (p Params) PriceSampleInterval() time.Duration {
+	if p.PriceHistoryLength == 0 {
+		return 0
+	}
+	return p.TWAPWindow / time.Duration(p.PriceHistoryLength)
+}
+
 // Validate ensure that params have valid values
 func (p Params) Validate() error {
-	return validateMarketParams(p.Markets)
+	if err := validateMarketParams(p.Markets); err != nil {
+		return err
+	}
+	if err := validateTWAPWindowParam(p.TWAPWindow); err != nil {
+		return err
+	}
+	if err := validatePriceHistoryLengthParam(p.PriceHistoryLength); err != nil {
+		return err
+	}
+	return validatePriceUpdateRetentionParam(p.PriceUpdateRetention)
 }
 
 func validateMarketParams(i interface{}) error {
@@ -65,3 +103,42 @@ func validateMarketParams(i interface{}) error {
 
 	return markets.Validate()
 }
+
+func validateTWAPWindowParam(i interface{}) error {
+	twapWindow, ok := i.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if twapWindow < 0 {
+		return fmt.Errorf("twap window cannot be negative: %s", twapWindow)
+	}
+
+	return nil
+}
+
+func validatePriceHistoryLengthParam(i interface{}) error {
+	priceHistoryLength, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if priceHistoryLength > MaxPriceHistoryLength {
+		return fmt.Errorf("price history length cannot be greater than %d: %d", MaxPriceHistoryLength, priceHistoryLength)
+	}
+
+	return nil
+}
+
+func validatePriceUpdateRetentionParam(i interface{}) error {
+	retention, ok := i.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if retention < 0 {
+		return fmt.Errorf("price update retention cannot be negative: %s", retention)
+	}
+
+	return nil
+}
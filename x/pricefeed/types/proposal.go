@@ -0,0 +1,126 @@
+package types
+
+import (
+	"errors"
+	"strings"
+
+	yaml "gopkg.in/yaml.v2"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+const (
+	// ProposalTypeConfirmPrice defines the type for a ConfirmPriceProposal
+	ProposalTypeConfirmPrice = "ConfirmPrice"
+	// ProposalTypeReactivateOracle defines the type for a ReactivateOracleProposal
+	ProposalTypeReactivateOracle = "ReactivateOracle"
+)
+
+// ensure proposal types fulfill the gov Content interface.
+var _, _ govtypes.Content = ConfirmPriceProposal{}, ReactivateOracleProposal{}
+
+func init() {
+	// Gov proposals need to be registered on gov's ModuleCdc so MsgSubmitProposal can be encoded.
+	govtypes.RegisterProposalType(ProposalTypeConfirmPrice)
+	govtypes.RegisterProposalTypeCodec(ConfirmPriceProposal{}, "kava/ConfirmPriceProposal")
+	govtypes.RegisterProposalType(ProposalTypeReactivateOracle)
+	govtypes.RegisterProposalTypeCodec(ReactivateOracleProposal{}, "kava/ReactivateOracleProposal")
+}
+
+// ConfirmPriceProposal is a gov proposal for accepting the median price of an unstable market,
+// confirming a price move that breached the market's deviation limits.
+type ConfirmPriceProposal struct {
+	Title       string `json:"title" yaml:"title"`
+	Description string `json:"description" yaml:"description"`
+	MarketID    string `json:"market_id" yaml:"market_id"`
+}
+
+// NewConfirmPriceProposal returns a new ConfirmPriceProposal
+func NewConfirmPriceProposal(title, description, marketID string) ConfirmPriceProposal {
+	return ConfirmPriceProposal{
+		Title:       title,
+		Description: description,
+		MarketID:    marketID,
+	}
+}
+
+// GetTitle returns the title of the proposal.
+func (cpp ConfirmPriceProposal) GetTitle() string { return cpp.Title }
+
+// GetDescription returns the description of the proposal.
+func (cpp ConfirmPriceProposal) GetDescription() string { return cpp.Description }
+
+// ProposalRoute returns the routing key of the proposal.
+func (cpp ConfirmPriceProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of the proposal.
+func (cpp ConfirmPriceProposal) ProposalType() string { return ProposalTypeConfirmPrice }
+
+// ValidateBasic runs basic stateless validity checks
+func (cpp ConfirmPriceProposal) ValidateBasic() error {
+	if err := govtypes.ValidateAbstract(cpp); err != nil {
+		return err
+	}
+	if strings.TrimSpace(cpp.MarketID) == "" {
+		return errors.New("market id cannot be blank")
+	}
+	return nil
+}
+
+// String implements the Stringer interface.
+func (cpp ConfirmPriceProposal) String() string {
+	bz, _ := yaml.Marshal(cpp)
+	return string(bz)
+}
+
+// ReactivateOracleProposal is a gov proposal for reactivating an oracle that was deactivated for a market
+// after missing or misreporting too many prices.
+type ReactivateOracleProposal struct {
+	Title       string         `json:"title" yaml:"title"`
+	Description string         `json:"description" yaml:"description"`
+	MarketID    string         `json:"market_id" yaml:"market_id"`
+	Oracle      sdk.AccAddress `json:"oracle" yaml:"oracle"`
+}
+
+// NewReactivateOracleProposal returns a new ReactivateOracleProposal
+func NewReactivateOracleProposal(title, description, marketID string, oracle sdk.AccAddress) ReactivateOracleProposal {
+	return ReactivateOracleProposal{
+		Title:       title,
+		Description: description,
+		MarketID:    marketID,
+		Oracle:      oracle,
+	}
+}
+
+// GetTitle returns the title of the proposal.
+func (rop ReactivateOracleProposal) GetTitle() string { return rop.Title }
+
+// GetDescription returns the description of the proposal.
+func (rop ReactivateOracleProposal) GetDescription() string { return rop.Description }
+
+// ProposalRoute returns the routing key of the proposal.
+func (rop ReactivateOracleProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of the proposal.
+func (rop ReactivateOracleProposal) ProposalType() string { return ProposalTypeReactivateOracle }
+
+// ValidateBasic runs basic stateless validity checks
+func (rop ReactivateOracleProposal) ValidateBasic() error {
+	if err := govtypes.ValidateAbstract(rop); err != nil {
+		return err
+	}
+	if strings.TrimSpace(rop.MarketID) == "" {
+		return errors.New("market id cannot be blank")
+	}
+	if rop.Oracle.Empty() {
+		return errors.New("oracle address cannot be empty")
+	}
+	return nil
+}
+
+// String implements the Stringer interface.
+func (rop ReactivateOracleProposal) String() string {
+	bz, _ := yaml.Marshal(rop)
+	return string(bz)
+}
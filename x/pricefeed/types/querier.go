@@ -1,7 +1,13 @@
 package types
 
+import "time"
+
 // price Takes an [assetcode] and returns CurrentPrice for that asset
 // pricefeed Takes an [assetcode] and returns the raw []PostedPrice for that asset
+// twap Takes an [assetcode] and returns the time-weighted average CurrentPrice for that asset
+// pricehistory Takes an [assetcode] and returns the recorded []PriceRecord for that asset
+// priceupdates Takes an [assetcode] and a time range and returns the []PriceUpdate for that asset
+// oraclemetrics Takes an [assetcode] and returns the []OracleMetrics for that asset
 // assets Returns []Assets in the pricefeed system
 
 const (
@@ -15,6 +21,14 @@ const (
 	QueryRawPrices = "rawprices"
 	// QueryPrice command for price queries
 	QueryPrice = "price"
+	// QueryTWAPPrice command for time-weighted average price queries
+	QueryTWAPPrice = "twap"
+	// QueryPriceHistory command for price history queries
+	QueryPriceHistory = "pricehistory"
+	// QueryOracleMetrics command for oracle metrics queries
+	QueryOracleMetrics = "oraclemetrics"
+	// QueryPriceUpdates command for price update queries
+	QueryPriceUpdates = "priceupdates"
 )
 
 // QueryWithMarketIDParams fields for querying information from a specific market
@@ -28,3 +42,19 @@ func NewQueryWithMarketIDParams(marketID string) QueryWithMarketIDParams {
 		MarketID: marketID,
 	}
 }
+
+// QueryPriceUpdatesParams fields for querying the price updates of a market within a time range
+type QueryPriceUpdatesParams struct {
+	MarketID string
+	Start    time.Time
+	End      time.Time
+}
+
+// NewQueryPriceUpdatesParams creates a new instance of QueryPriceUpdatesParams
+func NewQueryPriceUpdatesParams(marketID string, start, end time.Time) QueryPriceUpdatesParams {
+	return QueryPriceUpdatesParams{
+		MarketID: marketID,
+		Start:    start,
+		End:      end,
+	}
+}